@@ -0,0 +1,112 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/spf13/viper"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"fractale/fractal6.go/db"
+	handle6 "fractale/fractal6.go/web/handlers"
+	"fractale/fractal6.go/web/mailin"
+)
+
+// RunMailin runs the LMTP/SMTP server receiving the emails delivered by the MTA.
+// They are processed as the emails received from the Postal webhooks
+// (see handlers.Notifications and handlers.Mailing).
+func RunMailin() {
+	address := viper.GetString("mailin.address")
+	if address == "" {
+		address = "127.0.0.1:2424"
+	}
+	maxSize := viper.GetInt64("mailin.max_size")
+	if maxSize <= 0 {
+		maxSize = 25
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = viper.GetString("server.domain")
+	}
+
+	srv := &mailin.Server{
+		Addr:     address,
+		Hostname: hostname,
+		Domain:   viper.GetString("server.domain"),
+		LMTP:     viper.GetString("mailin.protocol") != "smtp",
+		MaxSize:  maxSize << 20,
+		MaxRcpts: 50,
+		Timeout:  5 * time.Minute,
+		Handler:  receiveEmail,
+	}
+
+	// Stop on SIGINT/SIGTERM
+	sigCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-sigCtx.Done()
+		log.Printf("Shutting down mailin...")
+		srv.Close()
+	}()
+
+	log.Printf("Listening mailin @ %s", address)
+	if err := srv.ListenAndServe(); err != nil {
+		log.Fatal("mailin error: ", err)
+	}
+
+	db.GetDB().Close()
+	log.Printf("Mailin stopped.")
+}
+
+// receiveEmail converts a raw email to the form sent by the Postal webhooks.
+func receiveEmail(from, to string, data []byte) error {
+	msg, err := mailin.ParseMessage(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	form := handle6.EmailForm{
+		From:  msg.From,
+		To:    to,
+		Title: msg.Subject,
+		Msg:   msg.Text,
+	}
+	if form.From == "" {
+		form.From = from
+	}
+	for _, a := range msg.Attachments {
+		form.Attachments = append(form.Attachments, handle6.EmailAttachment{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Size:        len(a.Data),
+			Data:        base64.StdEncoding.EncodeToString(a.Data),
+		})
+	}
+	form.AttachmentQuantity = len(form.Attachments)
+
+	_, err = handle6.ReceiveEmail(form)
+	return err
+}
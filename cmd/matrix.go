@@ -0,0 +1,53 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cmd
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph"
+)
+
+// RunMatrix runs the Matrix bridge, which brings back the replies posted in
+// the Matrix rooms of the circles as comments (the new tensions, comments and
+// contracts are posted in the rooms by the notifier).
+func RunMatrix() {
+	if _, err := cache.Ping(ctx).Result(); err != nil {
+		log.Fatal("redis error: ", err)
+	}
+
+	// Stop on SIGINT/SIGTERM
+	sigCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Syncing Matrix rooms...")
+	if err := graph.RunMatrixBridge(sigCtx); err != nil {
+		log.Fatal("matrix error: ", err)
+	}
+
+	cache.Close()
+	db.GetDB().Close()
+	log.Printf("Matrix bridge stopped.")
+}
@@ -25,11 +25,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/viper"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"fractale/fractal6.go/db"
 	"fractale/fractal6.go/graph"
 	"fractale/fractal6.go/graph/model"
+	"fractale/fractal6.go/tools"
+	handle6 "fractale/fractal6.go/web/handlers"
+	"fractale/fractal6.go/web/metrics"
 	"fractale/fractal6.go/web/middleware"
+	"fractale/fractal6.go/web/sessions"
 	//. "fractale/fractal6.go/tools"
 )
 
@@ -56,6 +70,7 @@ func RunNotifier() {
 	subscriber := cache.Subscribe(
 		ctx,
 		"api-tension-notification",
+		"api-tension-bulk-notification",
 		"api-contract-notification",
 		"api-notif-notification",
 	)
@@ -67,26 +82,92 @@ func RunNotifier() {
 
 	log.Printf("Listening Redis pubsub channels @ http://%s", REDIS_ADDRESS)
 
+	// Stop receiving on SIGINT/SIGTERM: closing the subscriber closes its channel.
+	sigCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-sigCtx.Done()
+		log.Printf("Shutting down notifier...")
+		subscriber.Close()
+	}()
+
+	// Track the workers to drain them on shutdown
+	var wg sync.WaitGroup
+
+	// Serve the probes and Prometheus instrumentation
+	if address := viper.GetString("server.notifier_metrics_address"); address != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			serveNotifier(sigCtx, address, subscriber)
+		}()
+	}
+
+	// Send the due date reminders, create the recurring tensions,
+	// check the watched views and send the email digests periodically
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		runReminders(sigCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		runRecurrences(sigCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		runViewWatches(sigCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		runDigests(sigCtx)
+	}()
+
 	for msg := range subscriber.Channel() {
+		var process func(*redis.Message)
 		switch msg.Channel {
 		case "api-tension-notification":
-			go processTensionNotification(msg)
+			process = processTensionNotification
+
+		case "api-tension-bulk-notification":
+			process = processBulkTensionNotification
 
 		case "api-contract-notification":
-			go processContractNotification(msg)
+			process = processContractNotification
 
 		case "api-notif-notification":
-			go processNotifNotification(msg)
+			process = processNotifNotification
 
+		default:
+			continue
 		}
+
+		wg.Add(1)
+		go func(msg *redis.Message) {
+			defer wg.Done()
+			process(msg)
+		}(msg)
+	}
+
+	// Wait for in-flight notifications (emails...)
+	if !tools.WaitTimeout(&wg, shutdownTimeout()) {
+		log.Printf("Notifier shutdown: timeout reached, some notifications may be lost.")
 	}
+
+	// Close clients
+	cache.Close()
+	sessions.Close()
+	db.GetDB().Close()
+	log.Printf("Notifier stopped.")
 }
 
 func processTensionNotification(msg *redis.Message) {
-	defer middleware.NotifRecover("tension event")
+	var err error
+	defer func(start time.Time) { metrics.ObserveNotifier(msg.Channel, start, err) }(time.Now())
+	defer middleware.NotifRecover("tension event", &err)
 	// Extract message
 	var notif model.EventNotif
-	if err := json.Unmarshal([]byte(msg.Payload), &notif); err != nil {
+	if err = json.Unmarshal([]byte(msg.Payload), &notif); err != nil {
 		log.Printf("unmarshaling error for channel %s: %v", msg.Channel, err)
 	}
 	if len(notif.History) == 0 {
@@ -95,18 +176,42 @@ func processTensionNotification(msg *redis.Message) {
 	}
 
 	// Push notification
-	if err := graph.PushEventNotifications(notif); err != nil {
+	if err = graph.PushEventNotifications(notif); err != nil {
 		log.Printf("PushEventNotifications error: %v", err)
 	}
 
 	fmt.Printf("e")
 }
 
+func processBulkTensionNotification(msg *redis.Message) {
+	var err error
+	defer func(start time.Time) { metrics.ObserveNotifier(msg.Channel, start, err) }(time.Now())
+	defer middleware.NotifRecover("bulk tension event", &err)
+	// Extract message
+	var notif model.BulkEventNotif
+	if err = json.Unmarshal([]byte(msg.Payload), &notif); err != nil {
+		log.Printf("unmarshaling error for channel %s: %v", msg.Channel, err)
+	}
+	if len(notif.Notifs) == 0 {
+		log.Printf("No event in notif.")
+		return
+	}
+
+	// Push notification
+	if err = graph.PushBulkEventNotifications(notif); err != nil {
+		log.Printf("PushBulkEventNotifications error: %v", err)
+	}
+
+	fmt.Printf("b")
+}
+
 func processContractNotification(msg *redis.Message) {
-	defer middleware.NotifRecover("contract event")
+	var err error
+	defer func(start time.Time) { metrics.ObserveNotifier(msg.Channel, start, err) }(time.Now())
+	defer middleware.NotifRecover("contract event", &err)
 	// Extract message
 	var notif model.ContractNotif
-	if err := json.Unmarshal([]byte(msg.Payload), &notif); err != nil {
+	if err = json.Unmarshal([]byte(msg.Payload), &notif); err != nil {
 		log.Printf("unmarshaling error for channel %s: %v", msg.Channel, err)
 	}
 	if notif.Contract == nil {
@@ -119,7 +224,7 @@ func processContractNotification(msg *redis.Message) {
 	//time.Sleep(1 * time.Second)
 
 	// Push notification
-	if err := graph.PushContractNotifications(notif); err != nil {
+	if err = graph.PushContractNotifications(notif); err != nil {
 		log.Printf("PushContractNotification error: %v: ", err)
 	}
 
@@ -127,10 +232,12 @@ func processContractNotification(msg *redis.Message) {
 }
 
 func processNotifNotification(msg *redis.Message) {
-	defer middleware.NotifRecover("notif event")
+	var err error
+	defer func(start time.Time) { metrics.ObserveNotifier(msg.Channel, start, err) }(time.Now())
+	defer middleware.NotifRecover("notif event", &err)
 	// Extract message
 	var notif model.NotifNotif
-	if err := json.Unmarshal([]byte(msg.Payload), &notif); err != nil {
+	if err = json.Unmarshal([]byte(msg.Payload), &notif); err != nil {
 		log.Printf("unmarshaling error for channel %s: %v", msg.Channel, err)
 	}
 	if len(notif.Msg) == 0 {
@@ -139,9 +246,156 @@ func processNotifNotification(msg *redis.Message) {
 	}
 
 	// Push notification
-	if err := graph.PushNotifNotifications(notif, false); err != nil {
+	if err = graph.PushNotifNotifications(notif, false); err != nil {
 		log.Printf("PushEventNotifications error: %v", err)
 	}
 
 	fmt.Printf("n")
 }
+
+// runReminders sends the due date reminders every `server.due_reminder_interval`
+// minutes, until the context is done.
+func runReminders(done context.Context) {
+	lead := 24 * time.Hour
+	if viper.IsSet("server.due_reminder_lead") {
+		lead = time.Duration(viper.GetInt("server.due_reminder_lead")) * time.Hour
+	}
+	interval := 10 * time.Minute
+	if viper.IsSet("server.due_reminder_interval") {
+		interval = time.Duration(viper.GetInt("server.due_reminder_interval")) * time.Minute
+	}
+	if interval <= 0 {
+		log.Printf("Due date reminders disabled.")
+		return
+	}
+	runPeriodic(done, interval, func() error { return processReminders(lead) })
+}
+
+// runRecurrences creates the recurring tensions every `server.recurrence_interval`
+// minutes, until the context is done.
+func runRecurrences(done context.Context) {
+	interval := 5 * time.Minute
+	if viper.IsSet("server.recurrence_interval") {
+		interval = time.Duration(viper.GetInt("server.recurrence_interval")) * time.Minute
+	}
+	if interval <= 0 {
+		log.Printf("Recurring tensions disabled.")
+		return
+	}
+	runPeriodic(done, interval, processRecurrences)
+}
+
+// runViewWatches notifies the watchers of the saved tension views every
+// `server.view_watch_interval` minutes, until the context is done.
+func runViewWatches(done context.Context) {
+	interval := 30 * time.Minute
+	if viper.IsSet("server.view_watch_interval") {
+		interval = time.Duration(viper.GetInt("server.view_watch_interval")) * time.Minute
+	}
+	if interval <= 0 {
+		log.Printf("Tension view watches disabled.")
+		return
+	}
+	runPeriodic(done, interval, processViewWatches)
+}
+
+// runDigests sends the email digests every `server.digest_interval` minutes,
+// until the context is done.
+func runDigests(done context.Context) {
+	interval := 10 * time.Minute
+	if viper.IsSet("server.digest_interval") {
+		interval = time.Duration(viper.GetInt("server.digest_interval")) * time.Minute
+	}
+	if interval <= 0 {
+		log.Printf("Email digests disabled.")
+		return
+	}
+	runPeriodic(done, interval, processDigests)
+}
+
+// runPeriodic calls process at each interval, until the context is done.
+// The errors are logged and recorded by the process itself.
+func runPeriodic(done context.Context, interval time.Duration, process func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done.Done():
+			return
+		case <-ticker.C:
+			_ = process()
+		}
+	}
+}
+
+func processReminders(lead time.Duration) (err error) {
+	defer func(start time.Time) { metrics.ObserveNotifier("due-date-reminder", start, err) }(time.Now())
+	defer middleware.NotifRecover("due date reminder", &err)
+
+	if err = graph.PushDueDateReminders(lead); err != nil {
+		log.Printf("PushDueDateReminders error: %v", err)
+	}
+	return err
+}
+
+func processRecurrences() (err error) {
+	defer func(start time.Time) { metrics.ObserveNotifier("recurring-tension", start, err) }(time.Now())
+	defer middleware.NotifRecover("recurring tension", &err)
+
+	if err = graph.PushRecurringTensions(); err != nil {
+		log.Printf("PushRecurringTensions error: %v", err)
+	}
+	return err
+}
+
+func processViewWatches() (err error) {
+	defer func(start time.Time) { metrics.ObserveNotifier("tension-view-watch", start, err) }(time.Now())
+	defer middleware.NotifRecover("tension view watch", &err)
+
+	if err = graph.PushTensionViewWatches(); err != nil {
+		log.Printf("PushTensionViewWatches error: %v", err)
+	}
+	return err
+}
+
+func processDigests() (err error) {
+	defer func(start time.Time) { metrics.ObserveNotifier("email-digest", start, err) }(time.Now())
+	defer middleware.NotifRecover("email digest", &err)
+
+	if err = graph.PushEmailDigests(); err != nil {
+		log.Printf("PushEmailDigests error: %v", err)
+	}
+	return err
+}
+
+// serveNotifier exposes the notifier probes and metrics, as the notifier runs
+// in its own process. The liveness probe fails when the Redis subscription
+// drops, so that the orchestrator can restart the notifier.
+func serveNotifier(ctx context.Context, address string, subscriber *redis.PubSub) {
+	mux := http.NewServeMux()
+
+	// Liveness and readiness probes
+	subscription := func(ctx context.Context) error { return subscriber.Ping(ctx) }
+	mux.HandleFunc("/healthz", handle6.ReadyzHandler(map[string]handle6.HealthCheck{
+		"redis_subscription": subscription,
+	}))
+	mux.HandleFunc("/readyz", handle6.ReadyzHandler(map[string]handle6.HealthCheck{
+		"redis_subscription": subscription,
+		"dgraph_grpc":        db.GetDB().PingDql,
+		"dgraph_graphql":     db.GetDB().PingGql,
+		"dgraph_keys":        func(ctx context.Context) error { return db.CheckKeys() },
+	}))
+
+	// Serve Prometheus instrumentation
+	if viper.GetBool("server.prometheus_instrumentation") {
+		r := prometheus.NewRegistry()
+		metrics.Register(r, viper.GetBool("server.prometheus_runtime"))
+		mux.Handle("/metrics", middleware.CheckBearer(promhttp.HandlerFor(r, promhttp.HandlerOpts{})))
+	}
+
+	log.Printf("Serving notifier probes @ http://%s", address)
+	srv := &http.Server{Addr: address, Handler: mux}
+	if err := tools.ListenAndServeGraceful(ctx, srv, shutdownTimeout()); err != nil {
+		log.Printf("notifier http error: %v", err)
+	}
+}
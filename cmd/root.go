@@ -59,6 +59,24 @@ var (
 		},
 	}
 
+	mailinCmd = &cobra.Command{
+		Use:   "mailin",
+		Short: "run LMTP/SMTP server receiving emails",
+		Long:  `run LMTP/SMTP server receiving emails from the MTA.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			RunMailin()
+		},
+	}
+
+	matrixCmd = &cobra.Command{
+		Use:   "matrix",
+		Short: "run Matrix bridge",
+		Long:  `run Matrix bridge receiving the replies posted in the Matrix rooms.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			RunMatrix()
+		},
+	}
+
 	genToken = &cobra.Command{
 		Use:   "token [username]",
 		Short: "Generate JWT tokens",
@@ -79,6 +97,8 @@ func init() {
 	// Cli init
 	rootCmd.AddCommand(apiCmd)
 	rootCmd.AddCommand(notifierCmd)
+	rootCmd.AddCommand(mailinCmd)
+	rootCmd.AddCommand(matrixCmd)
 	rootCmd.AddCommand(genToken)
 	rootCmd.AddCommand(addUser)
 	rootCmd.AddCommand(delUser)
@@ -22,18 +22,26 @@ package cmd
 
 import (
 	//"fmt"
+	"context"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/cors"
 	"github.com/spf13/viper"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/tools"
 	"fractale/fractal6.go/web"
 	"fractale/fractal6.go/web/auth"
 	handle6 "fractale/fractal6.go/web/handlers"
+	"fractale/fractal6.go/web/metrics"
 	middle6 "fractale/fractal6.go/web/middleware"
+	"fractale/fractal6.go/web/sessions"
 )
 
 var tkMaster *auth.Jwt
@@ -53,34 +61,31 @@ func init() {
 
 // RunServer launch the server
 func RunServer() {
-	DOMAIN := viper.GetString("server.domain")
-	HOST := viper.GetString("server.hostname")
-	PORT := viper.GetString("server.port")
 	gqlConfig := viper.GetStringMap("graphql")
 	instrumentation := viper.GetBool("server.prometheus_instrumentation")
+	runtimeMetrics := viper.GetBool("server.prometheus_runtime")
 
-	r := chi.NewRouter()
-
-	var allowedOrigins []string
-	if buildMode == "PROD" {
-		allowedOrigins = append(allowedOrigins, "https://"+DOMAIN, "https://api."+DOMAIN, "https://staging."+DOMAIN)
-	} else {
-		allowedOrigins = append(allowedOrigins, "http://localhost:8000")
+	// Validate the listen, cors and proxy settings
+	config, err := loadServerConfig()
+	if err != nil {
+		log.Fatal("Server config error: ", err)
 	}
 
+	r := chi.NewRouter()
+
 	// for more ideas, see: https://developer.github.com/v3/#cross-origin-resource-sharing
 	cors := cors.New(cors.Options{
-		AllowedOrigins: allowedOrigins,
+		AllowedOrigins: config.AllowedOrigins,
 		//AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		//AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		//ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"X-Next-Cursor"}, // tension queries pagination
 		AllowCredentials: true,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	})
 
 	// Middleware stack
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(middle6.RealIP(config.TrustedProxies))
 	r.Use(cors.Handler)
 	//r.Use(middle6.RequestContextMiddleware) // Set context info
 	// JWT   //r.Use(jwtauth.Verifier(tkMaster.GetAuth()))
@@ -93,10 +98,12 @@ func RunServer() {
 	// Set a timeout value on the request context (ctx), that will signal
 	// through ctx.Done() that the request has timed out and further
 	// processing should be stopped.
-	r.Use(middleware.Timeout(60 * time.Second))
+	r.Use(middleware.Timeout(config.RequestTimeout))
 
 	// Serve Prometheus instrumentation
 	if instrumentation {
+		// Record the request latency by route
+		r.Use(metrics.HttpMiddleware)
 		go func() {
 			// Update metrics in a goroutine
 			for {
@@ -107,9 +114,13 @@ func RunServer() {
 		secured := r.Group(nil)
 		secured.Use(middle6.CheckBearer)
 		//secured.Handle("/metrics", promhttp.Handler()) // inclue Go collection metrics
-		secured.Handle("/metrics", handle6.InstruHandler())
+		secured.Handle("/metrics", handle6.InstruHandler(runtimeMetrics))
 	}
 
+	// Liveness and readiness probes
+	r.Get("/healthz", handle6.Healthz)
+	r.Get("/readyz", handle6.ReadyzHandler(handle6.ApiChecks))
+
 	// Serve Graphql Playground & introspection
 	if buildMode == "DEV" {
 		r.Get("/playground", handle6.PlaygroundHandler("/api"))
@@ -137,11 +148,15 @@ func RunServer() {
 			r.Post("/resetpassword2", handle6.ResetPassword2)
 			r.Post("/uuidcheck", handle6.UuidCheck)
 			r.Post("/updatepassword", handle6.UpdatePassword)
+			r.Post("/matrixlink", handle6.MatrixLink)
 
 			// Organisation
 			r.Post("/createorga", handle6.CreateOrga)
 			r.Post("/setusercanjoin", handle6.SetUserCanJoin)
 			r.Post("/setguestcancreatetension", handle6.SetGuestCanCreateTension)
+
+			// Tension
+			r.Post("/bulktensions", handle6.BulkTensions)
 		})
 	})
 
@@ -161,6 +176,7 @@ func RunServer() {
 				r.Post("/sub_labels", handle6.SubLabels)
 				r.Post("/top_roles", handle6.TopRoles)
 				r.Post("/sub_roles", handle6.SubRoles)
+				r.Post("/top_templates", handle6.TopTemplates)
 			})
 
 			// Special tension query (nested filters and counts)
@@ -177,10 +193,16 @@ func RunServer() {
 		})
 	})
 
+	// Attachments download
+	r.Get("/data/attachment/{id}", handle6.Attachment)
+
 	// MTA communication Endpoints
 	// --
 	// Notifications endpoint
 	r.Post("/notifications", handle6.Notifications)
+	// One-click unsubscribe endpoint (RFC 8058)
+	r.Get("/notifications/unsubscribe", handle6.Unsubscribe)
+	r.Post("/notifications/unsubscribe", handle6.Unsubscribe)
 	// Mailing-list endpoint
 	r.Post("/mailing", handle6.Mailing)
 	// Postal webhook endpoint
@@ -193,7 +215,32 @@ func RunServer() {
 	// Serve static frontend files
 	web.FileServer(r, "/", "./public", "")
 
-	address := HOST + ":" + PORT
-	log.Printf("Running API (%s) @ http://%s", buildMode, address)
-	http.ListenAndServe(address, r)
+	ln, err := config.listen()
+	if err != nil {
+		log.Fatal("Server listen error: ", err)
+	}
+	log.Printf("Running API (%s) @ %s", buildMode, config.url())
+
+	// Serve until SIGINT/SIGTERM, then drain in-flight requests
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	srv := &http.Server{Handler: r}
+	if err := tools.ServeGraceful(ctx, srv, ln, shutdownTimeout()); err != nil {
+		log.Printf("Server shutdown error: %v", err)
+	}
+
+	// Close clients
+	db.GetDB().Close()
+	sessions.Close()
+	log.Printf("API stopped.")
+}
+
+// shutdownTimeout returns the time given to drain the in-flight
+// requests on shutdown.
+func shutdownTimeout() time.Duration {
+	timeout := viper.GetInt("server.shutdown_timeout")
+	if timeout <= 0 {
+		timeout = 30
+	}
+	return time.Duration(timeout) * time.Second
 }
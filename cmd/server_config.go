@@ -0,0 +1,169 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cmd
+
+import (
+	"crypto/tls"
+	"fmt"
+	"github.com/spf13/viper"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"fractale/fractal6.go/web"
+)
+
+// serverConfig holds the listen, CORS and proxy settings of the API server.
+type serverConfig struct {
+	// TCP address (host:port), used if Socket is empty
+	Address string
+	// Unix socket path
+	Socket string
+	// Cors allowed origins
+	AllowedOrigins []string
+	// Proxies trusted for the X-Real-IP/X-Forwarded-For headers
+	TrustedProxies []*net.IPNet
+	// Timeout set on the request context
+	RequestTimeout time.Duration
+	// Native TLS (reloaded when the files change)
+	TlsCert string
+	TlsKey  string
+}
+
+// loadServerConfig reads and validates the [server] settings.
+func loadServerConfig() (*serverConfig, error) {
+	DOMAIN := viper.GetString("server.domain")
+	HOST := viper.GetString("server.hostname")
+	PORT := viper.GetString("server.port")
+
+	c := &serverConfig{
+		Address:        HOST + ":" + PORT,
+		Socket:         viper.GetString("server.socket"),
+		AllowedOrigins: viper.GetStringSlice("server.allowed_origins"),
+		RequestTimeout: 60 * time.Second,
+		TlsCert:        viper.GetString("server.tls_cert"),
+		TlsKey:         viper.GetString("server.tls_key"),
+	}
+
+	// Listen
+	if c.Socket == "" && PORT == "" {
+		return nil, fmt.Errorf("server.port or server.socket is required")
+	}
+
+	// Cors
+	if len(c.AllowedOrigins) == 0 {
+		if buildMode == "PROD" {
+			c.AllowedOrigins = []string{"https://" + DOMAIN, "https://api." + DOMAIN, "https://staging." + DOMAIN}
+		} else {
+			c.AllowedOrigins = []string{"http://localhost:8000"}
+		}
+	}
+	for _, o := range c.AllowedOrigins {
+		if o == "*" {
+			// The cors middleware would reflect any origin with the credentials.
+			return nil, fmt.Errorf("server.allowed_origins: '*' is not allowed, as the requests carry credentials")
+		}
+		u, err := url.Parse(o)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			return nil, fmt.Errorf("server.allowed_origins: invalid origin '%s' (expected scheme://host[:port])", o)
+		}
+	}
+
+	// Trusted proxies
+	for _, p := range viper.GetStringSlice("server.trusted_proxies") {
+		cidr := p
+		if !strings.Contains(p, "/") {
+			if ip := net.ParseIP(p); ip != nil && ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("server.trusted_proxies: invalid IP or CIDR '%s'", p)
+		}
+		c.TrustedProxies = append(c.TrustedProxies, n)
+	}
+
+	// Request timeout
+	if viper.IsSet("server.request_timeout") {
+		timeout := viper.GetInt("server.request_timeout")
+		if timeout <= 0 {
+			return nil, fmt.Errorf("server.request_timeout must be a positive number of seconds")
+		}
+		c.RequestTimeout = time.Duration(timeout) * time.Second
+	}
+
+	// TLS
+	if (c.TlsCert == "") != (c.TlsKey == "") {
+		return nil, fmt.Errorf("server.tls_cert and server.tls_key must be set together")
+	}
+	if c.TlsCert != "" {
+		if _, err := tls.LoadX509KeyPair(c.TlsCert, c.TlsKey); err != nil {
+			return nil, fmt.Errorf("server.tls_cert/tls_key: %v", err)
+		}
+	}
+
+	return c, nil
+}
+
+// listen opens the server listener (unix socket or TCP, with optional TLS).
+func (c serverConfig) listen() (net.Listener, error) {
+	var ln net.Listener
+	var err error
+	if c.Socket != "" {
+		// Remove a stale socket from a previous run
+		if err := os.Remove(c.Socket); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		ln, err = net.Listen("unix", c.Socket)
+	} else {
+		ln, err = net.Listen("tcp", c.Address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.TlsCert != "" {
+		cr, err := web.NewCertReloader(c.TlsCert, c.TlsKey)
+		if err != nil {
+			ln.Close()
+			return nil, err
+		}
+		ln = tls.NewListener(ln, cr.TLSConfig())
+	}
+
+	return ln, nil
+}
+
+// url returns the address the server is reachable at, for logging.
+func (c serverConfig) url() string {
+	if c.Socket != "" {
+		return "unix:" + c.Socket
+	}
+	if c.TlsCert != "" {
+		return "https://" + c.Address
+	}
+	return "http://" + c.Address
+}
@@ -0,0 +1,79 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestLoadServerConfig(t *testing.T) {
+	defer viper.Reset()
+
+	tests := []struct {
+		origins []string
+		proxies []string
+		ok      bool
+	}{
+		{nil, nil, true},
+		{[]string{"https://app.fractale.co", "http://localhost:8000/"}, nil, true},
+		{[]string{"*"}, nil, false},
+		{[]string{"app.fractale.co"}, nil, false},
+		{[]string{"ftp://app.fractale.co"}, nil, false},
+		{[]string{"https://app.fractale.co/path"}, nil, false},
+		{nil, []string{"10.0.0.1", "172.16.0.0/12", "::1"}, true},
+		{nil, []string{"10.0.0.300"}, false},
+		{nil, []string{"10.0.0.0/33"}, false},
+	}
+	for _, tt := range tests {
+		viper.Reset()
+		viper.Set("server.port", "8888")
+		viper.Set("server.allowed_origins", tt.origins)
+		viper.Set("server.trusted_proxies", tt.proxies)
+		c, err := loadServerConfig()
+		if (err == nil) != tt.ok {
+			t.Errorf("loadServerConfig error for %v %v, want ok: %v, got: %v", tt.origins, tt.proxies, tt.ok, err)
+			continue
+		}
+		if err == nil && len(c.TrustedProxies) != len(tt.proxies) {
+			t.Errorf("loadServerConfig error, want %d proxies, got: %d", len(tt.proxies), len(c.TrustedProxies))
+		}
+	}
+
+	// The trusted proxies are matched as networks.
+	viper.Reset()
+	viper.Set("server.port", "8888")
+	viper.Set("server.trusted_proxies", []string{"10.0.0.1"})
+	c, err := loadServerConfig()
+	if err != nil {
+		t.Fatalf("loadServerConfig error: %v", err)
+	}
+	if n := c.TrustedProxies[0]; !n.Contains([]byte{10, 0, 0, 1}) || n.Contains([]byte{10, 0, 0, 2}) {
+		t.Errorf("loadServerConfig error, 10.0.0.1 should be parsed as 10.0.0.1/32, got: %s", n)
+	}
+
+	// Listen address or socket is required
+	viper.Reset()
+	if _, err := loadServerConfig(); err == nil {
+		t.Errorf("loadServerConfig should fail without port or socket")
+	}
+}
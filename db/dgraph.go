@@ -235,6 +235,52 @@ func (dg Dgraph) getDgraphClient() (dgClient *dgo.Dgraph, cancelFunc func()) {
 	return
 }
 
+// Close releases the idle connections to the GraphQL endpoint.
+// The gRPC connections are opened and closed per request (see getDgraphClient).
+func (dg Dgraph) Close() {
+	http.DefaultTransport.(*http.Transport).CloseIdleConnections()
+}
+
+//
+// Health checks
+//
+
+// PingDql checks that the Dgraph gRPC endpoint answers a trivial query.
+func (dg Dgraph) PingDql(ctx context.Context) error {
+	dgc, cancel := dg.getDgraphClient()
+	defer cancel()
+	txn := dgc.NewReadOnlyTxn().BestEffort()
+	defer txn.Discard(ctx)
+	_, err := txn.Query(ctx, `{ all(func: uid(0x1)) { uid } }`)
+	return err
+}
+
+// PingGql checks that the Dgraph GraphQL endpoint is reachable.
+func (dg Dgraph) PingGql(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", dg.gqlAddr, strings.NewReader(`{"query":"{ __typename }"}`))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("dgraph graphql returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// CheckKeys checks that the Dgraph RSA keys are loaded.
+func CheckKeys() error {
+	if dgraphPrivateKey == nil || dgraphPublicKey == nil {
+		return fmt.Errorf("dgraph RSA keys not loaded")
+	}
+	return nil
+}
+
 func (dg Dgraph) GetRootUctx() model.UserCtx {
 	return model.UserCtx{
 		Username: "root",
@@ -312,8 +358,7 @@ func (dg Dgraph) postql(uctx model.UserCtx, data []byte, res interface{}) error
 	gqlToken := dg.BuildGqlToken(uctx, time.Minute*10)
 	req.Header.Set("X-Frac6-Auth", gqlToken)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return err
 	}
@@ -327,7 +372,8 @@ func (dg Dgraph) postql(uctx model.UserCtx, data []byte, res interface{}) error
 //
 
 // QueryDql runs a query on dgraph (...QueryDql)
-func (dg Dgraph) QueryDql(op string, maps map[string]string) (*api.Response, error) {
+func (dg Dgraph) QueryDql(op string, maps map[string]string) (res *api.Response, err error) {
+	defer observeDgraph("dql", op, time.Now(), &err)
 	// init client
 	dgc, cancel := dg.getDgraphClient()
 	defer cancel()
@@ -343,14 +389,15 @@ func (dg Dgraph) QueryDql(op string, maps map[string]string) (*api.Response, err
 		fmt.Println(op)
 	}
 	//fmt.Println(string(q))
-	res, err := txn.Query(ctx, q)
+	res, err = txn.Query(ctx, q)
 	//fmt.Println(res)
 	return res, err
 }
 
 // MutateWithQueryDql runs an upsert block mutation by first querying query
 // and then mutate based on the result.
-func (dg Dgraph) MutateWithQueryDql(query string, mu *api.Mutation) error {
+func (dg Dgraph) MutateWithQueryDql(query string, mu *api.Mutation) (err error) {
+	defer observeDgraph("dql", "mutate", time.Now(), &err)
 	// init client
 	dgc, cancel := dg.getDgraphClient()
 	defer cancel()
@@ -364,13 +411,14 @@ func (dg Dgraph) MutateWithQueryDql(query string, mu *api.Mutation) error {
 		CommitNow: true,
 	}
 
-	_, err := txn.Do(ctx, req)
+	_, err = txn.Do(ctx, req)
 	return err
 }
 
 // MutateWithQueryDql3 runs an upsert block mutations by first querying query
 // and then mutate based on the result. Accepte conditions.
-func (dg Dgraph) MutateWithQueryDql3(q QueryMut, maps map[string]string) (*api.Response, error) {
+func (dg Dgraph) MutateWithQueryDql3(q QueryMut, maps map[string]string) (res *api.Response, err error) {
+	defer observeDgraph("dql", "upsert", time.Now(), &err)
 	// init client
 	dgc, cancel := dg.getDgraphClient()
 	defer cancel()
@@ -419,7 +467,8 @@ func (dg Dgraph) MutateWithQueryDql3(q QueryMut, maps map[string]string) (*api.R
 
 // QueryGql query the Dgraph Graphql endpoint by following a http request.
 // It map the result in to given data structure
-func (dg Dgraph) QueryGql(uctx model.UserCtx, op string, reqInput map[string]string, data interface{}) error {
+func (dg Dgraph) QueryGql(uctx model.UserCtx, op string, reqInput map[string]string, data interface{}) (err error) {
+	defer observeDgraph("graphql", op, time.Now(), &err)
 	// Get the query
 	queryName := reqInput["QueryName"]
 	q := dg.getGqlQuery(op, reqInput)
@@ -427,7 +476,7 @@ func (dg Dgraph) QueryGql(uctx model.UserCtx, op string, reqInput map[string]str
 	// Send the dgraph request and follow the results
 	res := &GqlRes{}
 	//fmt.Println("request ->", string(q))
-	err := dg.postql(uctx, []byte(q), res)
+	err = dg.postql(uctx, []byte(q), res)
 	//fmt.Println("response ->", res)
 	if err != nil {
 		return err
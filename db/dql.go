@@ -28,6 +28,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"log"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"fractale/fractal6.go/graph/model"
@@ -56,6 +57,7 @@ var tensionHookPayload string = `
   uid
   Post.createdBy { User.username }
   Tension.action
+  Tension.confidential
   Tension.emitter {
     Node.nameid
     Node.role_type
@@ -95,6 +97,8 @@ var tensionBlobHookPayload string = `
 var contractHookPayload string = `{
   uid
   Post.createdAt
+  Post.createdBy { User.username }
+  Contract.contractid
   Contract.tension { uid Tension.receiverid }
   Contract.status
   Contract.contract_type
@@ -327,6 +331,43 @@ var dqlQueries map[string]string = map[string]string{
             u as Node.children @filter(eq(Node.nameid, "{{.child}}"))
         }
         all(func: uid(u)) { uid }
+    }`,
+	"isTensionInProject": `{
+        var(func: uid({{.projectid}})) {
+            Project.columns { c as ProjectColumn.cards }
+        }
+        all(func: uid(c)) @filter(uid_in(ProjectCard.card, {{.tid}})) { uid }
+    }`,
+	"isCommentInTension": `{
+        all(func: uid({{.tid}})) @filter(uid_in(Tension.comments, {{.cid}})) { uid }
+    }`,
+	"getConfidentialReaders": `{
+        var(func: uid({{.tid}})) @filter(eq(Tension.confidential, true)) {
+            a as Post.createdBy
+            b as Tension.assignees
+            c as Tension.participants
+        }
+        all(func: uid(a, b, c)) { User.username }
+    }`,
+	"getNotifPreferences": `{
+        var(func: eq(User.username, "{{.username}}")) {
+            p as User.notif_preferences
+        }
+        all(func: uid(p)) {
+            NotifPreference.rootnameid
+            NotifPreference.reason
+            NotifPreference.event_group
+            NotifPreference.channel
+        }
+    }`,
+	"getUnreadUserEvents": `{
+        all(func: uid({{.ids}})) @filter(type(UserEvent) AND eq(UserEvent.isRead, false)) { uid }
+    }`,
+	"isTensionReachable": `{
+        var(func: uid({{.from}})) @recurse(loop: false) {
+            r as {{.predicate}}
+        }
+        all(func: uid(r)) @filter(uid({{.to}})) { uid }
     }`,
 	// Get multiple objects
 	"getChildren": `{
@@ -362,6 +403,15 @@ var dqlQueries map[string]string = map[string]string{
             }
         }
 
+        all(func: uid(u)) {
+            {{.user_payload}}
+        }
+    }`,
+	"getOwners": `{
+        var(func: eq(Node.rootnameid, "{{.rootnameid}}")) @filter(eq(Node.role_type, "Owner") AND has(Node.first_link)) {
+            u as Node.first_link
+        }
+
         all(func: uid(u)) {
             {{.user_payload}}
         }
@@ -503,6 +553,28 @@ var dqlQueries map[string]string = map[string]string{
             Label.color
             Label.nodes { Node.nameid }
         }
+    }`,
+	"getTopTemplates": `{
+        var(func: eq(Node.{{.fieldid}}, "{{.objid}}")) @recurse {
+            o as uid
+            Node.parent @normalize
+        }
+
+        var(func: uid(o)) @filter(eq(Node.isArchived, false) AND NOT eq(Node.{{.fieldinclude}}, "{{.objid}}")) {
+            l as Node.templates
+        }
+
+        all(func: uid(l)){
+            uid
+            TensionTemplate.name
+            TensionTemplate.about
+            TensionTemplate.title
+            TensionTemplate.message
+            TensionTemplate.type_
+            TensionTemplate.labels { uid Label.name Label.color }
+            TensionTemplate.assignees { User.username User.name }
+            TensionTemplate.nodes { Node.nameid }
+        }
     }`,
 	"getTopRoles": `{
         var(func: eq(Node.{{.fieldid}}, "{{.objid}}")) @recurse {
@@ -555,7 +627,12 @@ var dqlQueries map[string]string = map[string]string{
             }
         }
 
-        all(func: uid(tensions, tensionsProtected), first:{{.first}}, offset:{{.offset}}, {{.order}}: Post.createdAt) {
+        var(func: uid(tensions, tensionsProtected)) {
+            {{.sortVars}}
+        }
+
+        all(func: uid(tensions, tensionsProtected), first:{{.first}}, offset:{{.offset}}, {{.order}}: val(sortv)) {{.pageFilter}} {
+            sort_value: val(sortv)
             {{.payload}}
         }
     }`,
@@ -574,7 +651,12 @@ var dqlQueries map[string]string = map[string]string{
             }
         }
 
-        all(func: uid(tensions_in, tensions_out), first:{{.first}}, offset:{{.offset}}, {{.order}}: Post.createdAt) {
+        var(func: uid(tensions_in, tensions_out)) {
+            {{.sortVars}}
+        }
+
+        all(func: uid(tensions_in, tensions_out), first:{{.first}}, offset:{{.offset}}, {{.order}}: val(sortv)) {{.pageFilter}} {
+            sort_value: val(sortv)
             {{.payload}}
         }
     }`,
@@ -594,9 +676,97 @@ var dqlQueries map[string]string = map[string]string{
             }
         }
 
-        all(func: uid(tensions, tensionsProtected), first:{{.first}}, offset:{{.offset}}, {{.order}}: Post.createdAt) {
+        var(func: uid(tensions, tensionsProtected)) {
+            {{.sortVars}}
+        }
+
+        all(func: uid(tensions, tensionsProtected), first:{{.first}}, offset:{{.offset}}, {{.order}}: val(sortv)) {{.pageFilter}} {
+            sort_value: val(sortv)
             {{.payload}}
         }
+    }`,
+	"getDueTensions": `{
+        all(func: le(Tension.dueDate, "{{.before}}")) @filter(eq(Tension.status, "Open")) {
+            uid
+            Post.createdBy { User.username }
+            Tension.title
+            Tension.dueDate
+            Tension.receiverid
+            Tension.assignees { User.username }
+            Tension.subscribers { User.username }
+        }
+    }`,
+	"getTensionContent": `{
+        all(func: uid({{.id}})) {
+            uid
+            Tension.comments { uid }
+            Tension.subscribers { User.username }
+            Tension.labels { uid }
+            Tension.assignees { User.username }
+            Tension.mentions { uid }
+        }
+    }`,
+	"getRecurrences": `{
+        all(func: {{.func}}) {{.filter}} {
+            uid
+            Recurrence.createdBy { User.username }
+            Recurrence.receiverid
+            Recurrence.rrule
+            Recurrence.dtstart
+            Recurrence.paused
+            Recurrence.title
+            Recurrence.next_at
+            Recurrence.count
+            Recurrence.template { uid TensionTemplate.type_ }
+            Recurrence.tension {
+                uid
+                Tension.title
+                Tension.type_
+                Tension.emitterid
+                Tension.labels { uid Label.name Label.color }
+                Tension.assignees { User.username }
+                Tension.comments(first: 1, orderasc: Post.createdAt) { Post.message }
+            }
+        }
+    }`,
+	"getMailingSetting": `{
+        all(func: eq(MailingSetting.{{.fieldid}}, "{{.value}}")) {
+            uid
+            MailingSetting.createdBy { User.username }
+            MailingSetting.rootnameid
+            MailingSetting.nameid
+            MailingSetting.enabled
+            MailingSetting.alias
+            MailingSetting.senders
+            MailingSetting.allowlist
+            MailingSetting.type_
+            MailingSetting.labels { uid Label.name Label.color }
+        }
+    }`,
+	"getAttachment": `{
+        all(func: uid({{.id}})) @filter(type(Attachment)) {
+            uid
+            Attachment.createdBy { User.username }
+            Attachment.createdAt
+            Attachment.tension { uid Tension.receiverid }
+            Attachment.filename
+            Attachment.content_type
+            Attachment.size
+            Attachment.key
+        }
+    }`,
+	"getTensionViews": `{
+        all(func: {{.func}}) {
+            uid
+            TensionView.createdBy { User.username }
+            TensionView.createdAt
+            TensionView.rootnameid
+            TensionView.nameid
+            TensionView.name
+            TensionView.query
+            TensionView.watchers { User.username }
+            TensionView.checked_at
+        }
     }`,
 	"getTensionCount": `{
         {{.extra_pre_vars}}
@@ -614,10 +784,18 @@ var dqlQueries map[string]string = map[string]string{
             }
         }
 
-        all(func: uid(tensions, tensionsProtected)) @filter(eq(Tension.status, "Open")) {
+        var(func: uid(tensions, tensionsProtected)) {
+            {{.sortVars}}
+        }
+
+        page as var(func: uid(tensions, tensionsProtected)) {{.pageFilter}} {
+            uid
+        }
+
+        all(func: uid(page)) @filter(eq(Tension.status, "Open")) {
             count: count(uid)
         }
-        all2(func: uid(tensions, tensionsProtected)) @filter(eq(Tension.status, "Closed")) {
+        all2(func: uid(page)) @filter(eq(Tension.status, "Closed")) {
             count: count(uid)
         }
     }`,
@@ -1301,6 +1479,145 @@ func (dg Dgraph) IsChild(parent, child string) (bool, error) {
 	return len(r.All) > 0, nil
 }
 
+// IsTensionReachable returns true if the tension {to} can be reached from the
+// tension {from} by following the given predicate.
+func (dg Dgraph) IsTensionReachable(from, to, predicate string) (bool, error) {
+	// Format Query
+	maps := map[string]string{
+		"from":      from,
+		"to":        to,
+		"predicate": predicate,
+	}
+	// Send request
+	res, err := dg.QueryDql("isTensionReachable", maps)
+	if err != nil {
+		return false, err
+	}
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return false, err
+	}
+	return len(r.All) > 0, nil
+}
+
+// IsTensionInProject returns true if the tension has a card in the given project.
+func (dg Dgraph) IsTensionInProject(tid, projectid string) (bool, error) {
+	if !IsUid(tid) || !IsUid(projectid) {
+		return false, fmt.Errorf("invalid tension or project id")
+	}
+	// Format Query
+	maps := map[string]string{
+		"tid":       tid,
+		"projectid": projectid,
+	}
+	// Send request
+	res, err := dg.QueryDql("isTensionInProject", maps)
+	if err != nil {
+		return false, err
+	}
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return false, err
+	}
+	return len(r.All) > 0, nil
+}
+
+func (dg Dgraph) IsCommentInTension(cid, tid string) (bool, error) {
+	// Format Query
+	maps := map[string]string{
+		"cid": cid,
+		"tid": tid,
+	}
+	// Send request
+	res, err := dg.QueryDql("isCommentInTension", maps)
+	if err != nil {
+		return false, err
+	}
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return false, err
+	}
+	return len(r.All) > 0, nil
+}
+
+// GetConfidentialReaders returns the author, assignees and participants of the
+// given tension if it is confidential, and nil otherwise.
+func (dg Dgraph) GetConfidentialReaders(tid string) ([]string, error) {
+	// Send request
+	res, err := dg.QueryDql("getConfidentialReaders", map[string]string{"tid": tid})
+	if err != nil {
+		return nil, err
+	}
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+	var readers []string
+	for _, u := range r.All {
+		if username, ok := u["User.username"].(string); ok {
+			readers = append(readers, username)
+		}
+	}
+	return readers, nil
+}
+
+// GetNotifPreferences returns the notification preferences of the given user.
+func (dg Dgraph) GetNotifPreferences(username string) ([]*model.NotifPreference, error) {
+	// Send request
+	res, err := dg.QueryDql("getNotifPreferences", map[string]string{"username": username})
+	if err != nil {
+		return nil, err
+	}
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+	var prefs []*model.NotifPreference
+	for _, x := range r.All {
+		var p model.NotifPreference
+		if err := Map2Struct(CleanCompositeName(x, false), &p); err != nil {
+			return nil, err
+		}
+		prefs = append(prefs, &p)
+	}
+	return prefs, nil
+}
+
+// GetUnreadUserEvents returns the given user events that are not read yet.
+func (dg Dgraph) GetUnreadUserEvents(ids []string) ([]string, error) {
+	var unread []string
+	if len(ids) == 0 {
+		return unread, nil
+	}
+	// Send request
+	res, err := dg.QueryDql("getUnreadUserEvents", map[string]string{"ids": strings.Join(ids, ", ")})
+	if err != nil {
+		return nil, err
+	}
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+	for _, x := range r.All {
+		if id, ok := x["uid"].(string); ok {
+			unread = append(unread, id)
+		}
+	}
+	return unread, nil
+}
+
 // Returns the uids of the objects if found.
 func (dg Dgraph) GetIDs(fieldName string, value string, filterName, filterValue *string) ([]string, error) {
 	result := []string{}
@@ -2101,6 +2418,62 @@ func (dg Dgraph) GetTopLabels(fieldid string, objid string, includeSelf bool) ([
 	return data, err
 }
 
+// Get all top tension templates
+func (dg Dgraph) GetTopTemplates(fieldid string, objid string, includeSelf bool) ([]model.TensionTemplate, error) {
+	// Format Query
+	var fieldinclude string
+	if includeSelf {
+		fieldinclude = fieldid + "_IGNORE"
+	} else {
+		fieldinclude = fieldid
+	}
+	maps := map[string]string{
+		"fieldid":      fieldid,
+		"objid":        objid,
+		"fieldinclude": fieldinclude,
+	}
+	// Send request
+	res, err := dg.QueryDql("getTopTemplates", maps)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	var data_dup []model.TensionTemplate
+	config := &mapstructure.DecoderConfig{
+		Result:  &data_dup,
+		TagName: "json",
+		DecodeHook: func(from, to reflect.Kind, v interface{}) (interface{}, error) {
+			if to == reflect.Struct {
+				nv := CleanCompositeName(v.(map[string]interface{}), false)
+				return nv, nil
+			}
+			return v, nil
+		},
+	}
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(r.All)
+	// Remove duplicate based on TensionTemplate.name
+	data := []model.TensionTemplate{}
+	check := make(map[string]bool)
+	for _, d := range data_dup {
+		if _, v := check[d.Name]; !v {
+			check[d.Name] = true
+			data = append(data, d)
+		}
+	}
+	return data, err
+}
+
 // Get all sub labels
 func (dg Dgraph) GetSubLabels(fieldid string, objid string) ([]model.Label, error) {
 	// Format Query
@@ -2256,10 +2629,16 @@ func (dg Dgraph) GetSubRoles(fieldid string, objid string) ([]model.RoleExt, err
 }
 
 func (dg Dgraph) GetTensions(q TensionQuery, type_ string) ([]model.TensionRef, error) {
+	data, _, err := dg.GetTensionsPage(q, type_)
+	return data, err
+}
+
+// GetTensionsPage returns the tensions and the cursor of the next page, if the page is full.
+func (dg Dgraph) GetTensionsPage(q TensionQuery, type_ string) ([]model.TensionRef, *string, error) {
 	// Format Query
 	maps, err := FormatTensionIntExtMap(q)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// Send request
 	var op string
@@ -2287,6 +2666,7 @@ func (dg Dgraph) GetTensions(q TensionQuery, type_ string) ([]model.TensionRef,
         Tension.status
         Tension.type_
         Tension.action
+        Tension.dueDate
         Tension.labels { uid Label.name Label.color }
         n_comments: count(Tension.comments)`
 
@@ -2306,14 +2686,14 @@ func (dg Dgraph) GetTensions(q TensionQuery, type_ string) ([]model.TensionRef,
 	(*maps)["payload"] = payload
 	res, err := dg.QueryDql(op, *maps)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Decode response
 	var r DqlResp
 	err = json.Unmarshal(res.Json, &r)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var data []model.TensionRef
@@ -2330,10 +2710,38 @@ func (dg Dgraph) GetTensions(q TensionQuery, type_ string) ([]model.TensionRef,
 	}
 	decoder, err := mapstructure.NewDecoder(config)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	err = decoder.Decode(r.All)
-	return data, err
+	if err != nil || q.First <= 0 || len(r.All) < q.First {
+		return data, nil, err
+	}
+
+	// Build the cursor from the sort value of the last tension
+	sortValue := func(x model.JsonAtom) string {
+		switch v := x["sort_value"].(type) {
+		case string:
+			return v
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+		return ""
+	}
+	var cursor TensionCursor
+	cursor.Value = sortValue(r.All[len(r.All)-1])
+	if q.Cursor != nil && *q.Cursor != "" {
+		// Keep the ties of the previous pages
+		if prev, _ := DecodeTensionCursor(*q.Cursor); prev != nil && prev.Value == cursor.Value {
+			cursor.Ids = prev.Ids
+		}
+	}
+	for _, x := range r.All {
+		if sortValue(x) == cursor.Value {
+			cursor.Ids = append(cursor.Ids, x["uid"].(string))
+		}
+	}
+	next := cursor.Encode()
+	return data, &next, err
 }
 
 func (dg Dgraph) GetTensionsCount(q TensionQuery) (map[string]int, error) {
@@ -2362,6 +2770,256 @@ func (dg Dgraph) GetTensionsCount(q TensionQuery) (map[string]int, error) {
 	return nil, err
 }
 
+// GetTensionContent returns the comments, subscribers, labels, assignees and
+// mentions of the given tension.
+func (dg Dgraph) GetTensionContent(tid string) (*model.Tension, error) {
+	// Send request
+	res, err := dg.QueryDql("getTensionContent", map[string]string{"id": tid})
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []model.Tension
+	config := &mapstructure.DecoderConfig{
+		Result:  &data,
+		TagName: "json",
+		DecodeHook: func(from, to reflect.Kind, v interface{}) (interface{}, error) {
+			if to == reflect.Struct {
+				nv := CleanCompositeName(v.(map[string]interface{}), false)
+				return nv, nil
+			}
+			return v, nil
+		},
+	}
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(r.All)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return &data[0], err
+}
+
+// GetDueTensions returns the open tensions due before the given date.
+func (dg Dgraph) GetDueTensions(before string) ([]model.Tension, error) {
+	// Send request
+	res, err := dg.QueryDql("getDueTensions", map[string]string{"before": before})
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []model.Tension
+	config := &mapstructure.DecoderConfig{
+		Result:  &data,
+		TagName: "json",
+		DecodeHook: func(from, to reflect.Kind, v interface{}) (interface{}, error) {
+			if to == reflect.Struct {
+				nv := CleanCompositeName(v.(map[string]interface{}), false)
+				return nv, nil
+			}
+			return v, nil
+		},
+	}
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(r.All)
+	return data, err
+}
+
+// GetRecurrence returns the recurrence with the given id.
+func (dg Dgraph) GetRecurrence(id string) (*model.Recurrence, error) {
+	data, err := dg.getRecurrences(map[string]string{"func": fmt.Sprintf("uid(%s)", id), "filter": ""})
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return &data[0], err
+}
+
+// GetDueRecurrences returns the active recurrences with an occurrence due before the given date.
+func (dg Dgraph) GetDueRecurrences(before string) ([]model.Recurrence, error) {
+	return dg.getRecurrences(map[string]string{
+		"func":   fmt.Sprintf(`le(Recurrence.next_at, "%s")`, before),
+		"filter": `@filter(NOT eq(Recurrence.paused, true))`,
+	})
+}
+
+func (dg Dgraph) getRecurrences(maps map[string]string) ([]model.Recurrence, error) {
+	// Send request
+	res, err := dg.QueryDql("getRecurrences", maps)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []model.Recurrence
+	config := &mapstructure.DecoderConfig{
+		Result:  &data,
+		TagName: "json",
+		DecodeHook: func(from, to reflect.Kind, v interface{}) (interface{}, error) {
+			if to == reflect.Struct {
+				nv := CleanCompositeName(v.(map[string]interface{}), false)
+				return nv, nil
+			}
+			return v, nil
+		},
+	}
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(r.All)
+	return data, err
+}
+
+// GetMailingSetting returns the mailing setting matching the given field
+// (nameid or alias), or nil if there is none.
+func (dg Dgraph) GetMailingSetting(fieldid, value string) (*model.MailingSetting, error) {
+	// Send request
+	res, err := dg.QueryDql("getMailingSetting", map[string]string{"fieldid": fieldid, "value": value})
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.All) == 0 {
+		return nil, nil
+	}
+
+	var data model.MailingSetting
+	config := &mapstructure.DecoderConfig{
+		Result:  &data,
+		TagName: "json",
+		DecodeHook: func(from, to reflect.Kind, v interface{}) (interface{}, error) {
+			if to == reflect.Struct {
+				nv := CleanCompositeName(v.(map[string]interface{}), false)
+				return nv, nil
+			}
+			return v, nil
+		},
+	}
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(r.All[0])
+	return &data, err
+}
+
+// GetAttachment returns the attachment with the given id, with the receiver of its tension.
+func (dg Dgraph) GetAttachment(id string) (*model.Attachment, error) {
+	// Send request
+	res, err := dg.QueryDql("getAttachment", map[string]string{"id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []model.Attachment
+	config := &mapstructure.DecoderConfig{
+		Result:  &data,
+		TagName: "json",
+		DecodeHook: func(from, to reflect.Kind, v interface{}) (interface{}, error) {
+			if to == reflect.Struct {
+				nv := CleanCompositeName(v.(map[string]interface{}), false)
+				return nv, nil
+			}
+			return v, nil
+		},
+	}
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(r.All)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return &data[0], err
+}
+
+// GetTensionView returns the saved tension view with the given id.
+func (dg Dgraph) GetTensionView(id string) (*model.TensionView, error) {
+	data, err := dg.getTensionViews(map[string]string{"func": fmt.Sprintf("uid(%s)", id)})
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return &data[0], err
+}
+
+// GetWatchedTensionViews returns the saved tension views with watchers.
+func (dg Dgraph) GetWatchedTensionViews() ([]model.TensionView, error) {
+	return dg.getTensionViews(map[string]string{"func": "has(TensionView.watchers)"})
+}
+
+func (dg Dgraph) getTensionViews(maps map[string]string) ([]model.TensionView, error) {
+	// Send request
+	res, err := dg.QueryDql("getTensionViews", maps)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode response
+	var r DqlResp
+	err = json.Unmarshal(res.Json, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []model.TensionView
+	config := &mapstructure.DecoderConfig{
+		Result:  &data,
+		TagName: "json",
+		DecodeHook: func(from, to reflect.Kind, v interface{}) (interface{}, error) {
+			if to == reflect.Struct {
+				nv := CleanCompositeName(v.(map[string]interface{}), false)
+				return nv, nil
+			}
+			return v, nil
+		},
+	}
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(r.All)
+	return data, err
+}
+
 func (dg Dgraph) GetLastBlobId(tid string) *string {
 	// init client
 	dgc, cancel := dg.getDgraphClient()
@@ -2521,6 +3179,22 @@ func (dg Dgraph) SetFieldById(objid string, predicate string, val string) error
 	return err
 }
 
+// ClearFieldById removes a predicate of the given node in the DB
+func (dg Dgraph) ClearFieldById(objid string, predicate string) error {
+	query := fmt.Sprintf(`query {
+        node as var(func: uid(%s))
+    }`, objid)
+
+	muDel := fmt.Sprintf(`uid(node) <%s> * .`, predicate)
+
+	mutation := &api.Mutation{
+		DelNquads: []byte(muDel),
+	}
+
+	err := dg.MutateWithQueryDql(query, mutation)
+	return err
+}
+
 // SetFieldByEq set a predicate for the given node in the DB
 func (dg Dgraph) SetFieldByEq(fieldid string, objid string, predicate string, val string) error {
 	query := fmt.Sprintf(`query {
@@ -2559,6 +3233,29 @@ func (dg Dgraph) SetSubFieldByEq(fieldid string, objid string, predicate1, predi
 	return err
 }
 
+// SetUserMatrixid links the Matrix id to the user, and unlinks it from any
+// other user. An empty matrixid unlinks the user.
+func (dg Dgraph) SetUserMatrixid(username string, matrixid string) error {
+	query := fmt.Sprintf(`query {
+        user as var(func: eq(User.username, "%s"))
+        others as var(func: eq(User.matrixid, "%s"))
+    }`, username, matrixid)
+
+	muDel := `
+        uid(user) <User.matrixid> * .
+        uid(others) <User.matrixid> * .
+    `
+	err := dg.MutateWithQueryDql(query, &api.Mutation{DelNquads: []byte(muDel)})
+	if err != nil || matrixid == "" {
+		return err
+	}
+
+	mu := fmt.Sprintf(`
+        uid(user) <User.matrixid> "%s" .
+    `, matrixid)
+	return dg.MutateWithQueryDql(query, &api.Mutation{SetNquads: []byte(mu)})
+}
+
 // Set the blob pushedFlag and the tension action
 func (dg Dgraph) SetPushedFlagBlob(bid string, flag string, tid string, action model.TensionAction) error {
 	query := fmt.Sprintf(`query {
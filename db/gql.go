@@ -475,3 +475,42 @@ func (dg Dgraph) RemoveUserRole(username, nameid string) error {
 	err := dg.Update(dg.GetRootUctx(), "user", userInput)
 	return err
 }
+
+// RemoveTensionSubscriber remove the user from the tension subscribers
+func (dg Dgraph) RemoveTensionSubscriber(tid, username string) error {
+	tensionInput := model.UpdateTensionInput{
+		Filter: &model.TensionFilter{ID: []string{tid}},
+		Remove: &model.TensionPatch{
+			Subscribers: []*model.UserRef{&model.UserRef{Username: &username}},
+		},
+	}
+	err := dg.Update(dg.GetRootUctx(), "tension", tensionInput)
+	return err
+}
+
+// RemoveNodeWatcher remove the user from the node watchers
+func (dg Dgraph) RemoveNodeWatcher(nameid, username string) error {
+	nodeInput := model.UpdateNodeInput{
+		Filter: &model.NodeFilter{Nameid: &model.StringHashFilterStringRegExpFilter{Eq: &nameid}},
+		Remove: &model.NodePatch{
+			Watchers: []*model.UserRef{&model.UserRef{Username: &username}},
+		},
+	}
+	err := dg.Update(dg.GetRootUctx(), "node", nodeInput)
+	return err
+}
+
+// SetNotifPreference add or replace the user preference for the given reason,
+// for all organisations and event groups.
+func (dg Dgraph) SetNotifPreference(username string, reason model.NotifPreferenceReason, channel model.NotifChannel) error {
+	upsert := true
+	input := model.AddNotifPreferenceInput{
+		Preferenceid: strings.Join([]string{username, "", string(reason), ""}, "#"),
+		User:         &model.UserRef{Username: &username},
+		Reason:       &reason,
+		Channel:      channel,
+	}
+	var data model.AddNotifPreferencePayload
+	err := dg.AddExtra(dg.GetRootUctx(), "notifPreference", []model.AddNotifPreferenceInput{input}, &upsert, "notifPreference { id }", &data)
+	return err
+}
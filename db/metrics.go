@@ -0,0 +1,55 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package db
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	dgraphQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dgraph_query_duration_seconds",
+		Help:    "Dgraph request latency by api (dql/graphql) and query name.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"api", "op"})
+
+	dgraphQueryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dgraph_query_errors_total",
+		Help: "Number of failed Dgraph requests by api (dql/graphql) and query name.",
+	}, []string{"api", "op"})
+)
+
+// MetricsCollectors returns the Dgraph metrics, to be registered with the
+// other runtime metrics (see metrics.Register).
+func MetricsCollectors() []prometheus.Collector {
+	return []prometheus.Collector{dgraphQueryDuration, dgraphQueryErrors}
+}
+
+// observeDgraph records the latency of a Dgraph request.
+// Usage: defer observeDgraph("dql", op, time.Now(), &err)
+func observeDgraph(api, op string, start time.Time, err *error) {
+	dgraphQueryDuration.WithLabelValues(api, op).Observe(time.Since(start).Seconds())
+	if err != nil && *err != nil {
+		dgraphQueryErrors.WithLabelValues(api, op).Inc()
+	}
+}
@@ -21,30 +21,116 @@
 package db
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"fractale/fractal6.go/graph/codec"
 	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
 )
 
 type TensionQuery struct {
-	Nameids []string             `json:"nameids"`
-	First   int                  `json:"first"`
-	Offset  int                  `json:"offset"`
+	Nameids []string `json:"nameids"`
+	First   int      `json:"first"`
+	Offset  int      `json:"offset"`
+	// Cursor returned with the previous page (see TensionCursor).
+	// If given, Offset is ignored.
+	Cursor *string `json:"cursor"`
+	// Saved view (see model.TensionView) whose query replaces this one.
+	// Only the pagination fields are kept.
+	Viewid  *string              `json:"viewid"`
 	Pattern *string              `json:"pattern"`
 	Sort    *string              `json:"sort"`
 	Status  *model.TensionStatus `json:"status"`
 	Type    *model.TensionType   `json:"type_"`
+	Action  *model.TensionAction `json:"action"`
 	Authors []string             `json:"authors"`
 	Labels  []string             `json:"labels"`
+	// Filter out the tensions with one of those labels
+	ExcludeLabels []string `json:"exclude_labels"`
+	Assignees     []string `json:"assignees"`
+	Subscribers   []string `json:"subscribers"`
+	HasContract   bool     `json:"has_contract"`
 	// Either filter tension that in or NOT in the given project
-	InProject bool    `json:in_project`
+	InProject bool    `json:"in_project"`
 	Projectid *string `json:"projectid"`
+	// Former key of InProject (the json tag was malformed), still accepted.
+	InProjectLegacy *bool `json:"inProject,omitempty"`
+	// Date ranges (RFC3339 or YYYY-MM-DD)
+	CreatedAfter  *string `json:"created_after"`
+	CreatedBefore *string `json:"created_before"`
+	UpdatedAfter  *string `json:"updated_after"`
+	UpdatedBefore *string `json:"updated_before"`
+	// Due date filters (RFC3339 or YYYY-MM-DD)
+	DueBefore *string `json:"due_before"`
+	Overdue   bool    `json:"overdue"`
+	// Relation filters
+	Unblocked bool    `json:"unblocked"`
+	Parentid  *string `json:"parentid"`
 	// Protected tensions @auth
 	NameidsProtected []string
 	Username         string
+	// Confidential and pending tensions @auth
+	// Circles where the user can read every confidential and pending tension (coordinators)
+	NameidsConfidential []string
+	// If true, the user can read every confidential and pending tension (owner/root)
+	ConfidentialAll bool
+}
+
+// Sort keys of the tension queries. Each one defines the value variable
+// `sortv` used to order the tensions and to build the pagination cursor.
+// - newest/oldest: creation date
+// - updated: date of the last event (also used by the updated_* filters)
+// - comments: number of comments
+// - activity: date of the last comment
+var tensionSortVars = map[string]string{
+	"newest":   `sortv as Post.createdAt`,
+	"oldest":   `sortv as Post.createdAt`,
+	"updated":  `Tension.history { h as Post.createdAt } sortv as max(val(h))`,
+	"comments": `sortv as count(Tension.comments)`,
+	"activity": `Tension.comments { c as Post.createdAt } sortv as max(val(c))`,
+}
+
+// TensionCursor marks the end of a page of tensions: the sort value of the
+// last tension, and the tensions of the page with that same value (ties).
+type TensionCursor struct {
+	Value string   `json:"v"`
+	Ids   []string `json:"ids"`
+}
+
+func (c TensionCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeTensionCursor(s string) (*TensionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	var c TensionCursor
+	if err = json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	for _, id := range c.Ids {
+		if !IsUid(id) {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+	}
+	return &c, nil
+}
+
+// eqList returns a eq function matching any of the given values.
+func eqList(predicate string, values []string) string {
+	var vs []string
+	for _, v := range values {
+		vs = append(vs, fmt.Sprintf(`"%s"`, QuoteString(v)))
+	}
+	return fmt.Sprintf("eq(%s, %s)", predicate, strings.Join(vs, ", "))
 }
 
 // Note: We assumes here all nameids have the same rootnameid.
@@ -88,9 +174,103 @@ func FormatTensionIntExtMap(q TensionQuery) (*map[string]string, error) {
 	if q.Type != nil {
 		tf = append(tf, fmt.Sprintf(`eq(Tension.type_, "%s")`, q.Type))
 	}
+	if q.Action != nil {
+		tf = append(tf, fmt.Sprintf(`eq(Tension.action, "%s")`, *q.Action))
+	}
 	if q.Pattern != nil {
 		tf = append(tf, fmt.Sprintf(`anyoftext(Tension.title, "%s")`, *q.Pattern))
 	}
+	for _, x := range []struct {
+		date      *string
+		predicate string
+		op        string
+	}{
+		{q.CreatedAfter, "Post.createdAt", "ge"},
+		{q.CreatedBefore, "Post.createdAt", "le"},
+	} {
+		if x.date == nil {
+			continue
+		}
+		var d time.Time
+		if x.op == "ge" {
+			d, err = ParseDate(*x.date)
+		} else {
+			d, err = ParseDueDate(*x.date) // end of day
+		}
+		if err != nil {
+			return nil, err
+		}
+		tf = append(tf, fmt.Sprintf(`%s(%s, "%s")`, x.op, x.predicate, d.Format(time.RFC3339)))
+	}
+	if len(q.Assignees) > 0 {
+		tf = append(tf, `uid_in(Tension.assignees, uid(assignees))`)
+		preVars += fmt.Sprintf(`assignees as var(func: %s)
+        `, eqList("User.username", q.Assignees))
+	}
+	if len(q.Subscribers) > 0 {
+		tf = append(tf, `uid_in(Tension.subscribers, uid(subscribers))`)
+		preVars += fmt.Sprintf(`subscribers as var(func: %s)
+        `, eqList("User.username", q.Subscribers))
+	}
+	if len(q.ExcludeLabels) > 0 {
+		tf = append(tf, `NOT uid_in(Tension.labels, uid(excludedLabels))`)
+		preVars += fmt.Sprintf(`excludedLabels as var(func: %s)
+        `, eqList("Label.name", q.ExcludeLabels))
+	}
+	if q.HasContract {
+		tf = append(tf, `uid_in(Tension.contracts, uid(openContracts))`)
+		preVars += `openContracts as var(func: eq(Contract.status, "Open"))
+        `
+	}
+	if q.DueBefore != nil {
+		dueBefore, err := ParseDueDate(*q.DueBefore)
+		if err != nil {
+			return nil, err
+		}
+		tf = append(tf, fmt.Sprintf(`le(Tension.dueDate, "%s")`, dueBefore.Format(time.RFC3339)))
+	}
+	if q.Overdue {
+		// Only open tensions can be late.
+		tf = append(tf, fmt.Sprintf(`lt(Tension.dueDate, "%s") AND eq(Tension.status, "Open")`, Now()))
+	}
+	if q.Unblocked {
+		// Tensions blocked only by closed tensions are unblocked.
+		tf = append(tf, `NOT uid_in(Tension.blocked_by, uid(openBlockers))`)
+		preVars += `openBlockers as var(func: has(Tension.blocks)) @filter(eq(Tension.status, "Open"))
+        `
+	}
+	if q.Parentid != nil {
+		if !IsUid(*q.Parentid) {
+			return nil, fmt.Errorf("invalid parentid: %s", *q.Parentid)
+		}
+		tf = append(tf, fmt.Sprintf(`uid_in(Tension.parent, %s)`, *q.Parentid))
+	}
+	if !q.ConfidentialAll {
+		// Confidential tensions are visible only to their participants.
+		cf := []string{
+			`NOT eq(Tension.confidential, true)`,
+			`uid_in(Post.createdBy, uid(me))`,
+			`uid_in(Tension.assignees, uid(me))`,
+			`uid_in(Tension.participants, uid(me))`,
+		}
+		preVars += fmt.Sprintf(`me as var(func: eq(User.username, "%s"))
+        `, q.Username)
+		if len(q.NameidsConfidential) > 0 {
+			cf = append(cf, `uid_in(Tension.receiver, uid(confidentialReceivers))`)
+			preVars += fmt.Sprintf(`confidentialReceivers as var(func: %s)
+        `, eqList("Node.nameid", q.NameidsConfidential))
+		}
+		tf = append(tf, "("+strings.Join(cf, " OR ")+")")
+		// Pending tensions are visible only to their author and the coordinators.
+		pd := []string{
+			`NOT eq(Tension.status, "Pending")`,
+			`uid_in(Post.createdBy, uid(me))`,
+		}
+		if len(q.NameidsConfidential) > 0 {
+			pd = append(pd, `uid_in(Tension.receiver, uid(confidentialReceivers))`)
+		}
+		tf = append(tf, "("+strings.Join(pd, " OR ")+")")
+	}
 	if len(q.Authors) > 0 {
 		tf = append(tf, `has(Post.createdBy)`)
 	}
@@ -98,7 +278,10 @@ func FormatTensionIntExtMap(q TensionQuery) (*map[string]string, error) {
 		tf = append(tf, `has(Tension.labels)`)
 	}
 	if q.Projectid != nil {
-		if q.InProject {
+		if !IsUid(*q.Projectid) {
+			return nil, fmt.Errorf("invalid projectid: %s", *q.Projectid)
+		}
+		if q.InProject || (q.InProjectLegacy != nil && *q.InProjectLegacy) {
 			tf = append(tf, `uid_in(Tension.project_statuses, uid(columns))`)
 		} else {
 			tf = append(tf, `NOT uid_in(Tension.project_statuses, uid(columns))`)
@@ -117,12 +300,74 @@ func FormatTensionIntExtMap(q TensionQuery) (*map[string]string, error) {
 
 	/* sorting */
 	var sortFilter string = "orderdesc"
+	var sort string = "newest"
 	if q.Sort != nil {
-		if *q.Sort == "oldest" {
+		sort = *q.Sort
+		if _, ok := tensionSortVars[sort]; !ok {
+			return nil, fmt.Errorf("unknown sort: %s", sort)
+		}
+		if sort == "oldest" {
 			sortFilter = "orderasc"
 		}
 	}
 
+	/* cursor and filters on the sort values */
+	var pf []string
+	var pageFilter string
+	var sortVars string = tensionSortVars[sort]
+	var offset int = q.Offset
+	if q.Cursor != nil && *q.Cursor != "" {
+		cursor, err := DecodeTensionCursor(*q.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		// Count are not quoted
+		v := fmt.Sprintf(`"%s"`, QuoteString(cursor.Value))
+		if sort == "comments" {
+			if _, err := strconv.Atoi(cursor.Value); err != nil {
+				return nil, fmt.Errorf("invalid cursor")
+			}
+			v = cursor.Value
+		}
+		op := "lt"
+		if sortFilter == "orderasc" {
+			op = "gt"
+		}
+		if len(cursor.Ids) > 0 {
+			pf = append(pf, fmt.Sprintf(`(%s(val(sortv), %s) OR (eq(val(sortv), %s) AND NOT uid(%s)))`,
+				op, v, v, strings.Join(cursor.Ids, ", ")))
+		} else {
+			pf = append(pf, fmt.Sprintf(`%s(val(sortv), %s)`, op, v))
+		}
+		offset = 0
+	}
+	// The update date is the date of the last event, as for the "updated" sort.
+	if q.UpdatedAfter != nil || q.UpdatedBefore != nil {
+		updated := "sortv"
+		if sort != "updated" {
+			updated = "updated"
+			sortVars += `
+            Tension.history { hu as Post.createdAt } updated as max(val(hu))`
+		}
+		if q.UpdatedAfter != nil {
+			d, err := ParseDate(*q.UpdatedAfter)
+			if err != nil {
+				return nil, err
+			}
+			pf = append(pf, fmt.Sprintf(`ge(val(%s), "%s")`, updated, d.Format(time.RFC3339)))
+		}
+		if q.UpdatedBefore != nil {
+			d, err := ParseDueDate(*q.UpdatedBefore) // end of day
+			if err != nil {
+				return nil, err
+			}
+			pf = append(pf, fmt.Sprintf(`le(val(%s), "%s")`, updated, d.Format(time.RFC3339)))
+		}
+	}
+	if len(pf) > 0 {
+		pageFilter = fmt.Sprintf("@filter(%s)", strings.Join(pf, " AND "))
+	}
+
 	/* Sub Tension filter */
 	var authorsFilter string
 	var labelsFilter string
@@ -172,13 +417,15 @@ func FormatTensionIntExtMap(q TensionQuery) (*map[string]string, error) {
 	/* Build template map */
 	maps := &map[string]string{
 		"first":         strconv.Itoa(q.First),
-		"offset":        strconv.Itoa(q.Offset),
+		"offset":        strconv.Itoa(offset),
 		"rootnameid":    rootnameid,
 		"nameids":       nameidsString,
 		"tensionFilter": tensionFilter,
 		"authorsFilter": authorsFilter,
 		"labelsFilter":  labelsFilter,
 		"order":         sortFilter,
+		"sortVars":      sortVars,
+		"pageFilter":    pageFilter,
 		// Protected
 		"rootnameidProtected": rootnameidProtected,
 		"nameidsProtected":    nameidsProtectedString,
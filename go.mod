@@ -22,6 +22,7 @@ require (
 	github.com/vektah/gqlparser/v2 v2.5.11
 	github.com/yuin/goldmark v1.7.0
 	golang.org/x/crypto v0.20.0
+	golang.org/x/net v0.21.0
 	golang.org/x/text v0.14.0
 	google.golang.org/grpc v1.62.0
 )
@@ -72,7 +73,6 @@ require (
 	golang.org/x/exp v0.0.0-20240222234643-814bf88cf225 // indirect
 	golang.org/x/image v0.15.0 // indirect
 	golang.org/x/mod v0.15.0 // indirect
-	golang.org/x/net v0.21.0 // indirect
 	golang.org/x/sys v0.17.0 // indirect
 	golang.org/x/tools v0.18.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240228201840-1f18d85a4ec2 // indirect
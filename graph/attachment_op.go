@@ -0,0 +1,152 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/gofrs/uuid"
+	"io"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/storage"
+)
+
+// UploadAttachment stores the uploaded file and attaches it to the tension,
+// or to one of its comment. The user must be allowed to comment the tension,
+// and be the author of the comment.
+func UploadAttachment(uctx *model.UserCtx, tid string, cid *string, file graphql.Upload) (*model.Attachment, error) {
+	if !IsUid(tid) || cid != nil && !IsUid(*cid) {
+		return nil, LogErr("Value error", fmt.Errorf("invalid tension or comment id."))
+	}
+	tension, err := db.GetDB().GetTensionHook(tid, false, nil)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+	if tension == nil {
+		return nil, LogErr("Value error", fmt.Errorf("tension not found."))
+	}
+
+	// Check that the user can comment the tension
+	now := Now()
+	e := model.TensionEventCommentPushed
+	event := model.EventRef{
+		CreatedAt: &now,
+		CreatedBy: &model.UserRef{Username: &uctx.Username},
+		EventType: &e,
+	}
+	ok, _, err := ProcessEvent(uctx, tension, &event, nil, nil, true, false)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, LogErr("Access denied", fmt.Errorf("You are not allowed to add files to this tension."))
+	}
+	if cid != nil {
+		author, err := db.GetDB().GetSubFieldById(*cid, "Post.createdBy", "User.username")
+		if err != nil || author == nil || author.(string) != uctx.Username {
+			return nil, LogErr("Access denied", fmt.Errorf("Only the author of the comment can add files to it."))
+		}
+		if ok, err := db.GetDB().IsCommentInTension(*cid, tid); err != nil {
+			return nil, LogErr("Internal error", err)
+		} else if !ok {
+			return nil, LogErr("Value error", fmt.Errorf("comment not found in this tension."))
+		}
+	}
+
+	// Store the file
+	rootnameid, err := codec.Nid2rootid(tension.Receiverid)
+	if err != nil {
+		return nil, LogErr("Internal error", err)
+	}
+	attachment, err := StoreAttachment(uctx, rootnameid, file.Filename, file.File, file.Size)
+	if err != nil {
+		return nil, LogErr("Value error", err)
+	}
+	attachment.Tension = &model.TensionRef{ID: &tid}
+	if cid != nil {
+		attachment.Comment = &model.CommentRef{ID: cid}
+	}
+
+	var input model.AddAttachmentInput
+	StructMap(attachment, &input)
+	id, err := db.GetDB().Add(db.GetDB().GetRootUctx(), "attachment", input)
+	if err != nil {
+		storage.GetStorage().Delete(*attachment.Key)
+		return nil, LogErr("Internal error", err)
+	}
+
+	return &model.Attachment{
+		ID:          id,
+		CreatedBy:   &model.User{Username: uctx.Username},
+		CreatedAt:   *attachment.CreatedAt,
+		Tension:     &model.Tension{ID: tid},
+		Filename:    *attachment.Filename,
+		ContentType: *attachment.ContentType,
+		Size:        *attachment.Size,
+		Key:         *attachment.Key,
+	}, nil
+}
+
+// StoreAttachment checks and stores the file in the storage backend, under the
+// given organisation. It returns the attachment, to be linked to a tension or a comment.
+func StoreAttachment(uctx *model.UserCtx, rootnameid, filename string, r io.ReadSeeker, size int64) (*model.AttachmentRef, error) {
+	if filename == "" {
+		filename = "attachment"
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("%s/%s", rootnameid, id.String())
+	contentType, err := storage.Save(key, filename, r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	now := Now()
+	s := int(size)
+	return &model.AttachmentRef{
+		CreatedBy:   &model.UserRef{Username: &uctx.Username},
+		CreatedAt:   &now,
+		Filename:    &filename,
+		ContentType: &contentType,
+		Size:        &s,
+		Key:         &key,
+	}, nil
+}
+
+// DeleteAttachmentFiles removes the files of the given attachments from the storage backend.
+func DeleteAttachmentFiles(keys []string) error {
+	s := storage.GetStorage()
+	if s == nil {
+		return nil
+	}
+	for _, k := range keys {
+		if err := s.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+////////////////////////////////////////////////
+// Attachment Resolver
+////////////////////////////////////////////////
+
+// Delete Attachment - Must be the author or coordo of the tension receiver
+func deleteAttachmentHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var filter model.AttachmentFilter
+	ExtractFilter(ctx, &filter)
+	if len(filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	var keys []string
+	for _, id := range filter.ID {
+		a, err := db.GetDB().GetAttachment(id)
+		if err != nil || a == nil {
+			return nil, LogErr("Access denied", fmt.Errorf("attachment not found."))
+		}
+		if err = checkAttachmentEdit(uctx, a); err != nil {
+			return nil, err
+		}
+		keys = append(keys, a.Key)
+	}
+
+	// Forward Query
+	data, err := next(ctx)
+	if err != nil {
+		return data, err
+	}
+
+	// Remove the files
+	if err = DeleteAttachmentFiles(keys); err != nil {
+		return data, LogErr("Internal error", err)
+	}
+	return data, err
+}
+
+// checkAttachmentEdit checks that the user can remove the given attachment.
+func checkAttachmentEdit(uctx *model.UserCtx, a *model.Attachment) error {
+	if a.CreatedBy != nil && a.CreatedBy.Username == uctx.Username {
+		return nil
+	}
+	if a.Tension == nil {
+		return LogErr("Access denied", fmt.Errorf("attachment tension not found."))
+	}
+	ok, err := auth.HasCoordoAuth(uctx, a.Tension.Receiverid, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return LogErr("Access denied", fmt.Errorf("Only the author or the coordinators can remove an attachment."))
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"fmt"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+// Maximum number of tensions in a bulk operation.
+const maxBulkTensions = 500
+
+// BulkTensionOp applies one change to a set of tensions.
+// Each tension is authorized through the TensionEventHook, and the result is
+// returned for each of them. The events of the successful items are published
+// as one batch, so that each recipient gets one email.
+func BulkTensionOp(uctx *model.UserCtx, form model.BulkTensionForm) ([]model.BulkTensionResult, error) {
+	var results []model.BulkTensionResult
+	if len(form.Tids) == 0 {
+		return results, LogErr("Value error", fmt.Errorf("No tension given."))
+	} else if len(form.Tids) > maxBulkTensions {
+		return results, LogErr("Value error", fmt.Errorf("Too many tensions (max %d).", maxBulkTensions))
+	}
+	for _, tid := range form.Tids {
+		if !IsUid(tid) {
+			return results, LogErr("Value error", fmt.Errorf("Invalid tension id: %s", tid))
+		}
+	}
+	var value string
+	if form.Value != nil {
+		value = *form.Value
+	}
+
+	// Resolve the action value
+	var eventType model.TensionEvent
+	var eventOld, eventNew string
+	var labelRootnameid string
+	var set, remove *model.TensionPatch
+	switch form.Action {
+	case model.BulkTensionActionClose:
+		// The status is propagated by the event.
+		eventType = model.TensionEventClosed
+		eventOld = string(model.TensionStatusOpen)
+		eventNew = string(model.TensionStatusClosed)
+	case model.BulkTensionActionReopen:
+		eventType = model.TensionEventReopened
+		eventOld = string(model.TensionStatusClosed)
+		eventNew = string(model.TensionStatusOpen)
+	case model.BulkTensionActionAddLabel, model.BulkTensionActionRemoveLabel:
+		if !IsUid(value) {
+			return results, LogErr("Value error", fmt.Errorf("Invalid label id: %s", value))
+		}
+		label, err := db.GetDB().GetFieldById(value, "Label.name Label.color Label.rootnameid")
+		if err != nil {
+			return results, err
+		}
+		l, ok := label.(model.JsonAtom)
+		if ok {
+			labelRootnameid, _ = l["rootnameid"].(string)
+		}
+		if labelRootnameid == "" {
+			return results, LogErr("Value error", fmt.Errorf("label not found."))
+		}
+		name, _ := l["name"].(string)
+		color, _ := l["color"].(string)
+		eventNew = name + "§" + color
+		patch := &model.TensionPatch{Labels: []*model.LabelRef{&model.LabelRef{ID: &value}}}
+		if form.Action == model.BulkTensionActionAddLabel {
+			eventType = model.TensionEventLabelAdded
+			set = patch
+		} else {
+			eventType = model.TensionEventLabelRemoved
+			remove = patch
+		}
+	case model.BulkTensionActionAssign:
+		eventType = model.TensionEventAssigneeAdded
+		eventNew = value
+		set = &model.TensionPatch{Assignees: []*model.UserRef{&model.UserRef{Username: &value}}}
+	case model.BulkTensionActionMove:
+		// The move is done by the event action.
+		eventType = model.TensionEventMoved
+		eventNew = value
+	case model.BulkTensionActionAddToProject:
+		return bulkAddToProject(uctx, form.Tids, value)
+	default:
+		return results, LogErr("Value error", fmt.Errorf("Unknown bulk action: %s", form.Action))
+	}
+
+	var notifs []model.EventNotif
+	for _, tid := range form.Tids {
+		result := model.BulkTensionResult{Tid: tid}
+		now := Now()
+		event := &model.EventRef{
+			CreatedAt: &now,
+			CreatedBy: &model.UserRef{Username: &uctx.Username},
+			EventType: &eventType,
+		}
+		if eventOld != "" {
+			old := eventOld
+			event.Old = &old
+		}
+		if eventNew != "" {
+			new := eventNew
+			event.New = &new
+		}
+		if eventType == model.TensionEventMoved || labelRootnameid != "" {
+			receiverid, err := db.GetDB().GetFieldById(tid, "Tension.receiverid")
+			if err != nil || receiverid == nil {
+				result.Error = "tension not found."
+				results = append(results, result)
+				continue
+			}
+			if eventType == model.TensionEventMoved {
+				// Moved events need the source receiver.
+				old := receiverid.(string)
+				event.Old = &old
+			} else if rootnameid, _ := codec.Nid2rootid(receiverid.(string)); rootnameid != labelRootnameid {
+				result.Error = "The label does not belong to the organisation of this tension."
+				results = append(results, result)
+				continue
+			}
+		}
+
+		// Authorize and process the event
+		ok, contract, err := TensionEventHook(uctx, tid, []*model.EventRef{event}, nil)
+		if err != nil {
+			result.Error = err.Error()
+		} else if !ok && contract != nil {
+			result.Error = "This change requires a contract, it cannot be done in bulk."
+		} else if !ok {
+			result.Error = "Access denied."
+		} else {
+			// Apply the change
+			if set != nil || remove != nil {
+				input := model.UpdateTensionInput{
+					Filter: &model.TensionFilter{ID: []string{tid}},
+					Set:    set,
+					Remove: remove,
+				}
+				err = db.GetDB().Update(db.GetDB().GetRootUctx(), "tension", input)
+			}
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Ok = true
+				notifs = append(notifs, model.EventNotif{Uctx: uctx, Tid: tid, History: []*model.EventRef{event}})
+			}
+		}
+		results = append(results, result)
+	}
+
+	if len(notifs) > 0 {
+		PublishBulkTensionEvent(model.BulkEventNotif{Uctx: uctx, Notifs: notifs})
+	}
+	return results, nil
+}
+
+// bulkAddToProject adds the tensions to the given project column.
+// Each tension is authorized through the ProjectAdded event, and tensions
+// already in the project are skipped.
+func bulkAddToProject(uctx *model.UserCtx, tids []string, colid string) ([]model.BulkTensionResult, error) {
+	var results []model.BulkTensionResult
+	if !IsUid(colid) {
+		return results, LogErr("Value error", fmt.Errorf("Invalid project column id: %s", colid))
+	}
+	x, err := db.GetDB().GetSubFieldById(colid, "ProjectColumn.project", "uid")
+	if err != nil {
+		return results, err
+	}
+	if x == nil {
+		return results, LogErr("Value error", fmt.Errorf("project column not found."))
+	}
+	projectid := x.(string)
+
+	// Check project auth
+	if err = auth.CheckProjectAuth(uctx, projectid); err != nil {
+		return results, err
+	}
+
+	var notifs []model.EventNotif
+	for _, tid := range tids {
+		result := model.BulkTensionResult{Tid: tid}
+		now := Now()
+		eventType := model.TensionEventProjectAdded
+		pid := projectid
+		event := &model.EventRef{
+			CreatedAt: &now,
+			CreatedBy: &model.UserRef{Username: &uctx.Username},
+			EventType: &eventType,
+			New:       &pid,
+		}
+		if in, err := db.GetDB().IsTensionInProject(tid, projectid); err != nil {
+			result.Error = err.Error()
+		} else if in {
+			result.Error = "The tension is already in this project."
+		} else if ok, _, err := TensionEventHook(uctx, tid, []*model.EventRef{event}, nil); err != nil {
+			result.Error = err.Error()
+		} else if !ok {
+			result.Error = "Access denied."
+		} else if cardid, err := db.GetDB().Add(db.GetDB().GetRootUctx(), "projectCard", model.AddProjectCardInput{
+			Pos:  0,
+			Card: &model.CardKindRef{TensionRef: &model.TensionRef{ID: &tid}},
+			Pc:   &model.ProjectColumnRef{ID: &colid},
+		}); err != nil {
+			result.Error = err.Error()
+		} else if _, err := db.GetDB().Meta("incrementCardPos", map[string]string{"cardid": cardid, "now": Now()}); err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Ok = true
+			notifs = append(notifs, model.EventNotif{Uctx: uctx, Tid: tid, History: []*model.EventRef{event}})
+		}
+		results = append(results, result)
+	}
+
+	if len(notifs) > 0 {
+		PublishBulkTensionEvent(model.BulkEventNotif{Uctx: uctx, Notifs: notifs})
+	}
+	return results, nil
+}
@@ -84,6 +84,22 @@ func contractEventHook(uctx *model.UserCtx, cid, tid string, event *model.EventR
 				return false, contract, fmt.Errorf("Candidate '%s' is already member.", c.Email)
 			}
 		}
+	case model.TensionEventOwnerAdded, model.TensionEventOwnerTransferred:
+		if !codec.IsRoot(contract.Tension.Receiverid) {
+			return false, contract, fmt.Errorf("Ownership can only be changed in the root circle.")
+		}
+		if len(contract.Candidates) != 1 || contract.Event.New == nil || contract.Candidates[0].Username != *contract.Event.New {
+			return false, contract, fmt.Errorf("The new owner must be the contract candidate.")
+		}
+		if len(contract.PendingCandidates) > 0 {
+			return false, contract, fmt.Errorf("The new owner must be a member of the organisation.")
+		}
+		if contract.Event.EventType == model.TensionEventOwnerTransferred {
+			// One can only transfer its own ownership.
+			if contract.Event.Old == nil || *contract.Event.Old != uctx.Username {
+				return false, contract, fmt.Errorf("You can only transfer your own ownership.")
+			}
+		}
 	case model.TensionEventMemberLinked:
 		// pass, this shouldn't be a security flaw.
 		// @todo: check if role has already a first-link.
@@ -93,7 +109,8 @@ func contractEventHook(uctx *model.UserCtx, cid, tid string, event *model.EventR
 		}
 	}
 
-	// Process event
+	// Process event (the contract author is the event author)
+	event.CreatedBy = &model.UserRef{Username: &uctx.Username}
 	ok, contract, err = ProcessEvent(uctx, tension, event, nil, contract, true, true)
 	return (ok || contract != nil), contract, err
 }
@@ -120,6 +137,9 @@ func voteEventHook(uctx *model.UserCtx, cid string) (bool, *model.Contract, erro
 	// Process event
 	var event model.EventRef
 	StructMap(contract.Event, &event)
+	if contract.CreatedBy != nil {
+		event.CreatedBy = &model.UserRef{Username: &contract.CreatedBy.Username}
+	}
 	ok, contract, err = ProcessEvent(uctx, tension, &event, nil, contract, true, true)
 	if contract == nil || err != nil {
 		return false, contract, err
@@ -137,6 +157,79 @@ func voteEventHook(uctx *model.UserCtx, cid string) (bool, *model.Contract, erro
 	return ok, contract, err
 }
 
+// contractOutcomeHook take action once a vote has been processed,
+// depending on the new status of the contract.
+func contractOutcomeHook(uctx *model.UserCtx, contract *model.Contract) error {
+	if contract.Status == model.ContractStatusCanceled {
+		// Eventually reset the pending node state
+		if contract.Event.EventType == model.TensionEventMemberLinked || contract.Event.EventType == model.TensionEventUserJoined {
+			for _, c := range contract.Candidates {
+				err := MaybeDeletePendingNode(c.Username, contract.Tension)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Notify user of the cancel
+		msg := fmt.Sprintf("Contract %s has been cancelled.", contract.ID)
+		var to []string
+		for _, p := range contract.Participants {
+			to = append(to, p.Node.FirstLink.Username)
+		}
+		PublishNotifEvent(model.NotifNotif{Uctx: uctx, Tid: &contract.Tension.ID, Cid: &contract.ID, Msg: msg, To: to})
+	} else if contract.Status == model.ContractStatusClosed {
+		PublishContractEvent(model.ContractNotif{Uctx: uctx, Tid: contract.Tension.ID, Contract: contract, ContractEvent: model.CloseContract})
+	}
+	return nil
+}
+
+// AddVote add (or replace) the vote of the user on the given contract,
+// and process it as the addVote mutation does (see addVoteHook).
+// Used to vote from outside the GraphQL API (e.g. email replies).
+func AddVote(uctx *model.UserCtx, contract *model.Contract, vote int) (*model.Contract, error) {
+	rid, err := codec.Nid2rootid(contract.Tension.Receiverid)
+	if err != nil {
+		return nil, err
+	}
+	cid := contract.Contractid
+	nameid := codec.MemberIdCodec(rid, uctx.Username)
+	createdAt := Now()
+	upsert := true
+	input := model.AddVoteInput{
+		CreatedBy: &model.UserRef{Username: &uctx.Username},
+		CreatedAt: createdAt,
+		Voteid:    cid + "#" + nameid,
+		Contract:  &model.ContractRef{Contractid: &cid},
+		Node:      &model.NodeRef{Nameid: &nameid},
+		Data:      []int{vote},
+	}
+
+	// Try to add vote
+	var data model.AddVotePayload
+	err = db.GetDB().AddExtra(*uctx, "vote", []model.AddVoteInput{input}, &upsert, "vote { id }", &data)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Vote) == 0 {
+		return nil, fmt.Errorf("no vote added.")
+	}
+
+	// Post process vote
+	ok, c, err := voteEventHook(uctx, cid)
+	if err != nil {
+		e := db.GetDB().Delete(*uctx, "vote", model.VoteFilter{ID: []string{data.Vote[0].ID}})
+		if e != nil {
+			panic(e)
+		}
+		return nil, err
+	} else if !ok {
+		return c, err
+	}
+
+	return c, contractOutcomeHook(uctx, c)
+}
+
 // HasContractRight check if user has validation rights (Coordo right like).
 func HasContractRight(uctx *model.UserCtx, contract *model.Contract) (bool, error) {
 	var event model.EventRef
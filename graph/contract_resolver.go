@@ -387,26 +387,8 @@ func addVoteHook(ctx context.Context, obj interface{}, next graphql.Resolver) (i
 		return d, err
 	}
 
-	if contract.Status == model.ContractStatusCanceled {
-		// Eventually reset the pending node state
-		if contract.Event.EventType == model.TensionEventMemberLinked || contract.Event.EventType == model.TensionEventUserJoined {
-			for _, c := range contract.Candidates {
-				err = MaybeDeletePendingNode(c.Username, contract.Tension)
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
-
-		// Notify user of the cancel
-		msg := fmt.Sprintf("Contract %s has been cancelled.", contract.ID)
-		var to []string
-		for _, p := range contract.Participants {
-			to = append(to, p.Node.FirstLink.Username)
-		}
-		PublishNotifEvent(model.NotifNotif{Uctx: uctx, Tid: &contract.Tension.ID, Cid: &contract.ID, Msg: msg, To: to})
-	} else if contract.Status == model.ContractStatusClosed {
-		PublishContractEvent(model.ContractNotif{Uctx: uctx, Tid: contract.Tension.ID, Contract: contract, ContractEvent: model.CloseContract})
+	if err = contractOutcomeHook(uctx, contract); err != nil {
+		return nil, err
 	}
 
 	data.Vote[0].Contract = contract
@@ -0,0 +1,107 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/model"
+)
+
+// Number of consecutive hard bounces after which the email notifications
+// of the user are disabled.
+var maxHardBounces int64
+
+func init() {
+	maxHardBounces = viper.GetInt64("mailer.max_hard_bounces")
+	if maxHardBounces <= 0 {
+		maxHardBounces = 3
+	}
+}
+
+// deliveryStatsKey returns the key of the delivery stats of the given address.
+// Stats are a hash with a counter per EmailStatus, plus the number of
+// consecutive hard bounces and the last status received.
+func deliveryStatsKey(address string) string {
+	return fmt.Sprintf("delivery:%s", strings.ToLower(address))
+}
+
+// GetDeliveryStats returns the delivery stats of the given address.
+func GetDeliveryStats(address string) (map[string]string, error) {
+	return cache.HGetAll(ctx, deliveryStatsKey(address)).Result()
+}
+
+// ProcessDeliveryEvent records a delivery event of an email sent to the given
+// address and updates the email status of the matching user. The email
+// notifications are disabled after a complaint or repeated hard bounces.
+func ProcessDeliveryEvent(address string, status model.EmailStatus) error {
+	if !status.IsValid() {
+		return fmt.Errorf("unknown email status: %s", status)
+	}
+
+	// Update the address stats
+	key := deliveryStatsKey(address)
+	var hardBounces *redis.IntCmd
+	_, err := cache.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, key, string(status), 1)
+		pipe.HSet(ctx, key, "last", string(status), "lastAt", time.Now().UTC().Format(time.RFC3339))
+		switch status {
+		case model.EmailStatusHardBounced:
+			hardBounces = pipe.HIncrBy(ctx, key, "consecutiveHardBounces", 1)
+		case model.EmailStatusDelivered:
+			pipe.HSet(ctx, key, "consecutiveHardBounces", 0)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Update the user status
+	res, err := db.GetDB().GetFieldByEq("User.email", strings.ToLower(address), "User.username User.emailStatus")
+	if err != nil || res == nil {
+		// Not a user (pending user...)
+		return err
+	}
+	user := res.(map[string]interface{})
+	username, _ := user["username"].(string)
+	if s, _ := user["emailStatus"].(string); s != string(status) {
+		if err := db.GetDB().SetFieldByEq("User.username", username, "User.emailStatus", string(status)); err != nil {
+			return err
+		}
+	}
+
+	// Disable the email notifications
+	if status == model.EmailStatusComplained ||
+		(hardBounces != nil && hardBounces.Val() >= maxHardBounces) {
+		log.Printf("Disabling email notifications for %s (%s)", username, status)
+		return db.GetDB().SetFieldByEq("User.username", username, "User.notifyByEmail", "false")
+	}
+
+	return nil
+}
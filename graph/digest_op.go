@@ -0,0 +1,172 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/email"
+)
+
+// Period of each digest delivery mode.
+// Digests are sent at the start of each period (UTC), weeks start on Monday.
+var digestPeriods = map[model.EmailDelivery]time.Duration{
+	model.EmailDeliveryHourly: time.Hour,
+	model.EmailDeliveryDaily:  24 * time.Hour,
+	model.EmailDeliveryWeekly: 7 * 24 * time.Hour,
+}
+
+// Queued notifications expire if they are not sent in time.
+var digestTTL time.Duration = 8 * 24 * time.Hour
+
+func digestQueueKey(delivery model.EmailDelivery, username string) string {
+	return fmt.Sprintf("digest:%s:%s", delivery, username)
+}
+
+func digestUsersKey(delivery model.EmailDelivery) string {
+	return fmt.Sprintf("digest:%s:users", delivery)
+}
+
+// QueueEmailDigest queues the event notification for the next digest email
+// of the user, according to their delivery mode.
+func QueueEmailDigest(ui model.UserNotifInfo, notif model.EventNotif) error {
+	delivery := *ui.User.EmailDelivery
+	if _, ok := digestPeriods[delivery]; !ok {
+		return fmt.Errorf("unknown email delivery mode: %s", delivery)
+	}
+	payload, err := json.Marshal(model.DigestNotif{Notif: notif, Eid: ui.Eid, Reason: ui.Reason})
+	if err != nil {
+		return err
+	}
+
+	key := digestQueueKey(delivery, ui.User.Username)
+	_, err = cache.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.RPush(ctx, key, payload)
+		pipe.Expire(ctx, key, digestTTL)
+		pipe.SAdd(ctx, digestUsersKey(delivery), ui.User.Username)
+		return nil
+	})
+	return err
+}
+
+// PushEmailDigests sends the digest emails of the periods that started since
+// the last call. Each period is sent once, even with several notifiers.
+func PushEmailDigests() error {
+	now := time.Now().UTC()
+	for _, delivery := range []model.EmailDelivery{
+		model.EmailDeliveryHourly,
+		model.EmailDeliveryDaily,
+		model.EmailDeliveryWeekly,
+	} {
+		start := now.Truncate(digestPeriods[delivery]).Format(time.RFC3339)
+		last, err := cache.GetSet(ctx, fmt.Sprintf("digest:%s:last", delivery), start).Result()
+		if err != nil && err != redis.Nil {
+			return err
+		} else if last == start {
+			continue
+		}
+
+		if err := pushEmailDigests(delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pushEmailDigests sends the queued notifications of each user of the given delivery mode.
+func pushEmailDigests(delivery model.EmailDelivery) error {
+	usernames, err := cache.SMembers(ctx, digestUsersKey(delivery)).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, username := range usernames {
+		// Pop the user queue
+		var items *redis.StringSliceCmd
+		key := digestQueueKey(delivery, username)
+		_, err := cache.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			items = pipe.LRange(ctx, key, 0, -1)
+			pipe.Del(ctx, key)
+			pipe.SRem(ctx, digestUsersKey(delivery), username)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := pushEmailDigest(delivery, username, items.Val()); err != nil {
+			log.Printf("Digest %s: email failed for %s: %v", delivery, username, err)
+		}
+	}
+
+	return nil
+}
+
+// pushEmailDigest sends one digest email with the notifications that are still unread.
+func pushEmailDigest(delivery model.EmailDelivery, username string, items []string) error {
+	var notifs []model.DigestNotif
+	var eids []string
+	for _, item := range items {
+		var n model.DigestNotif
+		if err := json.Unmarshal([]byte(item), &n); err != nil {
+			return err
+		}
+		notifs = append(notifs, n)
+		eids = append(eids, n.Eid)
+	}
+
+	// Skip the events already read on the platform.
+	unread, err := db.GetDB().GetUnreadUserEvents(eids)
+	if err != nil {
+		return err
+	}
+	var digest []model.DigestNotif
+	for _, n := range notifs {
+		if IndexOf(unread, n.Eid) >= 0 {
+			digest = append(digest, n)
+		}
+	}
+	if len(digest) == 0 {
+		return nil
+	}
+
+	res, err := db.GetDB().GetFieldByEq("User.username", username, "User.username User.email User.name User.lang")
+	if err != nil {
+		return err
+	} else if res == nil {
+		return fmt.Errorf("user not found: %s", username)
+	}
+	var user model.User
+	if err := Map2Struct(res.(model.JsonAtom), &user); err != nil {
+		return err
+	}
+
+	return email.SendDigestEmail(user, delivery, digest)
+}
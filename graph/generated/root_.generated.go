@@ -38,137 +38,198 @@ type ResolverRoot interface {
 }
 
 type DirectiveRoot struct {
-	Auth                          func(ctx context.Context, obj interface{}, next graphql.Resolver, password *model.AuthRule, query *model.AuthRule, add *model.AuthRule, update *model.AuthRule, delete *model.AuthRule) (res interface{}, err error)
-	CacheControl                  func(ctx context.Context, obj interface{}, next graphql.Resolver, maxAge int) (res interface{}, err error)
-	Cascade                       func(ctx context.Context, obj interface{}, next graphql.Resolver, fields []*string) (res interface{}, err error)
-	Custom                        func(ctx context.Context, obj interface{}, next graphql.Resolver, http *model.CustomHTTP, dql *string) (res interface{}, err error)
-	Dgraph                        func(ctx context.Context, obj interface{}, next graphql.Resolver, typeArg *string, pred *string) (res interface{}, err error)
-	Generate                      func(ctx context.Context, obj interface{}, next graphql.Resolver, query *model.GenerateQueryParams, mutation *model.GenerateMutationParams, subscription *bool) (res interface{}, err error)
-	HasInverse                    func(ctx context.Context, obj interface{}, next graphql.Resolver, field string) (res interface{}, err error)
-	Hidden                        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addComment               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addCommentInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addContract              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addContractInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addLabel                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addLabelInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addProject               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addProjectCard           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addProjectCardInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addProjectColumn         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addProjectColumnInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addProjectDraft          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addProjectDraftInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addProjectInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addReaction              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addReactionInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addRoleExt               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addRoleExtInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addTension               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addTensionInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addUser                  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addUserInput             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addVote                  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_addVoteInput             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteComment            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteCommentInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteContract           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteContractInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteLabel              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteLabelInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteProject            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteProjectCard        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteProjectCardInput   func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteProjectColumn      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteProjectColumnInput func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteProjectDraft       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteProjectDraftInput  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteProjectInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteReaction           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteReactionInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteRoleExt            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteRoleExtInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteTension            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteTensionInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteUser               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteUserInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteVote               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_deleteVoteInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getCommentInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getContractInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getLabelInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getProjectCardInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getProjectColumnInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getProjectDraftInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getProjectInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getReactionInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getRoleExtInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getTensionInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getUserInput             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_getVoteInput             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryCommentInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryContractInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryLabelInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryProjectCardInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryProjectColumnInput  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryProjectDraftInput   func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryProjectInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryReactionInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryRoleExtInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryTensionInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryUserInput           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_queryVoteInput           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateComment            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateCommentInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateContract           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateContractInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateLabel              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateLabelInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateProject            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateProjectCard        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateProjectCardInput   func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateProjectColumn      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateProjectColumnInput func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateProjectDraft       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateProjectDraftInput  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateProjectInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateReaction           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateReactionInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateRoleExt            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateRoleExtInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateTension            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateTensionInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateUser               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateUserInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateVote               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Hook_updateVoteInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Id                            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	IsContractValidator           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Lambda                        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	LambdaOnMutate                func(ctx context.Context, obj interface{}, next graphql.Resolver, add *bool, update *bool, delete *bool) (res interface{}, err error)
-	Meta                          func(ctx context.Context, obj interface{}, next graphql.Resolver, f string, k *string) (res interface{}, err error)
-	Private                       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	Remote                        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	RemoteResponse                func(ctx context.Context, obj interface{}, next graphql.Resolver, name *string) (res interface{}, err error)
-	Search                        func(ctx context.Context, obj interface{}, next graphql.Resolver, by []model.DgraphIndex) (res interface{}, err error)
-	Secret                        func(ctx context.Context, obj interface{}, next graphql.Resolver, field string, pred *string) (res interface{}, err error)
-	W_add                         func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
-	W_alter                       func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
-	W_meta_patch                  func(ctx context.Context, obj interface{}, next graphql.Resolver, f string, k *string) (res interface{}, err error)
-	W_patch                       func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
-	W_remove                      func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
-	W_set                         func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
-	WithSubscription              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	X_add                         func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
-	X_alter                       func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
-	X_patch                       func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
-	X_patch_ro                    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	X_remove                      func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
-	X_ro                          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
-	X_set                         func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
+	Auth                            func(ctx context.Context, obj interface{}, next graphql.Resolver, password *model.AuthRule, query *model.AuthRule, add *model.AuthRule, update *model.AuthRule, delete *model.AuthRule) (res interface{}, err error)
+	CacheControl                    func(ctx context.Context, obj interface{}, next graphql.Resolver, maxAge int) (res interface{}, err error)
+	Cascade                         func(ctx context.Context, obj interface{}, next graphql.Resolver, fields []*string) (res interface{}, err error)
+	Custom                          func(ctx context.Context, obj interface{}, next graphql.Resolver, http *model.CustomHTTP, dql *string) (res interface{}, err error)
+	Dgraph                          func(ctx context.Context, obj interface{}, next graphql.Resolver, typeArg *string, pred *string) (res interface{}, err error)
+	Generate                        func(ctx context.Context, obj interface{}, next graphql.Resolver, query *model.GenerateQueryParams, mutation *model.GenerateMutationParams, subscription *bool) (res interface{}, err error)
+	HasInverse                      func(ctx context.Context, obj interface{}, next graphql.Resolver, field string) (res interface{}, err error)
+	Hidden                          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addAttachment              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addAttachmentInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addComment                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addCommentInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addContract                func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addContractInput           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addLabel                   func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addLabelInput              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addMailingSetting          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addMailingSettingInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addMatrixRoom              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addMatrixRoomInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addNotifPreference         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addNotifPreferenceInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addProject                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addProjectCard             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addProjectCardInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addProjectColumn           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addProjectColumnInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addProjectDraft            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addProjectDraftInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addProjectInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addReaction                func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addReactionInput           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addRecurrence              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addRecurrenceInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addRoleExt                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addRoleExtInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addTension                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addTensionInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addTensionTemplate         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addTensionTemplateInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addTensionView             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addTensionViewInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addUser                    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addUserInput               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addVote                    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_addVoteInput               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteAttachment           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteAttachmentInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteComment              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteCommentInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteContract             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteContractInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteLabel                func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteLabelInput           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteMailingSetting       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteMailingSettingInput  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteMatrixRoom           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteMatrixRoomInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteNotifPreference      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteNotifPreferenceInput func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteProject              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteProjectCard          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteProjectCardInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteProjectColumn        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteProjectColumnInput   func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteProjectDraft         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteProjectDraftInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteProjectInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteReaction             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteReactionInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteRecurrence           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteRecurrenceInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteRoleExt              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteRoleExtInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteTension              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteTensionInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteTensionTemplate      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteTensionTemplateInput func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteTensionView          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteTensionViewInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteUser                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteUserInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteVote                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_deleteVoteInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getAttachmentInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getCommentInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getContractInput           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getLabelInput              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getMailingSettingInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getMatrixRoomInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getNotifPreferenceInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getProjectCardInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getProjectColumnInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getProjectDraftInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getProjectInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getReactionInput           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getRecurrenceInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getRoleExtInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getTensionInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getTensionTemplateInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getTensionViewInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getUserInput               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_getVoteInput               func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryAttachmentInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryCommentInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryContractInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryLabelInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryMailingSettingInput   func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryMatrixRoomInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryNotifPreferenceInput  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryProjectCardInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryProjectColumnInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryProjectDraftInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryProjectInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryReactionInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryRecurrenceInput       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryRoleExtInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryTensionInput          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryTensionTemplateInput  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryTensionViewInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryUserInput             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_queryVoteInput             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateAttachment           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateAttachmentInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateComment              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateCommentInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateContract             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateContractInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateLabel                func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateLabelInput           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateMailingSetting       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateMailingSettingInput  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateMatrixRoom           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateMatrixRoomInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateNotifPreference      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateNotifPreferenceInput func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateProject              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateProjectCard          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateProjectCardInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateProjectColumn        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateProjectColumnInput   func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateProjectDraft         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateProjectDraftInput    func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateProjectInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateReaction             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateReactionInput        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateRecurrence           func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateRecurrenceInput      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateRoleExt              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateRoleExtInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateTension              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateTensionInput         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateTensionTemplate      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateTensionTemplateInput func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateTensionView          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateTensionViewInput     func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateUser                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateUserInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateVote                 func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Hook_updateVoteInput            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Id                              func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	IsContractValidator             func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Lambda                          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	LambdaOnMutate                  func(ctx context.Context, obj interface{}, next graphql.Resolver, add *bool, update *bool, delete *bool) (res interface{}, err error)
+	Meta                            func(ctx context.Context, obj interface{}, next graphql.Resolver, f string, k *string) (res interface{}, err error)
+	Private                         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Remote                          func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	RemoteResponse                  func(ctx context.Context, obj interface{}, next graphql.Resolver, name *string) (res interface{}, err error)
+	Search                          func(ctx context.Context, obj interface{}, next graphql.Resolver, by []model.DgraphIndex) (res interface{}, err error)
+	Secret                          func(ctx context.Context, obj interface{}, next graphql.Resolver, field string, pred *string) (res interface{}, err error)
+	W_add                           func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
+	W_alter                         func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
+	W_meta_patch                    func(ctx context.Context, obj interface{}, next graphql.Resolver, f string, k *string) (res interface{}, err error)
+	W_patch                         func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
+	W_remove                        func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
+	W_set                           func(ctx context.Context, obj interface{}, next graphql.Resolver, a string) (res interface{}, err error)
+	WithSubscription                func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	X_add                           func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
+	X_alter                         func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
+	X_patch                         func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
+	X_patch_ro                      func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	X_remove                        func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
+	X_ro                            func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	X_set                           func(ctx context.Context, obj interface{}, next graphql.Resolver, r *string, f *string, e []model.TensionEvent, n *int) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
+	AddAttachmentPayload struct {
+		Attachment func(childComplexity int, filter *model.AttachmentFilter, order *model.AttachmentOrder, first *int, offset *int) int
+		NumUids    func(childComplexity int) int
+	}
+
 	AddBlobPayload struct {
 		Blob    func(childComplexity int, filter *model.BlobFilter, order *model.BlobOrder, first *int, offset *int) int
 		NumUids func(childComplexity int) int
@@ -204,11 +265,21 @@ type ComplexityRoot struct {
 		NumUids func(childComplexity int) int
 	}
 
+	AddMailingSettingPayload struct {
+		MailingSetting func(childComplexity int, filter *model.MailingSettingFilter, order *model.MailingSettingOrder, first *int, offset *int) int
+		NumUids        func(childComplexity int) int
+	}
+
 	AddMandatePayload struct {
 		Mandate func(childComplexity int, filter *model.MandateFilter, order *model.MandateOrder, first *int, offset *int) int
 		NumUids func(childComplexity int) int
 	}
 
+	AddMatrixRoomPayload struct {
+		MatrixRoom func(childComplexity int, filter *model.MatrixRoomFilter, order *model.MatrixRoomOrder, first *int, offset *int) int
+		NumUids    func(childComplexity int) int
+	}
+
 	AddNodeFragmentPayload struct {
 		NodeFragment func(childComplexity int, filter *model.NodeFragmentFilter, order *model.NodeFragmentOrder, first *int, offset *int) int
 		NumUids      func(childComplexity int) int
@@ -224,6 +295,11 @@ type ComplexityRoot struct {
 		NumUids func(childComplexity int) int
 	}
 
+	AddNotifPreferencePayload struct {
+		NotifPreference func(childComplexity int, filter *model.NotifPreferenceFilter, order *model.NotifPreferenceOrder, first *int, offset *int) int
+		NumUids         func(childComplexity int) int
+	}
+
 	AddPendingUserPayload struct {
 		NumUids     func(childComplexity int) int
 		PendingUser func(childComplexity int, filter *model.PendingUserFilter, order *model.PendingUserOrder, first *int, offset *int) int
@@ -264,6 +340,11 @@ type ComplexityRoot struct {
 		Reaction func(childComplexity int, filter *model.ReactionFilter, order *model.ReactionOrder, first *int, offset *int) int
 	}
 
+	AddRecurrencePayload struct {
+		NumUids    func(childComplexity int) int
+		Recurrence func(childComplexity int, filter *model.RecurrenceFilter, order *model.RecurrenceOrder, first *int, offset *int) int
+	}
+
 	AddRoleExtPayload struct {
 		NumUids func(childComplexity int) int
 		RoleExt func(childComplexity int, filter *model.RoleExtFilter, order *model.RoleExtOrder, first *int, offset *int) int
@@ -274,6 +355,16 @@ type ComplexityRoot struct {
 		Tension func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
 	}
 
+	AddTensionTemplatePayload struct {
+		NumUids         func(childComplexity int) int
+		TensionTemplate func(childComplexity int, filter *model.TensionTemplateFilter, order *model.TensionTemplateOrder, first *int, offset *int) int
+	}
+
+	AddTensionViewPayload struct {
+		NumUids     func(childComplexity int) int
+		TensionView func(childComplexity int, filter *model.TensionViewFilter, order *model.TensionViewOrder, first *int, offset *int) int
+	}
+
 	AddUserEventPayload struct {
 		NumUids   func(childComplexity int) int
 		UserEvent func(childComplexity int, filter *model.UserEventFilter, order *model.UserEventOrder, first *int, offset *int) int
@@ -294,6 +385,34 @@ type ComplexityRoot struct {
 		Vote    func(childComplexity int, filter *model.VoteFilter, order *model.VoteOrder, first *int, offset *int) int
 	}
 
+	Attachment struct {
+		Comment     func(childComplexity int, filter *model.CommentFilter) int
+		ContentType func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		CreatedBy   func(childComplexity int, filter *model.UserFilter) int
+		Filename    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Key         func(childComplexity int) int
+		Size        func(childComplexity int) int
+		Tension     func(childComplexity int, filter *model.TensionFilter) int
+	}
+
+	AttachmentAggregateResult struct {
+		ContentTypeMax func(childComplexity int) int
+		ContentTypeMin func(childComplexity int) int
+		Count          func(childComplexity int) int
+		CreatedAtMax   func(childComplexity int) int
+		CreatedAtMin   func(childComplexity int) int
+		FilenameMax    func(childComplexity int) int
+		FilenameMin    func(childComplexity int) int
+		KeyMax         func(childComplexity int) int
+		KeyMin         func(childComplexity int) int
+		SizeAvg        func(childComplexity int) int
+		SizeMax        func(childComplexity int) int
+		SizeMin        func(childComplexity int) int
+		SizeSum        func(childComplexity int) int
+	}
+
 	Blob struct {
 		ArchivedFlag func(childComplexity int) int
 		BlobType     func(childComplexity int) int
@@ -325,13 +444,15 @@ type ComplexityRoot struct {
 	}
 
 	Comment struct {
-		CreatedAt          func(childComplexity int) int
-		CreatedBy          func(childComplexity int, filter *model.UserFilter) int
-		ID                 func(childComplexity int) int
-		Message            func(childComplexity int) int
-		Reactions          func(childComplexity int, filter *model.ReactionFilter, order *model.ReactionOrder, first *int, offset *int) int
-		ReactionsAggregate func(childComplexity int, filter *model.ReactionFilter) int
-		UpdatedAt          func(childComplexity int) int
+		Attachments          func(childComplexity int, filter *model.AttachmentFilter, order *model.AttachmentOrder, first *int, offset *int) int
+		AttachmentsAggregate func(childComplexity int, filter *model.AttachmentFilter) int
+		CreatedAt            func(childComplexity int) int
+		CreatedBy            func(childComplexity int, filter *model.UserFilter) int
+		ID                   func(childComplexity int) int
+		Message              func(childComplexity int) int
+		Reactions            func(childComplexity int, filter *model.ReactionFilter, order *model.ReactionOrder, first *int, offset *int) int
+		ReactionsAggregate   func(childComplexity int, filter *model.ReactionFilter) int
+		UpdatedAt            func(childComplexity int) int
 	}
 
 	CommentAggregateResult struct {
@@ -381,6 +502,12 @@ type ComplexityRoot struct {
 		UpdatedAtMin  func(childComplexity int) int
 	}
 
+	DeleteAttachmentPayload struct {
+		Attachment func(childComplexity int, filter *model.AttachmentFilter, order *model.AttachmentOrder, first *int, offset *int) int
+		Msg        func(childComplexity int) int
+		NumUids    func(childComplexity int) int
+	}
+
 	DeleteBlobPayload struct {
 		Blob    func(childComplexity int, filter *model.BlobFilter, order *model.BlobOrder, first *int, offset *int) int
 		Msg     func(childComplexity int) int
@@ -423,12 +550,24 @@ type ComplexityRoot struct {
 		NumUids func(childComplexity int) int
 	}
 
+	DeleteMailingSettingPayload struct {
+		MailingSetting func(childComplexity int, filter *model.MailingSettingFilter, order *model.MailingSettingOrder, first *int, offset *int) int
+		Msg            func(childComplexity int) int
+		NumUids        func(childComplexity int) int
+	}
+
 	DeleteMandatePayload struct {
 		Mandate func(childComplexity int, filter *model.MandateFilter, order *model.MandateOrder, first *int, offset *int) int
 		Msg     func(childComplexity int) int
 		NumUids func(childComplexity int) int
 	}
 
+	DeleteMatrixRoomPayload struct {
+		MatrixRoom func(childComplexity int, filter *model.MatrixRoomFilter, order *model.MatrixRoomOrder, first *int, offset *int) int
+		Msg        func(childComplexity int) int
+		NumUids    func(childComplexity int) int
+	}
+
 	DeleteNodeFragmentPayload struct {
 		Msg          func(childComplexity int) int
 		NodeFragment func(childComplexity int, filter *model.NodeFragmentFilter, order *model.NodeFragmentOrder, first *int, offset *int) int
@@ -447,6 +586,12 @@ type ComplexityRoot struct {
 		NumUids func(childComplexity int) int
 	}
 
+	DeleteNotifPreferencePayload struct {
+		Msg             func(childComplexity int) int
+		NotifPreference func(childComplexity int, filter *model.NotifPreferenceFilter, order *model.NotifPreferenceOrder, first *int, offset *int) int
+		NumUids         func(childComplexity int) int
+	}
+
 	DeletePendingUserPayload struct {
 		Msg         func(childComplexity int) int
 		NumUids     func(childComplexity int) int
@@ -501,6 +646,12 @@ type ComplexityRoot struct {
 		Reaction func(childComplexity int, filter *model.ReactionFilter, order *model.ReactionOrder, first *int, offset *int) int
 	}
 
+	DeleteRecurrencePayload struct {
+		Msg        func(childComplexity int) int
+		NumUids    func(childComplexity int) int
+		Recurrence func(childComplexity int, filter *model.RecurrenceFilter, order *model.RecurrenceOrder, first *int, offset *int) int
+	}
+
 	DeleteRoleExtPayload struct {
 		Msg     func(childComplexity int) int
 		NumUids func(childComplexity int) int
@@ -513,6 +664,18 @@ type ComplexityRoot struct {
 		Tension func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
 	}
 
+	DeleteTensionTemplatePayload struct {
+		Msg             func(childComplexity int) int
+		NumUids         func(childComplexity int) int
+		TensionTemplate func(childComplexity int, filter *model.TensionTemplateFilter, order *model.TensionTemplateOrder, first *int, offset *int) int
+	}
+
+	DeleteTensionViewPayload struct {
+		Msg         func(childComplexity int) int
+		NumUids     func(childComplexity int) int
+		TensionView func(childComplexity int, filter *model.TensionViewFilter, order *model.TensionViewOrder, first *int, offset *int) int
+	}
+
 	DeleteUserEventPayload struct {
 		Msg       func(childComplexity int) int
 		NumUids   func(childComplexity int) int
@@ -624,6 +787,33 @@ type ComplexityRoot struct {
 		RootnameidMin  func(childComplexity int) int
 	}
 
+	MailingSetting struct {
+		Alias           func(childComplexity int) int
+		Allowlist       func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		CreatedBy       func(childComplexity int, filter *model.UserFilter) int
+		Enabled         func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Labels          func(childComplexity int, filter *model.LabelFilter, order *model.LabelOrder, first *int, offset *int) int
+		LabelsAggregate func(childComplexity int, filter *model.LabelFilter) int
+		Nameid          func(childComplexity int) int
+		Rootnameid      func(childComplexity int) int
+		Senders         func(childComplexity int) int
+		Type            func(childComplexity int) int
+	}
+
+	MailingSettingAggregateResult struct {
+		AliasMax      func(childComplexity int) int
+		AliasMin      func(childComplexity int) int
+		Count         func(childComplexity int) int
+		CreatedAtMax  func(childComplexity int) int
+		CreatedAtMin  func(childComplexity int) int
+		NameidMax     func(childComplexity int) int
+		NameidMin     func(childComplexity int) int
+		RootnameidMax func(childComplexity int) int
+		RootnameidMin func(childComplexity int) int
+	}
+
 	Mandate struct {
 		Domains          func(childComplexity int) int
 		ID               func(childComplexity int) int
@@ -644,11 +834,33 @@ type ComplexityRoot struct {
 		ResponsabilitiesMin func(childComplexity int) int
 	}
 
+	MatrixRoom struct {
+		CreatedAt  func(childComplexity int) int
+		CreatedBy  func(childComplexity int, filter *model.UserFilter) int
+		ID         func(childComplexity int) int
+		Nameid     func(childComplexity int) int
+		Roomid     func(childComplexity int) int
+		Rootnameid func(childComplexity int) int
+	}
+
+	MatrixRoomAggregateResult struct {
+		Count         func(childComplexity int) int
+		CreatedAtMax  func(childComplexity int) int
+		CreatedAtMin  func(childComplexity int) int
+		NameidMax     func(childComplexity int) int
+		NameidMin     func(childComplexity int) int
+		RoomidMax     func(childComplexity int) int
+		RoomidMin     func(childComplexity int) int
+		RootnameidMax func(childComplexity int) int
+		RootnameidMin func(childComplexity int) int
+	}
+
 	MultiPolygon struct {
 		Polygons func(childComplexity int) int
 	}
 
 	Mutation struct {
+		AddAttachment           func(childComplexity int, input []*model.AddAttachmentInput) int
 		AddBlob                 func(childComplexity int, input []*model.AddBlobInput) int
 		AddComment              func(childComplexity int, input []*model.AddCommentInput) int
 		AddContract             func(childComplexity int, input []*model.AddContractInput, upsert *bool) int
@@ -656,10 +868,13 @@ type ComplexityRoot struct {
 		AddEventCount           func(childComplexity int, input []*model.AddEventCountInput) int
 		AddEventFragment        func(childComplexity int, input []*model.AddEventFragmentInput) int
 		AddLabel                func(childComplexity int, input []*model.AddLabelInput) int
+		AddMailingSetting       func(childComplexity int, input []*model.AddMailingSettingInput, upsert *bool) int
 		AddMandate              func(childComplexity int, input []*model.AddMandateInput) int
+		AddMatrixRoom           func(childComplexity int, input []*model.AddMatrixRoomInput, upsert *bool) int
 		AddNode                 func(childComplexity int, input []*model.AddNodeInput, upsert *bool) int
 		AddNodeFragment         func(childComplexity int, input []*model.AddNodeFragmentInput) int
 		AddNotif                func(childComplexity int, input []*model.AddNotifInput) int
+		AddNotifPreference      func(childComplexity int, input []*model.AddNotifPreferenceInput, upsert *bool) int
 		AddPendingUser          func(childComplexity int, input []*model.AddPendingUserInput, upsert *bool) int
 		AddProject              func(childComplexity int, input []*model.AddProjectInput) int
 		AddProjectCard          func(childComplexity int, input []*model.AddProjectCardInput) int
@@ -668,12 +883,16 @@ type ComplexityRoot struct {
 		AddProjectField         func(childComplexity int, input []*model.AddProjectFieldInput) int
 		AddProjectFieldValue    func(childComplexity int, input []*model.AddProjectFieldValueInput) int
 		AddReaction             func(childComplexity int, input []*model.AddReactionInput, upsert *bool) int
+		AddRecurrence           func(childComplexity int, input []*model.AddRecurrenceInput) int
 		AddRoleExt              func(childComplexity int, input []*model.AddRoleExtInput) int
 		AddTension              func(childComplexity int, input []*model.AddTensionInput) int
+		AddTensionTemplate      func(childComplexity int, input []*model.AddTensionTemplateInput) int
+		AddTensionView          func(childComplexity int, input []*model.AddTensionViewInput) int
 		AddUser                 func(childComplexity int, input []*model.AddUserInput, upsert *bool) int
 		AddUserEvent            func(childComplexity int, input []*model.AddUserEventInput) int
 		AddUserRights           func(childComplexity int, input []*model.AddUserRightsInput) int
 		AddVote                 func(childComplexity int, input []*model.AddVoteInput, upsert *bool) int
+		DeleteAttachment        func(childComplexity int, filter model.AttachmentFilter) int
 		DeleteBlob              func(childComplexity int, filter model.BlobFilter) int
 		DeleteComment           func(childComplexity int, filter model.CommentFilter) int
 		DeleteContract          func(childComplexity int, filter model.ContractFilter) int
@@ -681,10 +900,13 @@ type ComplexityRoot struct {
 		DeleteEventCount        func(childComplexity int, filter model.EventCountFilter) int
 		DeleteEventFragment     func(childComplexity int, filter model.EventFragmentFilter) int
 		DeleteLabel             func(childComplexity int, filter model.LabelFilter) int
+		DeleteMailingSetting    func(childComplexity int, filter model.MailingSettingFilter) int
 		DeleteMandate           func(childComplexity int, filter model.MandateFilter) int
+		DeleteMatrixRoom        func(childComplexity int, filter model.MatrixRoomFilter) int
 		DeleteNode              func(childComplexity int, filter model.NodeFilter) int
 		DeleteNodeFragment      func(childComplexity int, filter model.NodeFragmentFilter) int
 		DeleteNotif             func(childComplexity int, filter model.NotifFilter) int
+		DeleteNotifPreference   func(childComplexity int, filter model.NotifPreferenceFilter) int
 		DeletePendingUser       func(childComplexity int, filter model.PendingUserFilter) int
 		DeletePost              func(childComplexity int, filter model.PostFilter) int
 		DeleteProject           func(childComplexity int, filter model.ProjectFilter) int
@@ -694,12 +916,16 @@ type ComplexityRoot struct {
 		DeleteProjectField      func(childComplexity int, filter model.ProjectFieldFilter) int
 		DeleteProjectFieldValue func(childComplexity int, filter model.ProjectFieldValueFilter) int
 		DeleteReaction          func(childComplexity int, filter model.ReactionFilter) int
+		DeleteRecurrence        func(childComplexity int, filter model.RecurrenceFilter) int
 		DeleteRoleExt           func(childComplexity int, filter model.RoleExtFilter) int
 		DeleteTension           func(childComplexity int, filter model.TensionFilter) int
+		DeleteTensionTemplate   func(childComplexity int, filter model.TensionTemplateFilter) int
+		DeleteTensionView       func(childComplexity int, filter model.TensionViewFilter) int
 		DeleteUser              func(childComplexity int, filter model.UserFilter) int
 		DeleteUserEvent         func(childComplexity int, filter model.UserEventFilter) int
 		DeleteUserRights        func(childComplexity int, filter model.UserRightsFilter) int
 		DeleteVote              func(childComplexity int, filter model.VoteFilter) int
+		UpdateAttachment        func(childComplexity int, input model.UpdateAttachmentInput) int
 		UpdateBlob              func(childComplexity int, input model.UpdateBlobInput) int
 		UpdateComment           func(childComplexity int, input model.UpdateCommentInput) int
 		UpdateContract          func(childComplexity int, input model.UpdateContractInput) int
@@ -707,10 +933,13 @@ type ComplexityRoot struct {
 		UpdateEventCount        func(childComplexity int, input model.UpdateEventCountInput) int
 		UpdateEventFragment     func(childComplexity int, input model.UpdateEventFragmentInput) int
 		UpdateLabel             func(childComplexity int, input model.UpdateLabelInput) int
+		UpdateMailingSetting    func(childComplexity int, input model.UpdateMailingSettingInput) int
 		UpdateMandate           func(childComplexity int, input model.UpdateMandateInput) int
+		UpdateMatrixRoom        func(childComplexity int, input model.UpdateMatrixRoomInput) int
 		UpdateNode              func(childComplexity int, input model.UpdateNodeInput) int
 		UpdateNodeFragment      func(childComplexity int, input model.UpdateNodeFragmentInput) int
 		UpdateNotif             func(childComplexity int, input model.UpdateNotifInput) int
+		UpdateNotifPreference   func(childComplexity int, input model.UpdateNotifPreferenceInput) int
 		UpdatePendingUser       func(childComplexity int, input model.UpdatePendingUserInput) int
 		UpdatePost              func(childComplexity int, input model.UpdatePostInput) int
 		UpdateProject           func(childComplexity int, input model.UpdateProjectInput) int
@@ -720,12 +949,16 @@ type ComplexityRoot struct {
 		UpdateProjectField      func(childComplexity int, input model.UpdateProjectFieldInput) int
 		UpdateProjectFieldValue func(childComplexity int, input model.UpdateProjectFieldValueInput) int
 		UpdateReaction          func(childComplexity int, input model.UpdateReactionInput) int
+		UpdateRecurrence        func(childComplexity int, input model.UpdateRecurrenceInput) int
 		UpdateRoleExt           func(childComplexity int, input model.UpdateRoleExtInput) int
 		UpdateTension           func(childComplexity int, input model.UpdateTensionInput) int
+		UpdateTensionTemplate   func(childComplexity int, input model.UpdateTensionTemplateInput) int
+		UpdateTensionView       func(childComplexity int, input model.UpdateTensionViewInput) int
 		UpdateUser              func(childComplexity int, input model.UpdateUserInput) int
 		UpdateUserEvent         func(childComplexity int, input model.UpdateUserEventInput) int
 		UpdateUserRights        func(childComplexity int, input model.UpdateUserRightsInput) int
 		UpdateVote              func(childComplexity int, input model.UpdateVoteInput) int
+		UploadAttachment        func(childComplexity int, tid string, cid *string, file graphql.Upload) int
 	}
 
 	Node struct {
@@ -763,6 +996,8 @@ type ComplexityRoot struct {
 		Rootnameid             func(childComplexity int) int
 		Skills                 func(childComplexity int) int
 		Source                 func(childComplexity int, filter *model.BlobFilter) int
+		Templates              func(childComplexity int, filter *model.TensionTemplateFilter, order *model.TensionTemplateOrder, first *int, offset *int) int
+		TemplatesAggregate     func(childComplexity int, filter *model.TensionTemplateFilter) int
 		TensionsIn             func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
 		TensionsInAggregate    func(childComplexity int, filter *model.TensionFilter) int
 		TensionsOut            func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
@@ -852,6 +1087,24 @@ type ComplexityRoot struct {
 		UpdatedAtMin func(childComplexity int) int
 	}
 
+	NotifPreference struct {
+		Channel      func(childComplexity int) int
+		EventGroup   func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Preferenceid func(childComplexity int) int
+		Reason       func(childComplexity int) int
+		Rootnameid   func(childComplexity int) int
+		User         func(childComplexity int, filter *model.UserFilter) int
+	}
+
+	NotifPreferenceAggregateResult struct {
+		Count           func(childComplexity int) int
+		PreferenceidMax func(childComplexity int) int
+		PreferenceidMin func(childComplexity int) int
+		RootnameidMax   func(childComplexity int) int
+		RootnameidMin   func(childComplexity int) int
+	}
+
 	PendingUser struct {
 		Contracts          func(childComplexity int, filter *model.ContractFilter, order *model.ContractOrder, first *int, offset *int) int
 		ContractsAggregate func(childComplexity int, filter *model.ContractFilter) int
@@ -1048,6 +1301,7 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		AggregateAttachment        func(childComplexity int, filter *model.AttachmentFilter) int
 		AggregateBlob              func(childComplexity int, filter *model.BlobFilter) int
 		AggregateComment           func(childComplexity int, filter *model.CommentFilter) int
 		AggregateContract          func(childComplexity int, filter *model.ContractFilter) int
@@ -1055,10 +1309,13 @@ type ComplexityRoot struct {
 		AggregateEventCount        func(childComplexity int, filter *model.EventCountFilter) int
 		AggregateEventFragment     func(childComplexity int, filter *model.EventFragmentFilter) int
 		AggregateLabel             func(childComplexity int, filter *model.LabelFilter) int
+		AggregateMailingSetting    func(childComplexity int, filter *model.MailingSettingFilter) int
 		AggregateMandate           func(childComplexity int, filter *model.MandateFilter) int
+		AggregateMatrixRoom        func(childComplexity int, filter *model.MatrixRoomFilter) int
 		AggregateNode              func(childComplexity int, filter *model.NodeFilter) int
 		AggregateNodeFragment      func(childComplexity int, filter *model.NodeFragmentFilter) int
 		AggregateNotif             func(childComplexity int, filter *model.NotifFilter) int
+		AggregateNotifPreference   func(childComplexity int, filter *model.NotifPreferenceFilter) int
 		AggregatePendingUser       func(childComplexity int, filter *model.PendingUserFilter) int
 		AggregatePost              func(childComplexity int, filter *model.PostFilter) int
 		AggregateProject           func(childComplexity int, filter *model.ProjectFilter) int
@@ -1068,21 +1325,28 @@ type ComplexityRoot struct {
 		AggregateProjectField      func(childComplexity int, filter *model.ProjectFieldFilter) int
 		AggregateProjectFieldValue func(childComplexity int, filter *model.ProjectFieldValueFilter) int
 		AggregateReaction          func(childComplexity int, filter *model.ReactionFilter) int
+		AggregateRecurrence        func(childComplexity int, filter *model.RecurrenceFilter) int
 		AggregateRoleExt           func(childComplexity int, filter *model.RoleExtFilter) int
 		AggregateTension           func(childComplexity int, filter *model.TensionFilter) int
+		AggregateTensionTemplate   func(childComplexity int, filter *model.TensionTemplateFilter) int
+		AggregateTensionView       func(childComplexity int, filter *model.TensionViewFilter) int
 		AggregateUser              func(childComplexity int, filter *model.UserFilter) int
 		AggregateUserEvent         func(childComplexity int, filter *model.UserEventFilter) int
 		AggregateUserRights        func(childComplexity int, filter *model.UserRightsFilter) int
 		AggregateVote              func(childComplexity int, filter *model.VoteFilter) int
+		GetAttachment              func(childComplexity int, id string) int
 		GetBlob                    func(childComplexity int, id string) int
 		GetComment                 func(childComplexity int, id string) int
 		GetContract                func(childComplexity int, id *string, contractid *string) int
 		GetEvent                   func(childComplexity int, id string) int
 		GetLabel                   func(childComplexity int, id string) int
+		GetMailingSetting          func(childComplexity int, id *string, nameid *string) int
 		GetMandate                 func(childComplexity int, id string) int
+		GetMatrixRoom              func(childComplexity int, id *string, nameid *string) int
 		GetNode                    func(childComplexity int, id *string, nameid *string) int
 		GetNodeFragment            func(childComplexity int, id string) int
 		GetNotif                   func(childComplexity int, id string) int
+		GetNotifPreference         func(childComplexity int, id *string, preferenceid *string) int
 		GetPendingUser             func(childComplexity int, id *string, username *string, email *string) int
 		GetPost                    func(childComplexity int, id string) int
 		GetProject                 func(childComplexity int, id string) int
@@ -1090,11 +1354,15 @@ type ComplexityRoot struct {
 		GetProjectColumn           func(childComplexity int, id string) int
 		GetProjectDraft            func(childComplexity int, id string) int
 		GetReaction                func(childComplexity int, id *string, reactionid *string) int
+		GetRecurrence              func(childComplexity int, id string) int
 		GetRoleExt                 func(childComplexity int, id string) int
 		GetTension                 func(childComplexity int, id string) int
+		GetTensionTemplate         func(childComplexity int, id string) int
+		GetTensionView             func(childComplexity int, id string) int
 		GetUser                    func(childComplexity int, id *string, username *string, email *string) int
 		GetUserEvent               func(childComplexity int, id string) int
 		GetVote                    func(childComplexity int, id *string, voteid *string) int
+		QueryAttachment            func(childComplexity int, filter *model.AttachmentFilter, order *model.AttachmentOrder, first *int, offset *int) int
 		QueryBlob                  func(childComplexity int, filter *model.BlobFilter, order *model.BlobOrder, first *int, offset *int) int
 		QueryComment               func(childComplexity int, filter *model.CommentFilter, order *model.CommentOrder, first *int, offset *int) int
 		QueryContract              func(childComplexity int, filter *model.ContractFilter, order *model.ContractOrder, first *int, offset *int) int
@@ -1102,10 +1370,13 @@ type ComplexityRoot struct {
 		QueryEventCount            func(childComplexity int, filter *model.EventCountFilter, order *model.EventCountOrder, first *int, offset *int) int
 		QueryEventFragment         func(childComplexity int, filter *model.EventFragmentFilter, order *model.EventFragmentOrder, first *int, offset *int) int
 		QueryLabel                 func(childComplexity int, filter *model.LabelFilter, order *model.LabelOrder, first *int, offset *int) int
+		QueryMailingSetting        func(childComplexity int, filter *model.MailingSettingFilter, order *model.MailingSettingOrder, first *int, offset *int) int
 		QueryMandate               func(childComplexity int, filter *model.MandateFilter, order *model.MandateOrder, first *int, offset *int) int
+		QueryMatrixRoom            func(childComplexity int, filter *model.MatrixRoomFilter, order *model.MatrixRoomOrder, first *int, offset *int) int
 		QueryNode                  func(childComplexity int, filter *model.NodeFilter, order *model.NodeOrder, first *int, offset *int) int
 		QueryNodeFragment          func(childComplexity int, filter *model.NodeFragmentFilter, order *model.NodeFragmentOrder, first *int, offset *int) int
 		QueryNotif                 func(childComplexity int, filter *model.NotifFilter, order *model.NotifOrder, first *int, offset *int) int
+		QueryNotifPreference       func(childComplexity int, filter *model.NotifPreferenceFilter, order *model.NotifPreferenceOrder, first *int, offset *int) int
 		QueryPendingUser           func(childComplexity int, filter *model.PendingUserFilter, order *model.PendingUserOrder, first *int, offset *int) int
 		QueryPost                  func(childComplexity int, filter *model.PostFilter, order *model.PostOrder, first *int, offset *int) int
 		QueryProject               func(childComplexity int, filter *model.ProjectFilter, order *model.ProjectOrder, first *int, offset *int) int
@@ -1115,8 +1386,11 @@ type ComplexityRoot struct {
 		QueryProjectField          func(childComplexity int, filter *model.ProjectFieldFilter, first *int, offset *int) int
 		QueryProjectFieldValue     func(childComplexity int, filter *model.ProjectFieldValueFilter, order *model.ProjectFieldValueOrder, first *int, offset *int) int
 		QueryReaction              func(childComplexity int, filter *model.ReactionFilter, order *model.ReactionOrder, first *int, offset *int) int
+		QueryRecurrence            func(childComplexity int, filter *model.RecurrenceFilter, order *model.RecurrenceOrder, first *int, offset *int) int
 		QueryRoleExt               func(childComplexity int, filter *model.RoleExtFilter, order *model.RoleExtOrder, first *int, offset *int) int
 		QueryTension               func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
+		QueryTensionTemplate       func(childComplexity int, filter *model.TensionTemplateFilter, order *model.TensionTemplateOrder, first *int, offset *int) int
+		QueryTensionView           func(childComplexity int, filter *model.TensionViewFilter, order *model.TensionViewOrder, first *int, offset *int) int
 		QueryUser                  func(childComplexity int, filter *model.UserFilter, order *model.UserOrder, first *int, offset *int) int
 		QueryUserEvent             func(childComplexity int, filter *model.UserEventFilter, order *model.UserEventOrder, first *int, offset *int) int
 		QueryUserRights            func(childComplexity int, filter *model.UserRightsFilter, order *model.UserRightsOrder, first *int, offset *int) int
@@ -1141,6 +1415,44 @@ type ComplexityRoot struct {
 		TypeSum       func(childComplexity int) int
 	}
 
+	Recurrence struct {
+		Count      func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		CreatedBy  func(childComplexity int, filter *model.UserFilter) int
+		Dtstart    func(childComplexity int) int
+		ID         func(childComplexity int) int
+		NextAt     func(childComplexity int) int
+		Paused     func(childComplexity int) int
+		Receiverid func(childComplexity int) int
+		Rootnameid func(childComplexity int) int
+		Rrule      func(childComplexity int) int
+		Template   func(childComplexity int, filter *model.TensionTemplateFilter) int
+		Tension    func(childComplexity int, filter *model.TensionFilter) int
+		Title      func(childComplexity int) int
+	}
+
+	RecurrenceAggregateResult struct {
+		Count         func(childComplexity int) int
+		CountAvg      func(childComplexity int) int
+		CountMax      func(childComplexity int) int
+		CountMin      func(childComplexity int) int
+		CountSum      func(childComplexity int) int
+		CreatedAtMax  func(childComplexity int) int
+		CreatedAtMin  func(childComplexity int) int
+		DtstartMax    func(childComplexity int) int
+		DtstartMin    func(childComplexity int) int
+		NextAtMax     func(childComplexity int) int
+		NextAtMin     func(childComplexity int) int
+		ReceiveridMax func(childComplexity int) int
+		ReceiveridMin func(childComplexity int) int
+		RootnameidMax func(childComplexity int) int
+		RootnameidMin func(childComplexity int) int
+		RruleMax      func(childComplexity int) int
+		RruleMin      func(childComplexity int) int
+		TitleMax      func(childComplexity int) int
+		TitleMin      func(childComplexity int) int
+	}
+
 	RoleExt struct {
 		About          func(childComplexity int) int
 		Color          func(childComplexity int) int
@@ -1171,14 +1483,25 @@ type ComplexityRoot struct {
 		Action                   func(childComplexity int) int
 		Assignees                func(childComplexity int, filter *model.UserFilter, order *model.UserOrder, first *int, offset *int) int
 		AssigneesAggregate       func(childComplexity int, filter *model.UserFilter) int
+		Attachments              func(childComplexity int, filter *model.AttachmentFilter, order *model.AttachmentOrder, first *int, offset *int) int
+		AttachmentsAggregate     func(childComplexity int, filter *model.AttachmentFilter) int
 		Blobs                    func(childComplexity int, filter *model.BlobFilter, order *model.BlobOrder, first *int, offset *int) int
 		BlobsAggregate           func(childComplexity int, filter *model.BlobFilter) int
+		BlockedBy                func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
+		BlockedByAggregate       func(childComplexity int, filter *model.TensionFilter) int
+		Blocks                   func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
+		BlocksAggregate          func(childComplexity int, filter *model.TensionFilter) int
 		Comments                 func(childComplexity int, filter *model.CommentFilter, order *model.CommentOrder, first *int, offset *int) int
 		CommentsAggregate        func(childComplexity int, filter *model.CommentFilter) int
+		Confidential             func(childComplexity int) int
 		Contracts                func(childComplexity int, filter *model.ContractFilter, order *model.ContractOrder, first *int, offset *int) int
 		ContractsAggregate       func(childComplexity int, filter *model.ContractFilter) int
 		CreatedAt                func(childComplexity int) int
 		CreatedBy                func(childComplexity int, filter *model.UserFilter) int
+		DueDate                  func(childComplexity int) int
+		DuplicateOf              func(childComplexity int, filter *model.TensionFilter) int
+		Duplicates               func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
+		DuplicatesAggregate      func(childComplexity int, filter *model.TensionFilter) int
 		Emitter                  func(childComplexity int, filter *model.NodeFilter) int
 		Emitterid                func(childComplexity int) int
 		History                  func(childComplexity int, filter *model.EventFilter, order *model.EventOrder, first *int, offset *int) int
@@ -1190,13 +1513,21 @@ type ComplexityRoot struct {
 		MentionsAggregate        func(childComplexity int, filter *model.EventFilter) int
 		Message                  func(childComplexity int) int
 		NComments                func(childComplexity int) int
+		Parent                   func(childComplexity int, filter *model.TensionFilter) int
+		Participants             func(childComplexity int, filter *model.UserFilter, order *model.UserOrder, first *int, offset *int) int
+		ParticipantsAggregate    func(childComplexity int, filter *model.UserFilter) int
 		ProjectStatuses          func(childComplexity int, filter *model.ProjectColumnFilter, order *model.ProjectColumnOrder, first *int, offset *int) int
 		ProjectStatusesAggregate func(childComplexity int, filter *model.ProjectColumnFilter) int
 		Receiver                 func(childComplexity int, filter *model.NodeFilter) int
 		Receiverid               func(childComplexity int) int
+		Related                  func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
+		RelatedAggregate         func(childComplexity int, filter *model.TensionFilter) int
 		Status                   func(childComplexity int) int
 		Subscribers              func(childComplexity int, filter *model.UserFilter, order *model.UserOrder, first *int, offset *int) int
 		SubscribersAggregate     func(childComplexity int, filter *model.UserFilter) int
+		Subtasks                 func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
+		SubtasksAggregate        func(childComplexity int, filter *model.TensionFilter) int
+		Template                 func(childComplexity int, filter *model.TensionTemplateFilter) int
 		Title                    func(childComplexity int) int
 		Type                     func(childComplexity int) int
 		UpdatedAt                func(childComplexity int) int
@@ -1206,6 +1537,8 @@ type ComplexityRoot struct {
 		Count         func(childComplexity int) int
 		CreatedAtMax  func(childComplexity int) int
 		CreatedAtMin  func(childComplexity int) int
+		DueDateMax    func(childComplexity int) int
+		DueDateMin    func(childComplexity int) int
 		EmitteridMax  func(childComplexity int) int
 		EmitteridMin  func(childComplexity int) int
 		MessageMax    func(childComplexity int) int
@@ -1222,6 +1555,73 @@ type ComplexityRoot struct {
 		UpdatedAtMin  func(childComplexity int) int
 	}
 
+	TensionTemplate struct {
+		About              func(childComplexity int) int
+		Assignees          func(childComplexity int, filter *model.UserFilter, order *model.UserOrder, first *int, offset *int) int
+		AssigneesAggregate func(childComplexity int, filter *model.UserFilter) int
+		ID                 func(childComplexity int) int
+		Labels             func(childComplexity int, filter *model.LabelFilter, order *model.LabelOrder, first *int, offset *int) int
+		LabelsAggregate    func(childComplexity int, filter *model.LabelFilter) int
+		Message            func(childComplexity int) int
+		Name               func(childComplexity int) int
+		Nodes              func(childComplexity int, filter *model.NodeFilter, order *model.NodeOrder, first *int, offset *int) int
+		NodesAggregate     func(childComplexity int, filter *model.NodeFilter) int
+		Rootnameid         func(childComplexity int) int
+		Title              func(childComplexity int) int
+		Type               func(childComplexity int) int
+	}
+
+	TensionTemplateAggregateResult struct {
+		AboutMax      func(childComplexity int) int
+		AboutMin      func(childComplexity int) int
+		Count         func(childComplexity int) int
+		MessageMax    func(childComplexity int) int
+		MessageMin    func(childComplexity int) int
+		NameMax       func(childComplexity int) int
+		NameMin       func(childComplexity int) int
+		RootnameidMax func(childComplexity int) int
+		RootnameidMin func(childComplexity int) int
+		TitleMax      func(childComplexity int) int
+		TitleMin      func(childComplexity int) int
+	}
+
+	TensionView struct {
+		CheckedAt         func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		CreatedBy         func(childComplexity int, filter *model.UserFilter) int
+		Display           func(childComplexity int) int
+		ID                func(childComplexity int) int
+		Name              func(childComplexity int) int
+		Nameid            func(childComplexity int) int
+		Query             func(childComplexity int) int
+		Rootnameid        func(childComplexity int) int
+		Watchers          func(childComplexity int, filter *model.UserFilter, order *model.UserOrder, first *int, offset *int) int
+		WatchersAggregate func(childComplexity int, filter *model.UserFilter) int
+	}
+
+	TensionViewAggregateResult struct {
+		CheckedAtMax  func(childComplexity int) int
+		CheckedAtMin  func(childComplexity int) int
+		Count         func(childComplexity int) int
+		CreatedAtMax  func(childComplexity int) int
+		CreatedAtMin  func(childComplexity int) int
+		DisplayMax    func(childComplexity int) int
+		DisplayMin    func(childComplexity int) int
+		NameMax       func(childComplexity int) int
+		NameMin       func(childComplexity int) int
+		NameidMax     func(childComplexity int) int
+		NameidMin     func(childComplexity int) int
+		QueryMax      func(childComplexity int) int
+		QueryMin      func(childComplexity int) int
+		RootnameidMax func(childComplexity int) int
+		RootnameidMin func(childComplexity int) int
+	}
+
+	UpdateAttachmentPayload struct {
+		Attachment func(childComplexity int, filter *model.AttachmentFilter, order *model.AttachmentOrder, first *int, offset *int) int
+		NumUids    func(childComplexity int) int
+	}
+
 	UpdateBlobPayload struct {
 		Blob    func(childComplexity int, filter *model.BlobFilter, order *model.BlobOrder, first *int, offset *int) int
 		NumUids func(childComplexity int) int
@@ -1257,11 +1657,21 @@ type ComplexityRoot struct {
 		NumUids func(childComplexity int) int
 	}
 
+	UpdateMailingSettingPayload struct {
+		MailingSetting func(childComplexity int, filter *model.MailingSettingFilter, order *model.MailingSettingOrder, first *int, offset *int) int
+		NumUids        func(childComplexity int) int
+	}
+
 	UpdateMandatePayload struct {
 		Mandate func(childComplexity int, filter *model.MandateFilter, order *model.MandateOrder, first *int, offset *int) int
 		NumUids func(childComplexity int) int
 	}
 
+	UpdateMatrixRoomPayload struct {
+		MatrixRoom func(childComplexity int, filter *model.MatrixRoomFilter, order *model.MatrixRoomOrder, first *int, offset *int) int
+		NumUids    func(childComplexity int) int
+	}
+
 	UpdateNodeFragmentPayload struct {
 		NodeFragment func(childComplexity int, filter *model.NodeFragmentFilter, order *model.NodeFragmentOrder, first *int, offset *int) int
 		NumUids      func(childComplexity int) int
@@ -1277,6 +1687,11 @@ type ComplexityRoot struct {
 		NumUids func(childComplexity int) int
 	}
 
+	UpdateNotifPreferencePayload struct {
+		NotifPreference func(childComplexity int, filter *model.NotifPreferenceFilter, order *model.NotifPreferenceOrder, first *int, offset *int) int
+		NumUids         func(childComplexity int) int
+	}
+
 	UpdatePendingUserPayload struct {
 		NumUids     func(childComplexity int) int
 		PendingUser func(childComplexity int, filter *model.PendingUserFilter, order *model.PendingUserOrder, first *int, offset *int) int
@@ -1322,6 +1737,11 @@ type ComplexityRoot struct {
 		Reaction func(childComplexity int, filter *model.ReactionFilter, order *model.ReactionOrder, first *int, offset *int) int
 	}
 
+	UpdateRecurrencePayload struct {
+		NumUids    func(childComplexity int) int
+		Recurrence func(childComplexity int, filter *model.RecurrenceFilter, order *model.RecurrenceOrder, first *int, offset *int) int
+	}
+
 	UpdateRoleExtPayload struct {
 		NumUids func(childComplexity int) int
 		RoleExt func(childComplexity int, filter *model.RoleExtFilter, order *model.RoleExtOrder, first *int, offset *int) int
@@ -1332,6 +1752,16 @@ type ComplexityRoot struct {
 		Tension func(childComplexity int, filter *model.TensionFilter, order *model.TensionOrder, first *int, offset *int) int
 	}
 
+	UpdateTensionTemplatePayload struct {
+		NumUids         func(childComplexity int) int
+		TensionTemplate func(childComplexity int, filter *model.TensionTemplateFilter, order *model.TensionTemplateOrder, first *int, offset *int) int
+	}
+
+	UpdateTensionViewPayload struct {
+		NumUids     func(childComplexity int) int
+		TensionView func(childComplexity int, filter *model.TensionViewFilter, order *model.TensionViewOrder, first *int, offset *int) int
+	}
+
 	UpdateUserEventPayload struct {
 		NumUids   func(childComplexity int) int
 		UserEvent func(childComplexity int, filter *model.UserEventFilter, order *model.UserEventOrder, first *int, offset *int) int
@@ -1358,6 +1788,8 @@ type ComplexityRoot struct {
 		ContractsAggregate        func(childComplexity int, filter *model.ContractFilter) int
 		CreatedAt                 func(childComplexity int) int
 		Email                     func(childComplexity int) int
+		EmailDelivery             func(childComplexity int) int
+		EmailStatus               func(childComplexity int) int
 		EventCount                func(childComplexity int, filter *model.EventCountFilter) int
 		Events                    func(childComplexity int, filter *model.UserEventFilter, order *model.UserEventOrder, first *int, offset *int) int
 		EventsAggregate           func(childComplexity int, filter *model.UserEventFilter) int
@@ -1367,7 +1799,10 @@ type ComplexityRoot struct {
 		Links                     func(childComplexity int) int
 		Location                  func(childComplexity int) int
 		MarkAllAsRead             func(childComplexity int) int
+		Matrixid                  func(childComplexity int) int
 		Name                      func(childComplexity int) int
+		NotifPreferences          func(childComplexity int, filter *model.NotifPreferenceFilter, order *model.NotifPreferenceOrder, first *int, offset *int) int
+		NotifPreferencesAggregate func(childComplexity int, filter *model.NotifPreferenceFilter) int
 		NotifyByEmail             func(childComplexity int) int
 		Password                  func(childComplexity int) int
 		Reactions                 func(childComplexity int, filter *model.ReactionFilter, order *model.ReactionOrder, first *int, offset *int) int
@@ -1402,6 +1837,8 @@ type ComplexityRoot struct {
 		LocationMin      func(childComplexity int) int
 		MarkAllAsReadMax func(childComplexity int) int
 		MarkAllAsReadMin func(childComplexity int) int
+		MatrixidMax      func(childComplexity int) int
+		MatrixidMin      func(childComplexity int) int
 		NameMax          func(childComplexity int) int
 		NameMin          func(childComplexity int) int
 		PasswordMax      func(childComplexity int) int
@@ -1491,6 +1928,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AddAttachmentPayload.attachment":
+		if e.complexity.AddAttachmentPayload.Attachment == nil {
+			break
+		}
+
+		args, err := ec.field_AddAttachmentPayload_attachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.AddAttachmentPayload.Attachment(childComplexity, args["filter"].(*model.AttachmentFilter), args["order"].(*model.AttachmentOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "AddAttachmentPayload.numUids":
+		if e.complexity.AddAttachmentPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.AddAttachmentPayload.NumUids(childComplexity), true
+
 	case "AddBlobPayload.blob":
 		if e.complexity.AddBlobPayload.Blob == nil {
 			break
@@ -1624,6 +2080,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddLabelPayload.NumUids(childComplexity), true
 
+	case "AddMailingSettingPayload.mailingSetting":
+		if e.complexity.AddMailingSettingPayload.MailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_AddMailingSettingPayload_mailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.AddMailingSettingPayload.MailingSetting(childComplexity, args["filter"].(*model.MailingSettingFilter), args["order"].(*model.MailingSettingOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "AddMailingSettingPayload.numUids":
+		if e.complexity.AddMailingSettingPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.AddMailingSettingPayload.NumUids(childComplexity), true
+
 	case "AddMandatePayload.mandate":
 		if e.complexity.AddMandatePayload.Mandate == nil {
 			break
@@ -1643,6 +2118,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddMandatePayload.NumUids(childComplexity), true
 
+	case "AddMatrixRoomPayload.matrixRoom":
+		if e.complexity.AddMatrixRoomPayload.MatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_AddMatrixRoomPayload_matrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.AddMatrixRoomPayload.MatrixRoom(childComplexity, args["filter"].(*model.MatrixRoomFilter), args["order"].(*model.MatrixRoomOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "AddMatrixRoomPayload.numUids":
+		if e.complexity.AddMatrixRoomPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.AddMatrixRoomPayload.NumUids(childComplexity), true
+
 	case "AddNodeFragmentPayload.nodeFragment":
 		if e.complexity.AddNodeFragmentPayload.NodeFragment == nil {
 			break
@@ -1700,6 +2194,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddNotifPayload.NumUids(childComplexity), true
 
+	case "AddNotifPreferencePayload.notifPreference":
+		if e.complexity.AddNotifPreferencePayload.NotifPreference == nil {
+			break
+		}
+
+		args, err := ec.field_AddNotifPreferencePayload_notifPreference_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.AddNotifPreferencePayload.NotifPreference(childComplexity, args["filter"].(*model.NotifPreferenceFilter), args["order"].(*model.NotifPreferenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "AddNotifPreferencePayload.numUids":
+		if e.complexity.AddNotifPreferencePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.AddNotifPreferencePayload.NumUids(childComplexity), true
+
 	case "AddPendingUserPayload.numUids":
 		if e.complexity.AddPendingUserPayload.NumUids == nil {
 			break
@@ -1852,6 +2365,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddReactionPayload.Reaction(childComplexity, args["filter"].(*model.ReactionFilter), args["order"].(*model.ReactionOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "AddRecurrencePayload.numUids":
+		if e.complexity.AddRecurrencePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.AddRecurrencePayload.NumUids(childComplexity), true
+
+	case "AddRecurrencePayload.recurrence":
+		if e.complexity.AddRecurrencePayload.Recurrence == nil {
+			break
+		}
+
+		args, err := ec.field_AddRecurrencePayload_recurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.AddRecurrencePayload.Recurrence(childComplexity, args["filter"].(*model.RecurrenceFilter), args["order"].(*model.RecurrenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "AddRoleExtPayload.numUids":
 		if e.complexity.AddRoleExtPayload.NumUids == nil {
 			break
@@ -1890,6 +2422,44 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddTensionPayload.Tension(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "AddTensionTemplatePayload.numUids":
+		if e.complexity.AddTensionTemplatePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.AddTensionTemplatePayload.NumUids(childComplexity), true
+
+	case "AddTensionTemplatePayload.tensionTemplate":
+		if e.complexity.AddTensionTemplatePayload.TensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_AddTensionTemplatePayload_tensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.AddTensionTemplatePayload.TensionTemplate(childComplexity, args["filter"].(*model.TensionTemplateFilter), args["order"].(*model.TensionTemplateOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "AddTensionViewPayload.numUids":
+		if e.complexity.AddTensionViewPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.AddTensionViewPayload.NumUids(childComplexity), true
+
+	case "AddTensionViewPayload.tensionView":
+		if e.complexity.AddTensionViewPayload.TensionView == nil {
+			break
+		}
+
+		args, err := ec.field_AddTensionViewPayload_tensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.AddTensionViewPayload.TensionView(childComplexity, args["filter"].(*model.TensionViewFilter), args["order"].(*model.TensionViewOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "AddUserEventPayload.numUids":
 		if e.complexity.AddUserEventPayload.NumUids == nil {
 			break
@@ -1966,59 +2536,228 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddVotePayload.Vote(childComplexity, args["filter"].(*model.VoteFilter), args["order"].(*model.VoteOrder), args["first"].(*int), args["offset"].(*int)), true
 
-	case "Blob.archivedFlag":
-		if e.complexity.Blob.ArchivedFlag == nil {
+	case "Attachment.comment":
+		if e.complexity.Attachment.Comment == nil {
 			break
 		}
 
-		return e.complexity.Blob.ArchivedFlag(childComplexity), true
+		args, err := ec.field_Attachment_comment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Blob.blob_type":
-		if e.complexity.Blob.BlobType == nil {
+		return e.complexity.Attachment.Comment(childComplexity, args["filter"].(*model.CommentFilter)), true
+
+	case "Attachment.content_type":
+		if e.complexity.Attachment.ContentType == nil {
 			break
 		}
 
-		return e.complexity.Blob.BlobType(childComplexity), true
+		return e.complexity.Attachment.ContentType(childComplexity), true
 
-	case "Blob.createdAt":
-		if e.complexity.Blob.CreatedAt == nil {
+	case "Attachment.createdAt":
+		if e.complexity.Attachment.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Blob.CreatedAt(childComplexity), true
+		return e.complexity.Attachment.CreatedAt(childComplexity), true
 
-	case "Blob.createdBy":
-		if e.complexity.Blob.CreatedBy == nil {
+	case "Attachment.createdBy":
+		if e.complexity.Attachment.CreatedBy == nil {
 			break
 		}
 
-		args, err := ec.field_Blob_createdBy_args(context.TODO(), rawArgs)
+		args, err := ec.field_Attachment_createdBy_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Blob.CreatedBy(childComplexity, args["filter"].(*model.UserFilter)), true
+		return e.complexity.Attachment.CreatedBy(childComplexity, args["filter"].(*model.UserFilter)), true
 
-	case "Blob.id":
-		if e.complexity.Blob.ID == nil {
+	case "Attachment.filename":
+		if e.complexity.Attachment.Filename == nil {
 			break
 		}
 
-		return e.complexity.Blob.ID(childComplexity), true
+		return e.complexity.Attachment.Filename(childComplexity), true
 
-	case "Blob.md":
-		if e.complexity.Blob.Md == nil {
+	case "Attachment.id":
+		if e.complexity.Attachment.ID == nil {
 			break
 		}
 
-		return e.complexity.Blob.Md(childComplexity), true
+		return e.complexity.Attachment.ID(childComplexity), true
 
-	case "Blob.message":
-		if e.complexity.Blob.Message == nil {
+	case "Attachment.key":
+		if e.complexity.Attachment.Key == nil {
 			break
 		}
 
-		return e.complexity.Blob.Message(childComplexity), true
+		return e.complexity.Attachment.Key(childComplexity), true
+
+	case "Attachment.size":
+		if e.complexity.Attachment.Size == nil {
+			break
+		}
+
+		return e.complexity.Attachment.Size(childComplexity), true
+
+	case "Attachment.tension":
+		if e.complexity.Attachment.Tension == nil {
+			break
+		}
+
+		args, err := ec.field_Attachment_tension_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Attachment.Tension(childComplexity, args["filter"].(*model.TensionFilter)), true
+
+	case "AttachmentAggregateResult.content_typeMax":
+		if e.complexity.AttachmentAggregateResult.ContentTypeMax == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.ContentTypeMax(childComplexity), true
+
+	case "AttachmentAggregateResult.content_typeMin":
+		if e.complexity.AttachmentAggregateResult.ContentTypeMin == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.ContentTypeMin(childComplexity), true
+
+	case "AttachmentAggregateResult.count":
+		if e.complexity.AttachmentAggregateResult.Count == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.Count(childComplexity), true
+
+	case "AttachmentAggregateResult.createdAtMax":
+		if e.complexity.AttachmentAggregateResult.CreatedAtMax == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.CreatedAtMax(childComplexity), true
+
+	case "AttachmentAggregateResult.createdAtMin":
+		if e.complexity.AttachmentAggregateResult.CreatedAtMin == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.CreatedAtMin(childComplexity), true
+
+	case "AttachmentAggregateResult.filenameMax":
+		if e.complexity.AttachmentAggregateResult.FilenameMax == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.FilenameMax(childComplexity), true
+
+	case "AttachmentAggregateResult.filenameMin":
+		if e.complexity.AttachmentAggregateResult.FilenameMin == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.FilenameMin(childComplexity), true
+
+	case "AttachmentAggregateResult.keyMax":
+		if e.complexity.AttachmentAggregateResult.KeyMax == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.KeyMax(childComplexity), true
+
+	case "AttachmentAggregateResult.keyMin":
+		if e.complexity.AttachmentAggregateResult.KeyMin == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.KeyMin(childComplexity), true
+
+	case "AttachmentAggregateResult.sizeAvg":
+		if e.complexity.AttachmentAggregateResult.SizeAvg == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.SizeAvg(childComplexity), true
+
+	case "AttachmentAggregateResult.sizeMax":
+		if e.complexity.AttachmentAggregateResult.SizeMax == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.SizeMax(childComplexity), true
+
+	case "AttachmentAggregateResult.sizeMin":
+		if e.complexity.AttachmentAggregateResult.SizeMin == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.SizeMin(childComplexity), true
+
+	case "AttachmentAggregateResult.sizeSum":
+		if e.complexity.AttachmentAggregateResult.SizeSum == nil {
+			break
+		}
+
+		return e.complexity.AttachmentAggregateResult.SizeSum(childComplexity), true
+
+	case "Blob.archivedFlag":
+		if e.complexity.Blob.ArchivedFlag == nil {
+			break
+		}
+
+		return e.complexity.Blob.ArchivedFlag(childComplexity), true
+
+	case "Blob.blob_type":
+		if e.complexity.Blob.BlobType == nil {
+			break
+		}
+
+		return e.complexity.Blob.BlobType(childComplexity), true
+
+	case "Blob.createdAt":
+		if e.complexity.Blob.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Blob.CreatedAt(childComplexity), true
+
+	case "Blob.createdBy":
+		if e.complexity.Blob.CreatedBy == nil {
+			break
+		}
+
+		args, err := ec.field_Blob_createdBy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Blob.CreatedBy(childComplexity, args["filter"].(*model.UserFilter)), true
+
+	case "Blob.id":
+		if e.complexity.Blob.ID == nil {
+			break
+		}
+
+		return e.complexity.Blob.ID(childComplexity), true
+
+	case "Blob.md":
+		if e.complexity.Blob.Md == nil {
+			break
+		}
+
+		return e.complexity.Blob.Md(childComplexity), true
+
+	case "Blob.message":
+		if e.complexity.Blob.Message == nil {
+			break
+		}
+
+		return e.complexity.Blob.Message(childComplexity), true
 
 	case "Blob.node":
 		if e.complexity.Blob.Node == nil {
@@ -2149,6 +2888,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BlobAggregateResult.UpdatedAtMin(childComplexity), true
 
+	case "Comment.attachments":
+		if e.complexity.Comment.Attachments == nil {
+			break
+		}
+
+		args, err := ec.field_Comment_attachments_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Comment.Attachments(childComplexity, args["filter"].(*model.AttachmentFilter), args["order"].(*model.AttachmentOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Comment.attachmentsAggregate":
+		if e.complexity.Comment.AttachmentsAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Comment_attachmentsAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Comment.AttachmentsAggregate(childComplexity, args["filter"].(*model.AttachmentFilter)), true
+
 	case "Comment.createdAt":
 		if e.complexity.Comment.CreatedAt == nil {
 			break
@@ -2534,6 +3297,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ContractAggregateResult.UpdatedAtMin(childComplexity), true
 
+	case "DeleteAttachmentPayload.attachment":
+		if e.complexity.DeleteAttachmentPayload.Attachment == nil {
+			break
+		}
+
+		args, err := ec.field_DeleteAttachmentPayload_attachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.DeleteAttachmentPayload.Attachment(childComplexity, args["filter"].(*model.AttachmentFilter), args["order"].(*model.AttachmentOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "DeleteAttachmentPayload.msg":
+		if e.complexity.DeleteAttachmentPayload.Msg == nil {
+			break
+		}
+
+		return e.complexity.DeleteAttachmentPayload.Msg(childComplexity), true
+
+	case "DeleteAttachmentPayload.numUids":
+		if e.complexity.DeleteAttachmentPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.DeleteAttachmentPayload.NumUids(childComplexity), true
+
 	case "DeleteBlobPayload.blob":
 		if e.complexity.DeleteBlobPayload.Blob == nil {
 			break
@@ -2716,6 +3505,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DeleteLabelPayload.NumUids(childComplexity), true
 
+	case "DeleteMailingSettingPayload.mailingSetting":
+		if e.complexity.DeleteMailingSettingPayload.MailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_DeleteMailingSettingPayload_mailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.DeleteMailingSettingPayload.MailingSetting(childComplexity, args["filter"].(*model.MailingSettingFilter), args["order"].(*model.MailingSettingOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "DeleteMailingSettingPayload.msg":
+		if e.complexity.DeleteMailingSettingPayload.Msg == nil {
+			break
+		}
+
+		return e.complexity.DeleteMailingSettingPayload.Msg(childComplexity), true
+
+	case "DeleteMailingSettingPayload.numUids":
+		if e.complexity.DeleteMailingSettingPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.DeleteMailingSettingPayload.NumUids(childComplexity), true
+
 	case "DeleteMandatePayload.mandate":
 		if e.complexity.DeleteMandatePayload.Mandate == nil {
 			break
@@ -2742,6 +3557,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DeleteMandatePayload.NumUids(childComplexity), true
 
+	case "DeleteMatrixRoomPayload.matrixRoom":
+		if e.complexity.DeleteMatrixRoomPayload.MatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_DeleteMatrixRoomPayload_matrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.DeleteMatrixRoomPayload.MatrixRoom(childComplexity, args["filter"].(*model.MatrixRoomFilter), args["order"].(*model.MatrixRoomOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "DeleteMatrixRoomPayload.msg":
+		if e.complexity.DeleteMatrixRoomPayload.Msg == nil {
+			break
+		}
+
+		return e.complexity.DeleteMatrixRoomPayload.Msg(childComplexity), true
+
+	case "DeleteMatrixRoomPayload.numUids":
+		if e.complexity.DeleteMatrixRoomPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.DeleteMatrixRoomPayload.NumUids(childComplexity), true
+
 	case "DeleteNodeFragmentPayload.msg":
 		if e.complexity.DeleteNodeFragmentPayload.Msg == nil {
 			break
@@ -2820,6 +3661,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DeleteNotifPayload.NumUids(childComplexity), true
 
+	case "DeleteNotifPreferencePayload.msg":
+		if e.complexity.DeleteNotifPreferencePayload.Msg == nil {
+			break
+		}
+
+		return e.complexity.DeleteNotifPreferencePayload.Msg(childComplexity), true
+
+	case "DeleteNotifPreferencePayload.notifPreference":
+		if e.complexity.DeleteNotifPreferencePayload.NotifPreference == nil {
+			break
+		}
+
+		args, err := ec.field_DeleteNotifPreferencePayload_notifPreference_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.DeleteNotifPreferencePayload.NotifPreference(childComplexity, args["filter"].(*model.NotifPreferenceFilter), args["order"].(*model.NotifPreferenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "DeleteNotifPreferencePayload.numUids":
+		if e.complexity.DeleteNotifPreferencePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.DeleteNotifPreferencePayload.NumUids(childComplexity), true
+
 	case "DeletePendingUserPayload.msg":
 		if e.complexity.DeletePendingUserPayload.Msg == nil {
 			break
@@ -3054,6 +3921,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DeleteReactionPayload.Reaction(childComplexity, args["filter"].(*model.ReactionFilter), args["order"].(*model.ReactionOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "DeleteRecurrencePayload.msg":
+		if e.complexity.DeleteRecurrencePayload.Msg == nil {
+			break
+		}
+
+		return e.complexity.DeleteRecurrencePayload.Msg(childComplexity), true
+
+	case "DeleteRecurrencePayload.numUids":
+		if e.complexity.DeleteRecurrencePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.DeleteRecurrencePayload.NumUids(childComplexity), true
+
+	case "DeleteRecurrencePayload.recurrence":
+		if e.complexity.DeleteRecurrencePayload.Recurrence == nil {
+			break
+		}
+
+		args, err := ec.field_DeleteRecurrencePayload_recurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.DeleteRecurrencePayload.Recurrence(childComplexity, args["filter"].(*model.RecurrenceFilter), args["order"].(*model.RecurrenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "DeleteRoleExtPayload.msg":
 		if e.complexity.DeleteRoleExtPayload.Msg == nil {
 			break
@@ -3106,6 +3999,58 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DeleteTensionPayload.Tension(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "DeleteTensionTemplatePayload.msg":
+		if e.complexity.DeleteTensionTemplatePayload.Msg == nil {
+			break
+		}
+
+		return e.complexity.DeleteTensionTemplatePayload.Msg(childComplexity), true
+
+	case "DeleteTensionTemplatePayload.numUids":
+		if e.complexity.DeleteTensionTemplatePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.DeleteTensionTemplatePayload.NumUids(childComplexity), true
+
+	case "DeleteTensionTemplatePayload.tensionTemplate":
+		if e.complexity.DeleteTensionTemplatePayload.TensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_DeleteTensionTemplatePayload_tensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.DeleteTensionTemplatePayload.TensionTemplate(childComplexity, args["filter"].(*model.TensionTemplateFilter), args["order"].(*model.TensionTemplateOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "DeleteTensionViewPayload.msg":
+		if e.complexity.DeleteTensionViewPayload.Msg == nil {
+			break
+		}
+
+		return e.complexity.DeleteTensionViewPayload.Msg(childComplexity), true
+
+	case "DeleteTensionViewPayload.numUids":
+		if e.complexity.DeleteTensionViewPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.DeleteTensionViewPayload.NumUids(childComplexity), true
+
+	case "DeleteTensionViewPayload.tensionView":
+		if e.complexity.DeleteTensionViewPayload.TensionView == nil {
+			break
+		}
+
+		args, err := ec.field_DeleteTensionViewPayload_tensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.DeleteTensionViewPayload.TensionView(childComplexity, args["filter"].(*model.TensionViewFilter), args["order"].(*model.TensionViewOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "DeleteUserEventPayload.msg":
 		if e.complexity.DeleteUserEventPayload.Msg == nil {
 			break
@@ -3686,28 +4631,190 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LabelAggregateResult.RootnameidMin(childComplexity), true
 
-	case "Mandate.domains":
-		if e.complexity.Mandate.Domains == nil {
+	case "MailingSetting.alias":
+		if e.complexity.MailingSetting.Alias == nil {
 			break
 		}
 
-		return e.complexity.Mandate.Domains(childComplexity), true
+		return e.complexity.MailingSetting.Alias(childComplexity), true
 
-	case "Mandate.id":
-		if e.complexity.Mandate.ID == nil {
+	case "MailingSetting.allowlist":
+		if e.complexity.MailingSetting.Allowlist == nil {
 			break
 		}
 
-		return e.complexity.Mandate.ID(childComplexity), true
+		return e.complexity.MailingSetting.Allowlist(childComplexity), true
 
-	case "Mandate.policies":
-		if e.complexity.Mandate.Policies == nil {
+	case "MailingSetting.createdAt":
+		if e.complexity.MailingSetting.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Mandate.Policies(childComplexity), true
+		return e.complexity.MailingSetting.CreatedAt(childComplexity), true
 
-	case "Mandate.purpose":
+	case "MailingSetting.createdBy":
+		if e.complexity.MailingSetting.CreatedBy == nil {
+			break
+		}
+
+		args, err := ec.field_MailingSetting_createdBy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.MailingSetting.CreatedBy(childComplexity, args["filter"].(*model.UserFilter)), true
+
+	case "MailingSetting.enabled":
+		if e.complexity.MailingSetting.Enabled == nil {
+			break
+		}
+
+		return e.complexity.MailingSetting.Enabled(childComplexity), true
+
+	case "MailingSetting.id":
+		if e.complexity.MailingSetting.ID == nil {
+			break
+		}
+
+		return e.complexity.MailingSetting.ID(childComplexity), true
+
+	case "MailingSetting.labels":
+		if e.complexity.MailingSetting.Labels == nil {
+			break
+		}
+
+		args, err := ec.field_MailingSetting_labels_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.MailingSetting.Labels(childComplexity, args["filter"].(*model.LabelFilter), args["order"].(*model.LabelOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "MailingSetting.labelsAggregate":
+		if e.complexity.MailingSetting.LabelsAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_MailingSetting_labelsAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.MailingSetting.LabelsAggregate(childComplexity, args["filter"].(*model.LabelFilter)), true
+
+	case "MailingSetting.nameid":
+		if e.complexity.MailingSetting.Nameid == nil {
+			break
+		}
+
+		return e.complexity.MailingSetting.Nameid(childComplexity), true
+
+	case "MailingSetting.rootnameid":
+		if e.complexity.MailingSetting.Rootnameid == nil {
+			break
+		}
+
+		return e.complexity.MailingSetting.Rootnameid(childComplexity), true
+
+	case "MailingSetting.senders":
+		if e.complexity.MailingSetting.Senders == nil {
+			break
+		}
+
+		return e.complexity.MailingSetting.Senders(childComplexity), true
+
+	case "MailingSetting.type_":
+		if e.complexity.MailingSetting.Type == nil {
+			break
+		}
+
+		return e.complexity.MailingSetting.Type(childComplexity), true
+
+	case "MailingSettingAggregateResult.aliasMax":
+		if e.complexity.MailingSettingAggregateResult.AliasMax == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.AliasMax(childComplexity), true
+
+	case "MailingSettingAggregateResult.aliasMin":
+		if e.complexity.MailingSettingAggregateResult.AliasMin == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.AliasMin(childComplexity), true
+
+	case "MailingSettingAggregateResult.count":
+		if e.complexity.MailingSettingAggregateResult.Count == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.Count(childComplexity), true
+
+	case "MailingSettingAggregateResult.createdAtMax":
+		if e.complexity.MailingSettingAggregateResult.CreatedAtMax == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.CreatedAtMax(childComplexity), true
+
+	case "MailingSettingAggregateResult.createdAtMin":
+		if e.complexity.MailingSettingAggregateResult.CreatedAtMin == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.CreatedAtMin(childComplexity), true
+
+	case "MailingSettingAggregateResult.nameidMax":
+		if e.complexity.MailingSettingAggregateResult.NameidMax == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.NameidMax(childComplexity), true
+
+	case "MailingSettingAggregateResult.nameidMin":
+		if e.complexity.MailingSettingAggregateResult.NameidMin == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.NameidMin(childComplexity), true
+
+	case "MailingSettingAggregateResult.rootnameidMax":
+		if e.complexity.MailingSettingAggregateResult.RootnameidMax == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.RootnameidMax(childComplexity), true
+
+	case "MailingSettingAggregateResult.rootnameidMin":
+		if e.complexity.MailingSettingAggregateResult.RootnameidMin == nil {
+			break
+		}
+
+		return e.complexity.MailingSettingAggregateResult.RootnameidMin(childComplexity), true
+
+	case "Mandate.domains":
+		if e.complexity.Mandate.Domains == nil {
+			break
+		}
+
+		return e.complexity.Mandate.Domains(childComplexity), true
+
+	case "Mandate.id":
+		if e.complexity.Mandate.ID == nil {
+			break
+		}
+
+		return e.complexity.Mandate.ID(childComplexity), true
+
+	case "Mandate.policies":
+		if e.complexity.Mandate.Policies == nil {
+			break
+		}
+
+		return e.complexity.Mandate.Policies(childComplexity), true
+
+	case "Mandate.purpose":
 		if e.complexity.Mandate.Purpose == nil {
 			break
 		}
@@ -3784,6 +4891,116 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MandateAggregateResult.ResponsabilitiesMin(childComplexity), true
 
+	case "MatrixRoom.createdAt":
+		if e.complexity.MatrixRoom.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoom.CreatedAt(childComplexity), true
+
+	case "MatrixRoom.createdBy":
+		if e.complexity.MatrixRoom.CreatedBy == nil {
+			break
+		}
+
+		args, err := ec.field_MatrixRoom_createdBy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.MatrixRoom.CreatedBy(childComplexity, args["filter"].(*model.UserFilter)), true
+
+	case "MatrixRoom.id":
+		if e.complexity.MatrixRoom.ID == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoom.ID(childComplexity), true
+
+	case "MatrixRoom.nameid":
+		if e.complexity.MatrixRoom.Nameid == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoom.Nameid(childComplexity), true
+
+	case "MatrixRoom.roomid":
+		if e.complexity.MatrixRoom.Roomid == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoom.Roomid(childComplexity), true
+
+	case "MatrixRoom.rootnameid":
+		if e.complexity.MatrixRoom.Rootnameid == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoom.Rootnameid(childComplexity), true
+
+	case "MatrixRoomAggregateResult.count":
+		if e.complexity.MatrixRoomAggregateResult.Count == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.Count(childComplexity), true
+
+	case "MatrixRoomAggregateResult.createdAtMax":
+		if e.complexity.MatrixRoomAggregateResult.CreatedAtMax == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.CreatedAtMax(childComplexity), true
+
+	case "MatrixRoomAggregateResult.createdAtMin":
+		if e.complexity.MatrixRoomAggregateResult.CreatedAtMin == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.CreatedAtMin(childComplexity), true
+
+	case "MatrixRoomAggregateResult.nameidMax":
+		if e.complexity.MatrixRoomAggregateResult.NameidMax == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.NameidMax(childComplexity), true
+
+	case "MatrixRoomAggregateResult.nameidMin":
+		if e.complexity.MatrixRoomAggregateResult.NameidMin == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.NameidMin(childComplexity), true
+
+	case "MatrixRoomAggregateResult.roomidMax":
+		if e.complexity.MatrixRoomAggregateResult.RoomidMax == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.RoomidMax(childComplexity), true
+
+	case "MatrixRoomAggregateResult.roomidMin":
+		if e.complexity.MatrixRoomAggregateResult.RoomidMin == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.RoomidMin(childComplexity), true
+
+	case "MatrixRoomAggregateResult.rootnameidMax":
+		if e.complexity.MatrixRoomAggregateResult.RootnameidMax == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.RootnameidMax(childComplexity), true
+
+	case "MatrixRoomAggregateResult.rootnameidMin":
+		if e.complexity.MatrixRoomAggregateResult.RootnameidMin == nil {
+			break
+		}
+
+		return e.complexity.MatrixRoomAggregateResult.RootnameidMin(childComplexity), true
+
 	case "MultiPolygon.polygons":
 		if e.complexity.MultiPolygon.Polygons == nil {
 			break
@@ -3791,6 +5008,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MultiPolygon.Polygons(childComplexity), true
 
+	case "Mutation.addAttachment":
+		if e.complexity.Mutation.AddAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddAttachment(childComplexity, args["input"].([]*model.AddAttachmentInput)), true
+
 	case "Mutation.addBlob":
 		if e.complexity.Mutation.AddBlob == nil {
 			break
@@ -3875,6 +5104,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddLabel(childComplexity, args["input"].([]*model.AddLabelInput)), true
 
+	case "Mutation.addMailingSetting":
+		if e.complexity.Mutation.AddMailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addMailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddMailingSetting(childComplexity, args["input"].([]*model.AddMailingSettingInput), args["upsert"].(*bool)), true
+
 	case "Mutation.addMandate":
 		if e.complexity.Mutation.AddMandate == nil {
 			break
@@ -3887,6 +5128,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddMandate(childComplexity, args["input"].([]*model.AddMandateInput)), true
 
+	case "Mutation.addMatrixRoom":
+		if e.complexity.Mutation.AddMatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addMatrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddMatrixRoom(childComplexity, args["input"].([]*model.AddMatrixRoomInput), args["upsert"].(*bool)), true
+
 	case "Mutation.addNode":
 		if e.complexity.Mutation.AddNode == nil {
 			break
@@ -3923,6 +5176,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddNotif(childComplexity, args["input"].([]*model.AddNotifInput)), true
 
+	case "Mutation.addNotifPreference":
+		if e.complexity.Mutation.AddNotifPreference == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addNotifPreference_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddNotifPreference(childComplexity, args["input"].([]*model.AddNotifPreferenceInput), args["upsert"].(*bool)), true
+
 	case "Mutation.addPendingUser":
 		if e.complexity.Mutation.AddPendingUser == nil {
 			break
@@ -4019,6 +5284,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddReaction(childComplexity, args["input"].([]*model.AddReactionInput), args["upsert"].(*bool)), true
 
+	case "Mutation.addRecurrence":
+		if e.complexity.Mutation.AddRecurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addRecurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddRecurrence(childComplexity, args["input"].([]*model.AddRecurrenceInput)), true
+
 	case "Mutation.addRoleExt":
 		if e.complexity.Mutation.AddRoleExt == nil {
 			break
@@ -4043,6 +5320,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddTension(childComplexity, args["input"].([]*model.AddTensionInput)), true
 
+	case "Mutation.addTensionTemplate":
+		if e.complexity.Mutation.AddTensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTensionTemplate(childComplexity, args["input"].([]*model.AddTensionTemplateInput)), true
+
+	case "Mutation.addTensionView":
+		if e.complexity.Mutation.AddTensionView == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTensionView(childComplexity, args["input"].([]*model.AddTensionViewInput)), true
+
 	case "Mutation.addUser":
 		if e.complexity.Mutation.AddUser == nil {
 			break
@@ -4091,6 +5392,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddVote(childComplexity, args["input"].([]*model.AddVoteInput), args["upsert"].(*bool)), true
 
+	case "Mutation.deleteAttachment":
+		if e.complexity.Mutation.DeleteAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteAttachment(childComplexity, args["filter"].(model.AttachmentFilter)), true
+
 	case "Mutation.deleteBlob":
 		if e.complexity.Mutation.DeleteBlob == nil {
 			break
@@ -4175,6 +5488,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteLabel(childComplexity, args["filter"].(model.LabelFilter)), true
 
+	case "Mutation.deleteMailingSetting":
+		if e.complexity.Mutation.DeleteMailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteMailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMailingSetting(childComplexity, args["filter"].(model.MailingSettingFilter)), true
+
 	case "Mutation.deleteMandate":
 		if e.complexity.Mutation.DeleteMandate == nil {
 			break
@@ -4187,6 +5512,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteMandate(childComplexity, args["filter"].(model.MandateFilter)), true
 
+	case "Mutation.deleteMatrixRoom":
+		if e.complexity.Mutation.DeleteMatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteMatrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMatrixRoom(childComplexity, args["filter"].(model.MatrixRoomFilter)), true
+
 	case "Mutation.deleteNode":
 		if e.complexity.Mutation.DeleteNode == nil {
 			break
@@ -4223,20 +5560,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteNotif(childComplexity, args["filter"].(model.NotifFilter)), true
 
-	case "Mutation.deletePendingUser":
-		if e.complexity.Mutation.DeletePendingUser == nil {
+	case "Mutation.deleteNotifPreference":
+		if e.complexity.Mutation.DeleteNotifPreference == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deletePendingUser_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_deleteNotifPreference_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeletePendingUser(childComplexity, args["filter"].(model.PendingUserFilter)), true
+		return e.complexity.Mutation.DeleteNotifPreference(childComplexity, args["filter"].(model.NotifPreferenceFilter)), true
 
-	case "Mutation.deletePost":
-		if e.complexity.Mutation.DeletePost == nil {
+	case "Mutation.deletePendingUser":
+		if e.complexity.Mutation.DeletePendingUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deletePendingUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeletePendingUser(childComplexity, args["filter"].(model.PendingUserFilter)), true
+
+	case "Mutation.deletePost":
+		if e.complexity.Mutation.DeletePost == nil {
 			break
 		}
 
@@ -4331,6 +5680,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteReaction(childComplexity, args["filter"].(model.ReactionFilter)), true
 
+	case "Mutation.deleteRecurrence":
+		if e.complexity.Mutation.DeleteRecurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteRecurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteRecurrence(childComplexity, args["filter"].(model.RecurrenceFilter)), true
+
 	case "Mutation.deleteRoleExt":
 		if e.complexity.Mutation.DeleteRoleExt == nil {
 			break
@@ -4355,6 +5716,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteTension(childComplexity, args["filter"].(model.TensionFilter)), true
 
+	case "Mutation.deleteTensionTemplate":
+		if e.complexity.Mutation.DeleteTensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTensionTemplate(childComplexity, args["filter"].(model.TensionTemplateFilter)), true
+
+	case "Mutation.deleteTensionView":
+		if e.complexity.Mutation.DeleteTensionView == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteTensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteTensionView(childComplexity, args["filter"].(model.TensionViewFilter)), true
+
 	case "Mutation.deleteUser":
 		if e.complexity.Mutation.DeleteUser == nil {
 			break
@@ -4403,6 +5788,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteVote(childComplexity, args["filter"].(model.VoteFilter)), true
 
+	case "Mutation.updateAttachment":
+		if e.complexity.Mutation.UpdateAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateAttachment(childComplexity, args["input"].(model.UpdateAttachmentInput)), true
+
 	case "Mutation.updateBlob":
 		if e.complexity.Mutation.UpdateBlob == nil {
 			break
@@ -4487,6 +5884,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateLabel(childComplexity, args["input"].(model.UpdateLabelInput)), true
 
+	case "Mutation.updateMailingSetting":
+		if e.complexity.Mutation.UpdateMailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateMailingSetting(childComplexity, args["input"].(model.UpdateMailingSettingInput)), true
+
 	case "Mutation.updateMandate":
 		if e.complexity.Mutation.UpdateMandate == nil {
 			break
@@ -4499,6 +5908,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateMandate(childComplexity, args["input"].(model.UpdateMandateInput)), true
 
+	case "Mutation.updateMatrixRoom":
+		if e.complexity.Mutation.UpdateMatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMatrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateMatrixRoom(childComplexity, args["input"].(model.UpdateMatrixRoomInput)), true
+
 	case "Mutation.updateNode":
 		if e.complexity.Mutation.UpdateNode == nil {
 			break
@@ -4535,6 +5956,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateNotif(childComplexity, args["input"].(model.UpdateNotifInput)), true
 
+	case "Mutation.updateNotifPreference":
+		if e.complexity.Mutation.UpdateNotifPreference == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateNotifPreference_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateNotifPreference(childComplexity, args["input"].(model.UpdateNotifPreferenceInput)), true
+
 	case "Mutation.updatePendingUser":
 		if e.complexity.Mutation.UpdatePendingUser == nil {
 			break
@@ -4643,6 +6076,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateReaction(childComplexity, args["input"].(model.UpdateReactionInput)), true
 
+	case "Mutation.updateRecurrence":
+		if e.complexity.Mutation.UpdateRecurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateRecurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateRecurrence(childComplexity, args["input"].(model.UpdateRecurrenceInput)), true
+
 	case "Mutation.updateRoleExt":
 		if e.complexity.Mutation.UpdateRoleExt == nil {
 			break
@@ -4667,6 +6112,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateTension(childComplexity, args["input"].(model.UpdateTensionInput)), true
 
+	case "Mutation.updateTensionTemplate":
+		if e.complexity.Mutation.UpdateTensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTensionTemplate(childComplexity, args["input"].(model.UpdateTensionTemplateInput)), true
+
+	case "Mutation.updateTensionView":
+		if e.complexity.Mutation.UpdateTensionView == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTensionView(childComplexity, args["input"].(model.UpdateTensionViewInput)), true
+
 	case "Mutation.updateUser":
 		if e.complexity.Mutation.UpdateUser == nil {
 			break
@@ -4715,6 +6184,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateVote(childComplexity, args["input"].(model.UpdateVoteInput)), true
 
+	case "Mutation.uploadAttachment":
+		if e.complexity.Mutation.UploadAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadAttachment(childComplexity, args["tid"].(string), args["cid"].(*string), args["file"].(graphql.Upload)), true
+
 	case "Node.about":
 		if e.complexity.Node.About == nil {
 			break
@@ -5048,6 +6529,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Node.Source(childComplexity, args["filter"].(*model.BlobFilter)), true
 
+	case "Node.templates":
+		if e.complexity.Node.Templates == nil {
+			break
+		}
+
+		args, err := ec.field_Node_templates_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Node.Templates(childComplexity, args["filter"].(*model.TensionTemplateFilter), args["order"].(*model.TensionTemplateOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Node.templatesAggregate":
+		if e.complexity.Node.TemplatesAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Node_templatesAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Node.TemplatesAggregate(childComplexity, args["filter"].(*model.TensionTemplateFilter)), true
+
 	case "Node.tensions_in":
 		if e.complexity.Node.TensionsIn == nil {
 			break
@@ -5602,6 +7107,95 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.NotifAggregateResult.UpdatedAtMin(childComplexity), true
 
+	case "NotifPreference.channel":
+		if e.complexity.NotifPreference.Channel == nil {
+			break
+		}
+
+		return e.complexity.NotifPreference.Channel(childComplexity), true
+
+	case "NotifPreference.event_group":
+		if e.complexity.NotifPreference.EventGroup == nil {
+			break
+		}
+
+		return e.complexity.NotifPreference.EventGroup(childComplexity), true
+
+	case "NotifPreference.id":
+		if e.complexity.NotifPreference.ID == nil {
+			break
+		}
+
+		return e.complexity.NotifPreference.ID(childComplexity), true
+
+	case "NotifPreference.preferenceid":
+		if e.complexity.NotifPreference.Preferenceid == nil {
+			break
+		}
+
+		return e.complexity.NotifPreference.Preferenceid(childComplexity), true
+
+	case "NotifPreference.reason":
+		if e.complexity.NotifPreference.Reason == nil {
+			break
+		}
+
+		return e.complexity.NotifPreference.Reason(childComplexity), true
+
+	case "NotifPreference.rootnameid":
+		if e.complexity.NotifPreference.Rootnameid == nil {
+			break
+		}
+
+		return e.complexity.NotifPreference.Rootnameid(childComplexity), true
+
+	case "NotifPreference.user":
+		if e.complexity.NotifPreference.User == nil {
+			break
+		}
+
+		args, err := ec.field_NotifPreference_user_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.NotifPreference.User(childComplexity, args["filter"].(*model.UserFilter)), true
+
+	case "NotifPreferenceAggregateResult.count":
+		if e.complexity.NotifPreferenceAggregateResult.Count == nil {
+			break
+		}
+
+		return e.complexity.NotifPreferenceAggregateResult.Count(childComplexity), true
+
+	case "NotifPreferenceAggregateResult.preferenceidMax":
+		if e.complexity.NotifPreferenceAggregateResult.PreferenceidMax == nil {
+			break
+		}
+
+		return e.complexity.NotifPreferenceAggregateResult.PreferenceidMax(childComplexity), true
+
+	case "NotifPreferenceAggregateResult.preferenceidMin":
+		if e.complexity.NotifPreferenceAggregateResult.PreferenceidMin == nil {
+			break
+		}
+
+		return e.complexity.NotifPreferenceAggregateResult.PreferenceidMin(childComplexity), true
+
+	case "NotifPreferenceAggregateResult.rootnameidMax":
+		if e.complexity.NotifPreferenceAggregateResult.RootnameidMax == nil {
+			break
+		}
+
+		return e.complexity.NotifPreferenceAggregateResult.RootnameidMax(childComplexity), true
+
+	case "NotifPreferenceAggregateResult.rootnameidMin":
+		if e.complexity.NotifPreferenceAggregateResult.RootnameidMin == nil {
+			break
+		}
+
+		return e.complexity.NotifPreferenceAggregateResult.RootnameidMin(childComplexity), true
+
 	case "PendingUser.contracts":
 		if e.complexity.PendingUser.Contracts == nil {
 			break
@@ -6708,6 +8302,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ProjectFieldValueAggregateResult.ValueMin(childComplexity), true
 
+	case "Query.aggregateAttachment":
+		if e.complexity.Query.AggregateAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_aggregateAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AggregateAttachment(childComplexity, args["filter"].(*model.AttachmentFilter)), true
+
 	case "Query.aggregateBlob":
 		if e.complexity.Query.AggregateBlob == nil {
 			break
@@ -6792,6 +8398,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AggregateLabel(childComplexity, args["filter"].(*model.LabelFilter)), true
 
+	case "Query.aggregateMailingSetting":
+		if e.complexity.Query.AggregateMailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_Query_aggregateMailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AggregateMailingSetting(childComplexity, args["filter"].(*model.MailingSettingFilter)), true
+
 	case "Query.aggregateMandate":
 		if e.complexity.Query.AggregateMandate == nil {
 			break
@@ -6804,6 +8422,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AggregateMandate(childComplexity, args["filter"].(*model.MandateFilter)), true
 
+	case "Query.aggregateMatrixRoom":
+		if e.complexity.Query.AggregateMatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_aggregateMatrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AggregateMatrixRoom(childComplexity, args["filter"].(*model.MatrixRoomFilter)), true
+
 	case "Query.aggregateNode":
 		if e.complexity.Query.AggregateNode == nil {
 			break
@@ -6840,6 +8470,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AggregateNotif(childComplexity, args["filter"].(*model.NotifFilter)), true
 
+	case "Query.aggregateNotifPreference":
+		if e.complexity.Query.AggregateNotifPreference == nil {
+			break
+		}
+
+		args, err := ec.field_Query_aggregateNotifPreference_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AggregateNotifPreference(childComplexity, args["filter"].(*model.NotifPreferenceFilter)), true
+
 	case "Query.aggregatePendingUser":
 		if e.complexity.Query.AggregatePendingUser == nil {
 			break
@@ -6948,6 +8590,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AggregateReaction(childComplexity, args["filter"].(*model.ReactionFilter)), true
 
+	case "Query.aggregateRecurrence":
+		if e.complexity.Query.AggregateRecurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Query_aggregateRecurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AggregateRecurrence(childComplexity, args["filter"].(*model.RecurrenceFilter)), true
+
 	case "Query.aggregateRoleExt":
 		if e.complexity.Query.AggregateRoleExt == nil {
 			break
@@ -6972,6 +8626,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AggregateTension(childComplexity, args["filter"].(*model.TensionFilter)), true
 
+	case "Query.aggregateTensionTemplate":
+		if e.complexity.Query.AggregateTensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_Query_aggregateTensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AggregateTensionTemplate(childComplexity, args["filter"].(*model.TensionTemplateFilter)), true
+
+	case "Query.aggregateTensionView":
+		if e.complexity.Query.AggregateTensionView == nil {
+			break
+		}
+
+		args, err := ec.field_Query_aggregateTensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AggregateTensionView(childComplexity, args["filter"].(*model.TensionViewFilter)), true
+
 	case "Query.aggregateUser":
 		if e.complexity.Query.AggregateUser == nil {
 			break
@@ -7020,6 +8698,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.AggregateVote(childComplexity, args["filter"].(*model.VoteFilter)), true
 
+	case "Query.getAttachment":
+		if e.complexity.Query.GetAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetAttachment(childComplexity, args["id"].(string)), true
+
 	case "Query.getBlob":
 		if e.complexity.Query.GetBlob == nil {
 			break
@@ -7080,6 +8770,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetLabel(childComplexity, args["id"].(string)), true
 
+	case "Query.getMailingSetting":
+		if e.complexity.Query.GetMailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMailingSetting(childComplexity, args["id"].(*string), args["nameid"].(*string)), true
+
 	case "Query.getMandate":
 		if e.complexity.Query.GetMandate == nil {
 			break
@@ -7092,6 +8794,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetMandate(childComplexity, args["id"].(string)), true
 
+	case "Query.getMatrixRoom":
+		if e.complexity.Query.GetMatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getMatrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMatrixRoom(childComplexity, args["id"].(*string), args["nameid"].(*string)), true
+
 	case "Query.getNode":
 		if e.complexity.Query.GetNode == nil {
 			break
@@ -7128,20 +8842,32 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetNotif(childComplexity, args["id"].(string)), true
 
-	case "Query.getPendingUser":
-		if e.complexity.Query.GetPendingUser == nil {
+	case "Query.getNotifPreference":
+		if e.complexity.Query.GetNotifPreference == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getPendingUser_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_getNotifPreference_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.GetPendingUser(childComplexity, args["id"].(*string), args["username"].(*string), args["email"].(*string)), true
+		return e.complexity.Query.GetNotifPreference(childComplexity, args["id"].(*string), args["preferenceid"].(*string)), true
 
-	case "Query.getPost":
-		if e.complexity.Query.GetPost == nil {
+	case "Query.getPendingUser":
+		if e.complexity.Query.GetPendingUser == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getPendingUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetPendingUser(childComplexity, args["id"].(*string), args["username"].(*string), args["email"].(*string)), true
+
+	case "Query.getPost":
+		if e.complexity.Query.GetPost == nil {
 			break
 		}
 
@@ -7212,6 +8938,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetReaction(childComplexity, args["id"].(*string), args["reactionid"].(*string)), true
 
+	case "Query.getRecurrence":
+		if e.complexity.Query.GetRecurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getRecurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetRecurrence(childComplexity, args["id"].(string)), true
+
 	case "Query.getRoleExt":
 		if e.complexity.Query.GetRoleExt == nil {
 			break
@@ -7236,6 +8974,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetTension(childComplexity, args["id"].(string)), true
 
+	case "Query.getTensionTemplate":
+		if e.complexity.Query.GetTensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getTensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetTensionTemplate(childComplexity, args["id"].(string)), true
+
+	case "Query.getTensionView":
+		if e.complexity.Query.GetTensionView == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getTensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetTensionView(childComplexity, args["id"].(string)), true
+
 	case "Query.getUser":
 		if e.complexity.Query.GetUser == nil {
 			break
@@ -7272,6 +9034,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetVote(childComplexity, args["id"].(*string), args["voteid"].(*string)), true
 
+	case "Query.queryAttachment":
+		if e.complexity.Query.QueryAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryAttachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryAttachment(childComplexity, args["filter"].(*model.AttachmentFilter), args["order"].(*model.AttachmentOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "Query.queryBlob":
 		if e.complexity.Query.QueryBlob == nil {
 			break
@@ -7356,6 +9130,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.QueryLabel(childComplexity, args["filter"].(*model.LabelFilter), args["order"].(*model.LabelOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "Query.queryMailingSetting":
+		if e.complexity.Query.QueryMailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryMailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryMailingSetting(childComplexity, args["filter"].(*model.MailingSettingFilter), args["order"].(*model.MailingSettingOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "Query.queryMandate":
 		if e.complexity.Query.QueryMandate == nil {
 			break
@@ -7368,6 +9154,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.QueryMandate(childComplexity, args["filter"].(*model.MandateFilter), args["order"].(*model.MandateOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "Query.queryMatrixRoom":
+		if e.complexity.Query.QueryMatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryMatrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryMatrixRoom(childComplexity, args["filter"].(*model.MatrixRoomFilter), args["order"].(*model.MatrixRoomOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "Query.queryNode":
 		if e.complexity.Query.QueryNode == nil {
 			break
@@ -7404,6 +9202,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.QueryNotif(childComplexity, args["filter"].(*model.NotifFilter), args["order"].(*model.NotifOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "Query.queryNotifPreference":
+		if e.complexity.Query.QueryNotifPreference == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryNotifPreference_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryNotifPreference(childComplexity, args["filter"].(*model.NotifPreferenceFilter), args["order"].(*model.NotifPreferenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "Query.queryPendingUser":
 		if e.complexity.Query.QueryPendingUser == nil {
 			break
@@ -7512,6 +9322,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.QueryReaction(childComplexity, args["filter"].(*model.ReactionFilter), args["order"].(*model.ReactionOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "Query.queryRecurrence":
+		if e.complexity.Query.QueryRecurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryRecurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryRecurrence(childComplexity, args["filter"].(*model.RecurrenceFilter), args["order"].(*model.RecurrenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "Query.queryRoleExt":
 		if e.complexity.Query.QueryRoleExt == nil {
 			break
@@ -7536,6 +9358,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.QueryTension(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "Query.queryTensionTemplate":
+		if e.complexity.Query.QueryTensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryTensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryTensionTemplate(childComplexity, args["filter"].(*model.TensionTemplateFilter), args["order"].(*model.TensionTemplateOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Query.queryTensionView":
+		if e.complexity.Query.QueryTensionView == nil {
+			break
+		}
+
+		args, err := ec.field_Query_queryTensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.QueryTensionView(childComplexity, args["filter"].(*model.TensionViewFilter), args["order"].(*model.TensionViewOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "Query.queryUser":
 		if e.complexity.Query.QueryUser == nil {
 			break
@@ -7678,6 +9524,245 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ReactionAggregateResult.TypeSum(childComplexity), true
 
+	case "Recurrence.count":
+		if e.complexity.Recurrence.Count == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.Count(childComplexity), true
+
+	case "Recurrence.createdAt":
+		if e.complexity.Recurrence.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.CreatedAt(childComplexity), true
+
+	case "Recurrence.createdBy":
+		if e.complexity.Recurrence.CreatedBy == nil {
+			break
+		}
+
+		args, err := ec.field_Recurrence_createdBy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Recurrence.CreatedBy(childComplexity, args["filter"].(*model.UserFilter)), true
+
+	case "Recurrence.dtstart":
+		if e.complexity.Recurrence.Dtstart == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.Dtstart(childComplexity), true
+
+	case "Recurrence.id":
+		if e.complexity.Recurrence.ID == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.ID(childComplexity), true
+
+	case "Recurrence.next_at":
+		if e.complexity.Recurrence.NextAt == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.NextAt(childComplexity), true
+
+	case "Recurrence.paused":
+		if e.complexity.Recurrence.Paused == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.Paused(childComplexity), true
+
+	case "Recurrence.receiverid":
+		if e.complexity.Recurrence.Receiverid == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.Receiverid(childComplexity), true
+
+	case "Recurrence.rootnameid":
+		if e.complexity.Recurrence.Rootnameid == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.Rootnameid(childComplexity), true
+
+	case "Recurrence.rrule":
+		if e.complexity.Recurrence.Rrule == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.Rrule(childComplexity), true
+
+	case "Recurrence.template":
+		if e.complexity.Recurrence.Template == nil {
+			break
+		}
+
+		args, err := ec.field_Recurrence_template_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Recurrence.Template(childComplexity, args["filter"].(*model.TensionTemplateFilter)), true
+
+	case "Recurrence.tension":
+		if e.complexity.Recurrence.Tension == nil {
+			break
+		}
+
+		args, err := ec.field_Recurrence_tension_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Recurrence.Tension(childComplexity, args["filter"].(*model.TensionFilter)), true
+
+	case "Recurrence.title":
+		if e.complexity.Recurrence.Title == nil {
+			break
+		}
+
+		return e.complexity.Recurrence.Title(childComplexity), true
+
+	case "RecurrenceAggregateResult.count":
+		if e.complexity.RecurrenceAggregateResult.Count == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.Count(childComplexity), true
+
+	case "RecurrenceAggregateResult.countAvg":
+		if e.complexity.RecurrenceAggregateResult.CountAvg == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.CountAvg(childComplexity), true
+
+	case "RecurrenceAggregateResult.countMax":
+		if e.complexity.RecurrenceAggregateResult.CountMax == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.CountMax(childComplexity), true
+
+	case "RecurrenceAggregateResult.countMin":
+		if e.complexity.RecurrenceAggregateResult.CountMin == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.CountMin(childComplexity), true
+
+	case "RecurrenceAggregateResult.countSum":
+		if e.complexity.RecurrenceAggregateResult.CountSum == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.CountSum(childComplexity), true
+
+	case "RecurrenceAggregateResult.createdAtMax":
+		if e.complexity.RecurrenceAggregateResult.CreatedAtMax == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.CreatedAtMax(childComplexity), true
+
+	case "RecurrenceAggregateResult.createdAtMin":
+		if e.complexity.RecurrenceAggregateResult.CreatedAtMin == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.CreatedAtMin(childComplexity), true
+
+	case "RecurrenceAggregateResult.dtstartMax":
+		if e.complexity.RecurrenceAggregateResult.DtstartMax == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.DtstartMax(childComplexity), true
+
+	case "RecurrenceAggregateResult.dtstartMin":
+		if e.complexity.RecurrenceAggregateResult.DtstartMin == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.DtstartMin(childComplexity), true
+
+	case "RecurrenceAggregateResult.next_atMax":
+		if e.complexity.RecurrenceAggregateResult.NextAtMax == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.NextAtMax(childComplexity), true
+
+	case "RecurrenceAggregateResult.next_atMin":
+		if e.complexity.RecurrenceAggregateResult.NextAtMin == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.NextAtMin(childComplexity), true
+
+	case "RecurrenceAggregateResult.receiveridMax":
+		if e.complexity.RecurrenceAggregateResult.ReceiveridMax == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.ReceiveridMax(childComplexity), true
+
+	case "RecurrenceAggregateResult.receiveridMin":
+		if e.complexity.RecurrenceAggregateResult.ReceiveridMin == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.ReceiveridMin(childComplexity), true
+
+	case "RecurrenceAggregateResult.rootnameidMax":
+		if e.complexity.RecurrenceAggregateResult.RootnameidMax == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.RootnameidMax(childComplexity), true
+
+	case "RecurrenceAggregateResult.rootnameidMin":
+		if e.complexity.RecurrenceAggregateResult.RootnameidMin == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.RootnameidMin(childComplexity), true
+
+	case "RecurrenceAggregateResult.rruleMax":
+		if e.complexity.RecurrenceAggregateResult.RruleMax == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.RruleMax(childComplexity), true
+
+	case "RecurrenceAggregateResult.rruleMin":
+		if e.complexity.RecurrenceAggregateResult.RruleMin == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.RruleMin(childComplexity), true
+
+	case "RecurrenceAggregateResult.titleMax":
+		if e.complexity.RecurrenceAggregateResult.TitleMax == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.TitleMax(childComplexity), true
+
+	case "RecurrenceAggregateResult.titleMin":
+		if e.complexity.RecurrenceAggregateResult.TitleMin == nil {
+			break
+		}
+
+		return e.complexity.RecurrenceAggregateResult.TitleMin(childComplexity), true
+
 	case "RoleExt.about":
 		if e.complexity.RoleExt.About == nil {
 			break
@@ -7874,6 +9959,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Tension.AssigneesAggregate(childComplexity, args["filter"].(*model.UserFilter)), true
 
+	case "Tension.attachments":
+		if e.complexity.Tension.Attachments == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_attachments_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Attachments(childComplexity, args["filter"].(*model.AttachmentFilter), args["order"].(*model.AttachmentOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Tension.attachmentsAggregate":
+		if e.complexity.Tension.AttachmentsAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_attachmentsAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.AttachmentsAggregate(childComplexity, args["filter"].(*model.AttachmentFilter)), true
+
 	case "Tension.blobs":
 		if e.complexity.Tension.Blobs == nil {
 			break
@@ -7898,20 +10007,68 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Tension.BlobsAggregate(childComplexity, args["filter"].(*model.BlobFilter)), true
 
-	case "Tension.comments":
-		if e.complexity.Tension.Comments == nil {
+	case "Tension.blocked_by":
+		if e.complexity.Tension.BlockedBy == nil {
 			break
 		}
 
-		args, err := ec.field_Tension_comments_args(context.TODO(), rawArgs)
+		args, err := ec.field_Tension_blocked_by_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Tension.Comments(childComplexity, args["filter"].(*model.CommentFilter), args["order"].(*model.CommentOrder), args["first"].(*int), args["offset"].(*int)), true
+		return e.complexity.Tension.BlockedBy(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
 
-	case "Tension.commentsAggregate":
-		if e.complexity.Tension.CommentsAggregate == nil {
+	case "Tension.blocked_byAggregate":
+		if e.complexity.Tension.BlockedByAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_blocked_byAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.BlockedByAggregate(childComplexity, args["filter"].(*model.TensionFilter)), true
+
+	case "Tension.blocks":
+		if e.complexity.Tension.Blocks == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_blocks_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Blocks(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Tension.blocksAggregate":
+		if e.complexity.Tension.BlocksAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_blocksAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.BlocksAggregate(childComplexity, args["filter"].(*model.TensionFilter)), true
+
+	case "Tension.comments":
+		if e.complexity.Tension.Comments == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_comments_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Comments(childComplexity, args["filter"].(*model.CommentFilter), args["order"].(*model.CommentOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Tension.commentsAggregate":
+		if e.complexity.Tension.CommentsAggregate == nil {
 			break
 		}
 
@@ -7922,6 +10079,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Tension.CommentsAggregate(childComplexity, args["filter"].(*model.CommentFilter)), true
 
+	case "Tension.confidential":
+		if e.complexity.Tension.Confidential == nil {
+			break
+		}
+
+		return e.complexity.Tension.Confidential(childComplexity), true
+
 	case "Tension.contracts":
 		if e.complexity.Tension.Contracts == nil {
 			break
@@ -7965,6 +10129,49 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Tension.CreatedBy(childComplexity, args["filter"].(*model.UserFilter)), true
 
+	case "Tension.dueDate":
+		if e.complexity.Tension.DueDate == nil {
+			break
+		}
+
+		return e.complexity.Tension.DueDate(childComplexity), true
+
+	case "Tension.duplicate_of":
+		if e.complexity.Tension.DuplicateOf == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_duplicate_of_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.DuplicateOf(childComplexity, args["filter"].(*model.TensionFilter)), true
+
+	case "Tension.duplicates":
+		if e.complexity.Tension.Duplicates == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_duplicates_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Duplicates(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Tension.duplicatesAggregate":
+		if e.complexity.Tension.DuplicatesAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_duplicatesAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.DuplicatesAggregate(childComplexity, args["filter"].(*model.TensionFilter)), true
+
 	case "Tension.emitter":
 		if e.complexity.Tension.Emitter == nil {
 			break
@@ -8077,6 +10284,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Tension.NComments(childComplexity), true
 
+	case "Tension.parent":
+		if e.complexity.Tension.Parent == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_parent_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Parent(childComplexity, args["filter"].(*model.TensionFilter)), true
+
+	case "Tension.participants":
+		if e.complexity.Tension.Participants == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_participants_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Participants(childComplexity, args["filter"].(*model.UserFilter), args["order"].(*model.UserOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Tension.participantsAggregate":
+		if e.complexity.Tension.ParticipantsAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_participantsAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.ParticipantsAggregate(childComplexity, args["filter"].(*model.UserFilter)), true
+
 	case "Tension.project_statuses":
 		if e.complexity.Tension.ProjectStatuses == nil {
 			break
@@ -8111,185 +10354,673 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Tension.Receiver(childComplexity, args["filter"].(*model.NodeFilter)), true
+		return e.complexity.Tension.Receiver(childComplexity, args["filter"].(*model.NodeFilter)), true
+
+	case "Tension.receiverid":
+		if e.complexity.Tension.Receiverid == nil {
+			break
+		}
+
+		return e.complexity.Tension.Receiverid(childComplexity), true
+
+	case "Tension.related":
+		if e.complexity.Tension.Related == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_related_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Related(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Tension.relatedAggregate":
+		if e.complexity.Tension.RelatedAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_relatedAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.RelatedAggregate(childComplexity, args["filter"].(*model.TensionFilter)), true
+
+	case "Tension.status":
+		if e.complexity.Tension.Status == nil {
+			break
+		}
+
+		return e.complexity.Tension.Status(childComplexity), true
+
+	case "Tension.subscribers":
+		if e.complexity.Tension.Subscribers == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_subscribers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Subscribers(childComplexity, args["filter"].(*model.UserFilter), args["order"].(*model.UserOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Tension.subscribersAggregate":
+		if e.complexity.Tension.SubscribersAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_subscribersAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.SubscribersAggregate(childComplexity, args["filter"].(*model.UserFilter)), true
+
+	case "Tension.subtasks":
+		if e.complexity.Tension.Subtasks == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_subtasks_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Subtasks(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "Tension.subtasksAggregate":
+		if e.complexity.Tension.SubtasksAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_subtasksAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.SubtasksAggregate(childComplexity, args["filter"].(*model.TensionFilter)), true
+
+	case "Tension.template":
+		if e.complexity.Tension.Template == nil {
+			break
+		}
+
+		args, err := ec.field_Tension_template_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Tension.Template(childComplexity, args["filter"].(*model.TensionTemplateFilter)), true
+
+	case "Tension.title":
+		if e.complexity.Tension.Title == nil {
+			break
+		}
+
+		return e.complexity.Tension.Title(childComplexity), true
+
+	case "Tension.type_":
+		if e.complexity.Tension.Type == nil {
+			break
+		}
+
+		return e.complexity.Tension.Type(childComplexity), true
+
+	case "Tension.updatedAt":
+		if e.complexity.Tension.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Tension.UpdatedAt(childComplexity), true
+
+	case "TensionAggregateResult.count":
+		if e.complexity.TensionAggregateResult.Count == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.Count(childComplexity), true
+
+	case "TensionAggregateResult.createdAtMax":
+		if e.complexity.TensionAggregateResult.CreatedAtMax == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.CreatedAtMax(childComplexity), true
+
+	case "TensionAggregateResult.createdAtMin":
+		if e.complexity.TensionAggregateResult.CreatedAtMin == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.CreatedAtMin(childComplexity), true
+
+	case "TensionAggregateResult.dueDateMax":
+		if e.complexity.TensionAggregateResult.DueDateMax == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.DueDateMax(childComplexity), true
+
+	case "TensionAggregateResult.dueDateMin":
+		if e.complexity.TensionAggregateResult.DueDateMin == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.DueDateMin(childComplexity), true
+
+	case "TensionAggregateResult.emitteridMax":
+		if e.complexity.TensionAggregateResult.EmitteridMax == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.EmitteridMax(childComplexity), true
+
+	case "TensionAggregateResult.emitteridMin":
+		if e.complexity.TensionAggregateResult.EmitteridMin == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.EmitteridMin(childComplexity), true
+
+	case "TensionAggregateResult.messageMax":
+		if e.complexity.TensionAggregateResult.MessageMax == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.MessageMax(childComplexity), true
+
+	case "TensionAggregateResult.messageMin":
+		if e.complexity.TensionAggregateResult.MessageMin == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.MessageMin(childComplexity), true
+
+	case "TensionAggregateResult.n_commentsAvg":
+		if e.complexity.TensionAggregateResult.NCommentsAvg == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.NCommentsAvg(childComplexity), true
+
+	case "TensionAggregateResult.n_commentsMax":
+		if e.complexity.TensionAggregateResult.NCommentsMax == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.NCommentsMax(childComplexity), true
+
+	case "TensionAggregateResult.n_commentsMin":
+		if e.complexity.TensionAggregateResult.NCommentsMin == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.NCommentsMin(childComplexity), true
+
+	case "TensionAggregateResult.n_commentsSum":
+		if e.complexity.TensionAggregateResult.NCommentsSum == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.NCommentsSum(childComplexity), true
+
+	case "TensionAggregateResult.receiveridMax":
+		if e.complexity.TensionAggregateResult.ReceiveridMax == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.ReceiveridMax(childComplexity), true
+
+	case "TensionAggregateResult.receiveridMin":
+		if e.complexity.TensionAggregateResult.ReceiveridMin == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.ReceiveridMin(childComplexity), true
+
+	case "TensionAggregateResult.titleMax":
+		if e.complexity.TensionAggregateResult.TitleMax == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.TitleMax(childComplexity), true
+
+	case "TensionAggregateResult.titleMin":
+		if e.complexity.TensionAggregateResult.TitleMin == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.TitleMin(childComplexity), true
+
+	case "TensionAggregateResult.updatedAtMax":
+		if e.complexity.TensionAggregateResult.UpdatedAtMax == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.UpdatedAtMax(childComplexity), true
+
+	case "TensionAggregateResult.updatedAtMin":
+		if e.complexity.TensionAggregateResult.UpdatedAtMin == nil {
+			break
+		}
+
+		return e.complexity.TensionAggregateResult.UpdatedAtMin(childComplexity), true
+
+	case "TensionTemplate.about":
+		if e.complexity.TensionTemplate.About == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplate.About(childComplexity), true
+
+	case "TensionTemplate.assignees":
+		if e.complexity.TensionTemplate.Assignees == nil {
+			break
+		}
+
+		args, err := ec.field_TensionTemplate_assignees_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TensionTemplate.Assignees(childComplexity, args["filter"].(*model.UserFilter), args["order"].(*model.UserOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "TensionTemplate.assigneesAggregate":
+		if e.complexity.TensionTemplate.AssigneesAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_TensionTemplate_assigneesAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TensionTemplate.AssigneesAggregate(childComplexity, args["filter"].(*model.UserFilter)), true
+
+	case "TensionTemplate.id":
+		if e.complexity.TensionTemplate.ID == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplate.ID(childComplexity), true
+
+	case "TensionTemplate.labels":
+		if e.complexity.TensionTemplate.Labels == nil {
+			break
+		}
+
+		args, err := ec.field_TensionTemplate_labels_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TensionTemplate.Labels(childComplexity, args["filter"].(*model.LabelFilter), args["order"].(*model.LabelOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "TensionTemplate.labelsAggregate":
+		if e.complexity.TensionTemplate.LabelsAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_TensionTemplate_labelsAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TensionTemplate.LabelsAggregate(childComplexity, args["filter"].(*model.LabelFilter)), true
+
+	case "TensionTemplate.message":
+		if e.complexity.TensionTemplate.Message == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplate.Message(childComplexity), true
+
+	case "TensionTemplate.name":
+		if e.complexity.TensionTemplate.Name == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplate.Name(childComplexity), true
+
+	case "TensionTemplate.nodes":
+		if e.complexity.TensionTemplate.Nodes == nil {
+			break
+		}
+
+		args, err := ec.field_TensionTemplate_nodes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TensionTemplate.Nodes(childComplexity, args["filter"].(*model.NodeFilter), args["order"].(*model.NodeOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "TensionTemplate.nodesAggregate":
+		if e.complexity.TensionTemplate.NodesAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_TensionTemplate_nodesAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TensionTemplate.NodesAggregate(childComplexity, args["filter"].(*model.NodeFilter)), true
+
+	case "TensionTemplate.rootnameid":
+		if e.complexity.TensionTemplate.Rootnameid == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplate.Rootnameid(childComplexity), true
+
+	case "TensionTemplate.title":
+		if e.complexity.TensionTemplate.Title == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplate.Title(childComplexity), true
+
+	case "TensionTemplate.type_":
+		if e.complexity.TensionTemplate.Type == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplate.Type(childComplexity), true
+
+	case "TensionTemplateAggregateResult.aboutMax":
+		if e.complexity.TensionTemplateAggregateResult.AboutMax == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.AboutMax(childComplexity), true
+
+	case "TensionTemplateAggregateResult.aboutMin":
+		if e.complexity.TensionTemplateAggregateResult.AboutMin == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.AboutMin(childComplexity), true
+
+	case "TensionTemplateAggregateResult.count":
+		if e.complexity.TensionTemplateAggregateResult.Count == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.Count(childComplexity), true
+
+	case "TensionTemplateAggregateResult.messageMax":
+		if e.complexity.TensionTemplateAggregateResult.MessageMax == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.MessageMax(childComplexity), true
+
+	case "TensionTemplateAggregateResult.messageMin":
+		if e.complexity.TensionTemplateAggregateResult.MessageMin == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.MessageMin(childComplexity), true
+
+	case "TensionTemplateAggregateResult.nameMax":
+		if e.complexity.TensionTemplateAggregateResult.NameMax == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.NameMax(childComplexity), true
+
+	case "TensionTemplateAggregateResult.nameMin":
+		if e.complexity.TensionTemplateAggregateResult.NameMin == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.NameMin(childComplexity), true
+
+	case "TensionTemplateAggregateResult.rootnameidMax":
+		if e.complexity.TensionTemplateAggregateResult.RootnameidMax == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.RootnameidMax(childComplexity), true
+
+	case "TensionTemplateAggregateResult.rootnameidMin":
+		if e.complexity.TensionTemplateAggregateResult.RootnameidMin == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.RootnameidMin(childComplexity), true
+
+	case "TensionTemplateAggregateResult.titleMax":
+		if e.complexity.TensionTemplateAggregateResult.TitleMax == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.TitleMax(childComplexity), true
+
+	case "TensionTemplateAggregateResult.titleMin":
+		if e.complexity.TensionTemplateAggregateResult.TitleMin == nil {
+			break
+		}
+
+		return e.complexity.TensionTemplateAggregateResult.TitleMin(childComplexity), true
+
+	case "TensionView.checked_at":
+		if e.complexity.TensionView.CheckedAt == nil {
+			break
+		}
+
+		return e.complexity.TensionView.CheckedAt(childComplexity), true
+
+	case "TensionView.createdAt":
+		if e.complexity.TensionView.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.TensionView.CreatedAt(childComplexity), true
+
+	case "TensionView.createdBy":
+		if e.complexity.TensionView.CreatedBy == nil {
+			break
+		}
+
+		args, err := ec.field_TensionView_createdBy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TensionView.CreatedBy(childComplexity, args["filter"].(*model.UserFilter)), true
+
+	case "TensionView.display":
+		if e.complexity.TensionView.Display == nil {
+			break
+		}
+
+		return e.complexity.TensionView.Display(childComplexity), true
+
+	case "TensionView.id":
+		if e.complexity.TensionView.ID == nil {
+			break
+		}
+
+		return e.complexity.TensionView.ID(childComplexity), true
 
-	case "Tension.receiverid":
-		if e.complexity.Tension.Receiverid == nil {
+	case "TensionView.name":
+		if e.complexity.TensionView.Name == nil {
 			break
 		}
 
-		return e.complexity.Tension.Receiverid(childComplexity), true
+		return e.complexity.TensionView.Name(childComplexity), true
 
-	case "Tension.status":
-		if e.complexity.Tension.Status == nil {
+	case "TensionView.nameid":
+		if e.complexity.TensionView.Nameid == nil {
 			break
 		}
 
-		return e.complexity.Tension.Status(childComplexity), true
+		return e.complexity.TensionView.Nameid(childComplexity), true
 
-	case "Tension.subscribers":
-		if e.complexity.Tension.Subscribers == nil {
+	case "TensionView.query":
+		if e.complexity.TensionView.Query == nil {
 			break
 		}
 
-		args, err := ec.field_Tension_subscribers_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.TensionView.Query(childComplexity), true
+
+	case "TensionView.rootnameid":
+		if e.complexity.TensionView.Rootnameid == nil {
+			break
 		}
 
-		return e.complexity.Tension.Subscribers(childComplexity, args["filter"].(*model.UserFilter), args["order"].(*model.UserOrder), args["first"].(*int), args["offset"].(*int)), true
+		return e.complexity.TensionView.Rootnameid(childComplexity), true
 
-	case "Tension.subscribersAggregate":
-		if e.complexity.Tension.SubscribersAggregate == nil {
+	case "TensionView.watchers":
+		if e.complexity.TensionView.Watchers == nil {
 			break
 		}
 
-		args, err := ec.field_Tension_subscribersAggregate_args(context.TODO(), rawArgs)
+		args, err := ec.field_TensionView_watchers_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Tension.SubscribersAggregate(childComplexity, args["filter"].(*model.UserFilter)), true
+		return e.complexity.TensionView.Watchers(childComplexity, args["filter"].(*model.UserFilter), args["order"].(*model.UserOrder), args["first"].(*int), args["offset"].(*int)), true
 
-	case "Tension.title":
-		if e.complexity.Tension.Title == nil {
+	case "TensionView.watchersAggregate":
+		if e.complexity.TensionView.WatchersAggregate == nil {
 			break
 		}
 
-		return e.complexity.Tension.Title(childComplexity), true
-
-	case "Tension.type_":
-		if e.complexity.Tension.Type == nil {
-			break
+		args, err := ec.field_TensionView_watchersAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Tension.Type(childComplexity), true
+		return e.complexity.TensionView.WatchersAggregate(childComplexity, args["filter"].(*model.UserFilter)), true
 
-	case "Tension.updatedAt":
-		if e.complexity.Tension.UpdatedAt == nil {
+	case "TensionViewAggregateResult.checked_atMax":
+		if e.complexity.TensionViewAggregateResult.CheckedAtMax == nil {
 			break
 		}
 
-		return e.complexity.Tension.UpdatedAt(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.CheckedAtMax(childComplexity), true
 
-	case "TensionAggregateResult.count":
-		if e.complexity.TensionAggregateResult.Count == nil {
+	case "TensionViewAggregateResult.checked_atMin":
+		if e.complexity.TensionViewAggregateResult.CheckedAtMin == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.Count(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.CheckedAtMin(childComplexity), true
 
-	case "TensionAggregateResult.createdAtMax":
-		if e.complexity.TensionAggregateResult.CreatedAtMax == nil {
+	case "TensionViewAggregateResult.count":
+		if e.complexity.TensionViewAggregateResult.Count == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.CreatedAtMax(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.Count(childComplexity), true
 
-	case "TensionAggregateResult.createdAtMin":
-		if e.complexity.TensionAggregateResult.CreatedAtMin == nil {
+	case "TensionViewAggregateResult.createdAtMax":
+		if e.complexity.TensionViewAggregateResult.CreatedAtMax == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.CreatedAtMin(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.CreatedAtMax(childComplexity), true
 
-	case "TensionAggregateResult.emitteridMax":
-		if e.complexity.TensionAggregateResult.EmitteridMax == nil {
+	case "TensionViewAggregateResult.createdAtMin":
+		if e.complexity.TensionViewAggregateResult.CreatedAtMin == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.EmitteridMax(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.CreatedAtMin(childComplexity), true
 
-	case "TensionAggregateResult.emitteridMin":
-		if e.complexity.TensionAggregateResult.EmitteridMin == nil {
+	case "TensionViewAggregateResult.displayMax":
+		if e.complexity.TensionViewAggregateResult.DisplayMax == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.EmitteridMin(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.DisplayMax(childComplexity), true
 
-	case "TensionAggregateResult.messageMax":
-		if e.complexity.TensionAggregateResult.MessageMax == nil {
+	case "TensionViewAggregateResult.displayMin":
+		if e.complexity.TensionViewAggregateResult.DisplayMin == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.MessageMax(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.DisplayMin(childComplexity), true
 
-	case "TensionAggregateResult.messageMin":
-		if e.complexity.TensionAggregateResult.MessageMin == nil {
+	case "TensionViewAggregateResult.nameMax":
+		if e.complexity.TensionViewAggregateResult.NameMax == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.MessageMin(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.NameMax(childComplexity), true
 
-	case "TensionAggregateResult.n_commentsAvg":
-		if e.complexity.TensionAggregateResult.NCommentsAvg == nil {
+	case "TensionViewAggregateResult.nameMin":
+		if e.complexity.TensionViewAggregateResult.NameMin == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.NCommentsAvg(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.NameMin(childComplexity), true
 
-	case "TensionAggregateResult.n_commentsMax":
-		if e.complexity.TensionAggregateResult.NCommentsMax == nil {
+	case "TensionViewAggregateResult.nameidMax":
+		if e.complexity.TensionViewAggregateResult.NameidMax == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.NCommentsMax(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.NameidMax(childComplexity), true
 
-	case "TensionAggregateResult.n_commentsMin":
-		if e.complexity.TensionAggregateResult.NCommentsMin == nil {
+	case "TensionViewAggregateResult.nameidMin":
+		if e.complexity.TensionViewAggregateResult.NameidMin == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.NCommentsMin(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.NameidMin(childComplexity), true
 
-	case "TensionAggregateResult.n_commentsSum":
-		if e.complexity.TensionAggregateResult.NCommentsSum == nil {
+	case "TensionViewAggregateResult.queryMax":
+		if e.complexity.TensionViewAggregateResult.QueryMax == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.NCommentsSum(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.QueryMax(childComplexity), true
 
-	case "TensionAggregateResult.receiveridMax":
-		if e.complexity.TensionAggregateResult.ReceiveridMax == nil {
+	case "TensionViewAggregateResult.queryMin":
+		if e.complexity.TensionViewAggregateResult.QueryMin == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.ReceiveridMax(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.QueryMin(childComplexity), true
 
-	case "TensionAggregateResult.receiveridMin":
-		if e.complexity.TensionAggregateResult.ReceiveridMin == nil {
+	case "TensionViewAggregateResult.rootnameidMax":
+		if e.complexity.TensionViewAggregateResult.RootnameidMax == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.ReceiveridMin(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.RootnameidMax(childComplexity), true
 
-	case "TensionAggregateResult.titleMax":
-		if e.complexity.TensionAggregateResult.TitleMax == nil {
+	case "TensionViewAggregateResult.rootnameidMin":
+		if e.complexity.TensionViewAggregateResult.RootnameidMin == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.TitleMax(childComplexity), true
+		return e.complexity.TensionViewAggregateResult.RootnameidMin(childComplexity), true
 
-	case "TensionAggregateResult.titleMin":
-		if e.complexity.TensionAggregateResult.TitleMin == nil {
+	case "UpdateAttachmentPayload.attachment":
+		if e.complexity.UpdateAttachmentPayload.Attachment == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.TitleMin(childComplexity), true
-
-	case "TensionAggregateResult.updatedAtMax":
-		if e.complexity.TensionAggregateResult.UpdatedAtMax == nil {
-			break
+		args, err := ec.field_UpdateAttachmentPayload_attachment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.TensionAggregateResult.UpdatedAtMax(childComplexity), true
+		return e.complexity.UpdateAttachmentPayload.Attachment(childComplexity, args["filter"].(*model.AttachmentFilter), args["order"].(*model.AttachmentOrder), args["first"].(*int), args["offset"].(*int)), true
 
-	case "TensionAggregateResult.updatedAtMin":
-		if e.complexity.TensionAggregateResult.UpdatedAtMin == nil {
+	case "UpdateAttachmentPayload.numUids":
+		if e.complexity.UpdateAttachmentPayload.NumUids == nil {
 			break
 		}
 
-		return e.complexity.TensionAggregateResult.UpdatedAtMin(childComplexity), true
+		return e.complexity.UpdateAttachmentPayload.NumUids(childComplexity), true
 
 	case "UpdateBlobPayload.blob":
 		if e.complexity.UpdateBlobPayload.Blob == nil {
@@ -8424,6 +11155,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UpdateLabelPayload.NumUids(childComplexity), true
 
+	case "UpdateMailingSettingPayload.mailingSetting":
+		if e.complexity.UpdateMailingSettingPayload.MailingSetting == nil {
+			break
+		}
+
+		args, err := ec.field_UpdateMailingSettingPayload_mailingSetting_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.UpdateMailingSettingPayload.MailingSetting(childComplexity, args["filter"].(*model.MailingSettingFilter), args["order"].(*model.MailingSettingOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "UpdateMailingSettingPayload.numUids":
+		if e.complexity.UpdateMailingSettingPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.UpdateMailingSettingPayload.NumUids(childComplexity), true
+
 	case "UpdateMandatePayload.mandate":
 		if e.complexity.UpdateMandatePayload.Mandate == nil {
 			break
@@ -8443,6 +11193,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UpdateMandatePayload.NumUids(childComplexity), true
 
+	case "UpdateMatrixRoomPayload.matrixRoom":
+		if e.complexity.UpdateMatrixRoomPayload.MatrixRoom == nil {
+			break
+		}
+
+		args, err := ec.field_UpdateMatrixRoomPayload_matrixRoom_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.UpdateMatrixRoomPayload.MatrixRoom(childComplexity, args["filter"].(*model.MatrixRoomFilter), args["order"].(*model.MatrixRoomOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "UpdateMatrixRoomPayload.numUids":
+		if e.complexity.UpdateMatrixRoomPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.UpdateMatrixRoomPayload.NumUids(childComplexity), true
+
 	case "UpdateNodeFragmentPayload.nodeFragment":
 		if e.complexity.UpdateNodeFragmentPayload.NodeFragment == nil {
 			break
@@ -8500,6 +11269,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UpdateNotifPayload.NumUids(childComplexity), true
 
+	case "UpdateNotifPreferencePayload.notifPreference":
+		if e.complexity.UpdateNotifPreferencePayload.NotifPreference == nil {
+			break
+		}
+
+		args, err := ec.field_UpdateNotifPreferencePayload_notifPreference_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.UpdateNotifPreferencePayload.NotifPreference(childComplexity, args["filter"].(*model.NotifPreferenceFilter), args["order"].(*model.NotifPreferenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "UpdateNotifPreferencePayload.numUids":
+		if e.complexity.UpdateNotifPreferencePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.UpdateNotifPreferencePayload.NumUids(childComplexity), true
+
 	case "UpdatePendingUserPayload.numUids":
 		if e.complexity.UpdatePendingUserPayload.NumUids == nil {
 			break
@@ -8671,6 +11459,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UpdateReactionPayload.Reaction(childComplexity, args["filter"].(*model.ReactionFilter), args["order"].(*model.ReactionOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "UpdateRecurrencePayload.numUids":
+		if e.complexity.UpdateRecurrencePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.UpdateRecurrencePayload.NumUids(childComplexity), true
+
+	case "UpdateRecurrencePayload.recurrence":
+		if e.complexity.UpdateRecurrencePayload.Recurrence == nil {
+			break
+		}
+
+		args, err := ec.field_UpdateRecurrencePayload_recurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.UpdateRecurrencePayload.Recurrence(childComplexity, args["filter"].(*model.RecurrenceFilter), args["order"].(*model.RecurrenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "UpdateRoleExtPayload.numUids":
 		if e.complexity.UpdateRoleExtPayload.NumUids == nil {
 			break
@@ -8709,6 +11516,44 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UpdateTensionPayload.Tension(childComplexity, args["filter"].(*model.TensionFilter), args["order"].(*model.TensionOrder), args["first"].(*int), args["offset"].(*int)), true
 
+	case "UpdateTensionTemplatePayload.numUids":
+		if e.complexity.UpdateTensionTemplatePayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.UpdateTensionTemplatePayload.NumUids(childComplexity), true
+
+	case "UpdateTensionTemplatePayload.tensionTemplate":
+		if e.complexity.UpdateTensionTemplatePayload.TensionTemplate == nil {
+			break
+		}
+
+		args, err := ec.field_UpdateTensionTemplatePayload_tensionTemplate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.UpdateTensionTemplatePayload.TensionTemplate(childComplexity, args["filter"].(*model.TensionTemplateFilter), args["order"].(*model.TensionTemplateOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "UpdateTensionViewPayload.numUids":
+		if e.complexity.UpdateTensionViewPayload.NumUids == nil {
+			break
+		}
+
+		return e.complexity.UpdateTensionViewPayload.NumUids(childComplexity), true
+
+	case "UpdateTensionViewPayload.tensionView":
+		if e.complexity.UpdateTensionViewPayload.TensionView == nil {
+			break
+		}
+
+		args, err := ec.field_UpdateTensionViewPayload_tensionView_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.UpdateTensionViewPayload.TensionView(childComplexity, args["filter"].(*model.TensionViewFilter), args["order"].(*model.TensionViewOrder), args["first"].(*int), args["offset"].(*int)), true
+
 	case "UpdateUserEventPayload.numUids":
 		if e.complexity.UpdateUserEventPayload.NumUids == nil {
 			break
@@ -8830,6 +11675,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.User.Email(childComplexity), true
 
+	case "User.emailDelivery":
+		if e.complexity.User.EmailDelivery == nil {
+			break
+		}
+
+		return e.complexity.User.EmailDelivery(childComplexity), true
+
+	case "User.emailStatus":
+		if e.complexity.User.EmailStatus == nil {
+			break
+		}
+
+		return e.complexity.User.EmailStatus(childComplexity), true
+
 	case "User.event_count":
 		if e.complexity.User.EventCount == nil {
 			break
@@ -8908,6 +11767,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.User.MarkAllAsRead(childComplexity), true
 
+	case "User.matrixid":
+		if e.complexity.User.Matrixid == nil {
+			break
+		}
+
+		return e.complexity.User.Matrixid(childComplexity), true
+
 	case "User.name":
 		if e.complexity.User.Name == nil {
 			break
@@ -8915,6 +11781,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.User.Name(childComplexity), true
 
+	case "User.notif_preferences":
+		if e.complexity.User.NotifPreferences == nil {
+			break
+		}
+
+		args, err := ec.field_User_notif_preferences_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.NotifPreferences(childComplexity, args["filter"].(*model.NotifPreferenceFilter), args["order"].(*model.NotifPreferenceOrder), args["first"].(*int), args["offset"].(*int)), true
+
+	case "User.notif_preferencesAggregate":
+		if e.complexity.User.NotifPreferencesAggregate == nil {
+			break
+		}
+
+		args, err := ec.field_User_notif_preferencesAggregate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.NotifPreferencesAggregate(childComplexity, args["filter"].(*model.NotifPreferenceFilter)), true
+
 	case "User.notifyByEmail":
 		if e.complexity.User.NotifyByEmail == nil {
 			break
@@ -9197,6 +12087,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UserAggregateResult.MarkAllAsReadMin(childComplexity), true
 
+	case "UserAggregateResult.matrixidMax":
+		if e.complexity.UserAggregateResult.MatrixidMax == nil {
+			break
+		}
+
+		return e.complexity.UserAggregateResult.MatrixidMax(childComplexity), true
+
+	case "UserAggregateResult.matrixidMin":
+		if e.complexity.UserAggregateResult.MatrixidMin == nil {
+			break
+		}
+
+		return e.complexity.UserAggregateResult.MatrixidMin(childComplexity), true
+
 	case "UserAggregateResult.nameMax":
 		if e.complexity.UserAggregateResult.NameMax == nil {
 			break
@@ -9573,6 +12477,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAddAttachmentInput,
 		ec.unmarshalInputAddBlobInput,
 		ec.unmarshalInputAddCommentInput,
 		ec.unmarshalInputAddContractInput,
@@ -9580,10 +12485,13 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputAddEventFragmentInput,
 		ec.unmarshalInputAddEventInput,
 		ec.unmarshalInputAddLabelInput,
+		ec.unmarshalInputAddMailingSettingInput,
 		ec.unmarshalInputAddMandateInput,
+		ec.unmarshalInputAddMatrixRoomInput,
 		ec.unmarshalInputAddNodeFragmentInput,
 		ec.unmarshalInputAddNodeInput,
 		ec.unmarshalInputAddNotifInput,
+		ec.unmarshalInputAddNotifPreferenceInput,
 		ec.unmarshalInputAddPendingUserInput,
 		ec.unmarshalInputAddProjectCardInput,
 		ec.unmarshalInputAddProjectColumnInput,
@@ -9592,12 +12500,19 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputAddProjectFieldValueInput,
 		ec.unmarshalInputAddProjectInput,
 		ec.unmarshalInputAddReactionInput,
+		ec.unmarshalInputAddRecurrenceInput,
 		ec.unmarshalInputAddRoleExtInput,
 		ec.unmarshalInputAddTensionInput,
+		ec.unmarshalInputAddTensionTemplateInput,
+		ec.unmarshalInputAddTensionViewInput,
 		ec.unmarshalInputAddUserEventInput,
 		ec.unmarshalInputAddUserInput,
 		ec.unmarshalInputAddUserRightsInput,
 		ec.unmarshalInputAddVoteInput,
+		ec.unmarshalInputAttachmentFilter,
+		ec.unmarshalInputAttachmentOrder,
+		ec.unmarshalInputAttachmentPatch,
+		ec.unmarshalInputAttachmentRef,
 		ec.unmarshalInputAuthRule,
 		ec.unmarshalInputBlobFilter,
 		ec.unmarshalInputBlobOrder,
@@ -9647,10 +12562,18 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputLabelOrder,
 		ec.unmarshalInputLabelPatch,
 		ec.unmarshalInputLabelRef,
+		ec.unmarshalInputMailingSettingFilter,
+		ec.unmarshalInputMailingSettingOrder,
+		ec.unmarshalInputMailingSettingPatch,
+		ec.unmarshalInputMailingSettingRef,
 		ec.unmarshalInputMandateFilter,
 		ec.unmarshalInputMandateOrder,
 		ec.unmarshalInputMandatePatch,
 		ec.unmarshalInputMandateRef,
+		ec.unmarshalInputMatrixRoomFilter,
+		ec.unmarshalInputMatrixRoomOrder,
+		ec.unmarshalInputMatrixRoomPatch,
+		ec.unmarshalInputMatrixRoomRef,
 		ec.unmarshalInputMultiPolygonRef,
 		ec.unmarshalInputNearFilter,
 		ec.unmarshalInputNodeFilter,
@@ -9664,9 +12587,15 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputNodeRef,
 		ec.unmarshalInputNodeType_hash,
 		ec.unmarshalInputNodeVisibility_hash,
+		ec.unmarshalInputNotifEventGroup_hash,
 		ec.unmarshalInputNotifFilter,
 		ec.unmarshalInputNotifOrder,
 		ec.unmarshalInputNotifPatch,
+		ec.unmarshalInputNotifPreferenceFilter,
+		ec.unmarshalInputNotifPreferenceOrder,
+		ec.unmarshalInputNotifPreferencePatch,
+		ec.unmarshalInputNotifPreferenceReason_hash,
+		ec.unmarshalInputNotifPreferenceRef,
 		ec.unmarshalInputNotifRef,
 		ec.unmarshalInputPendingUserFilter,
 		ec.unmarshalInputPendingUserOrder,
@@ -9710,6 +12639,10 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputReactionOrder,
 		ec.unmarshalInputReactionPatch,
 		ec.unmarshalInputReactionRef,
+		ec.unmarshalInputRecurrenceFilter,
+		ec.unmarshalInputRecurrenceOrder,
+		ec.unmarshalInputRecurrencePatch,
+		ec.unmarshalInputRecurrenceRef,
 		ec.unmarshalInputRoleExtFilter,
 		ec.unmarshalInputRoleExtOrder,
 		ec.unmarshalInputRoleExtPatch,
@@ -9729,7 +12662,16 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputTensionPatch,
 		ec.unmarshalInputTensionRef,
 		ec.unmarshalInputTensionStatus_hash,
+		ec.unmarshalInputTensionTemplateFilter,
+		ec.unmarshalInputTensionTemplateOrder,
+		ec.unmarshalInputTensionTemplatePatch,
+		ec.unmarshalInputTensionTemplateRef,
 		ec.unmarshalInputTensionType_hash,
+		ec.unmarshalInputTensionViewFilter,
+		ec.unmarshalInputTensionViewOrder,
+		ec.unmarshalInputTensionViewPatch,
+		ec.unmarshalInputTensionViewRef,
+		ec.unmarshalInputUpdateAttachmentInput,
 		ec.unmarshalInputUpdateBlobInput,
 		ec.unmarshalInputUpdateCommentInput,
 		ec.unmarshalInputUpdateContractInput,
@@ -9737,10 +12679,13 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputUpdateEventFragmentInput,
 		ec.unmarshalInputUpdateEventInput,
 		ec.unmarshalInputUpdateLabelInput,
+		ec.unmarshalInputUpdateMailingSettingInput,
 		ec.unmarshalInputUpdateMandateInput,
+		ec.unmarshalInputUpdateMatrixRoomInput,
 		ec.unmarshalInputUpdateNodeFragmentInput,
 		ec.unmarshalInputUpdateNodeInput,
 		ec.unmarshalInputUpdateNotifInput,
+		ec.unmarshalInputUpdateNotifPreferenceInput,
 		ec.unmarshalInputUpdatePendingUserInput,
 		ec.unmarshalInputUpdatePostInput,
 		ec.unmarshalInputUpdateProjectCardInput,
@@ -9750,8 +12695,11 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputUpdateProjectFieldValueInput,
 		ec.unmarshalInputUpdateProjectInput,
 		ec.unmarshalInputUpdateReactionInput,
+		ec.unmarshalInputUpdateRecurrenceInput,
 		ec.unmarshalInputUpdateRoleExtInput,
 		ec.unmarshalInputUpdateTensionInput,
+		ec.unmarshalInputUpdateTensionTemplateInput,
+		ec.unmarshalInputUpdateTensionViewInput,
 		ec.unmarshalInputUpdateUserEventInput,
 		ec.unmarshalInputUpdateUserInput,
 		ec.unmarshalInputUpdateUserRightsInput,
@@ -9885,6 +12833,24 @@ directive @hook_updateRoleExtInput on ARGUMENT_DEFINITION
 directive @hook_updateRoleExt on FIELD_DEFINITION
 directive @hook_deleteRoleExtInput on ARGUMENT_DEFINITION
 directive @hook_deleteRoleExt on FIELD_DEFINITION
+directive @hook_addTensionTemplateInput on ARGUMENT_DEFINITION
+directive @hook_addTensionTemplate on FIELD_DEFINITION
+directive @hook_updateTensionTemplateInput on ARGUMENT_DEFINITION
+directive @hook_updateTensionTemplate on FIELD_DEFINITION
+directive @hook_deleteTensionTemplateInput on ARGUMENT_DEFINITION
+directive @hook_deleteTensionTemplate on FIELD_DEFINITION
+directive @hook_addRecurrenceInput on ARGUMENT_DEFINITION
+directive @hook_addRecurrence on FIELD_DEFINITION
+directive @hook_updateRecurrenceInput on ARGUMENT_DEFINITION
+directive @hook_updateRecurrence on FIELD_DEFINITION
+directive @hook_deleteRecurrenceInput on ARGUMENT_DEFINITION
+directive @hook_deleteRecurrence on FIELD_DEFINITION
+directive @hook_addTensionViewInput on ARGUMENT_DEFINITION
+directive @hook_addTensionView on FIELD_DEFINITION
+directive @hook_updateTensionViewInput on ARGUMENT_DEFINITION
+directive @hook_updateTensionView on FIELD_DEFINITION
+directive @hook_deleteTensionViewInput on ARGUMENT_DEFINITION
+directive @hook_deleteTensionView on FIELD_DEFINITION
 directive @hook_addProjectInput on ARGUMENT_DEFINITION
 directive @hook_addProject on FIELD_DEFINITION
 directive @hook_updateProjectInput on ARGUMENT_DEFINITION
@@ -9927,6 +12893,30 @@ directive @hook_updateReactionInput on ARGUMENT_DEFINITION
 directive @hook_updateReaction on FIELD_DEFINITION
 directive @hook_deleteReactionInput on ARGUMENT_DEFINITION
 directive @hook_deleteReaction on FIELD_DEFINITION
+directive @hook_addNotifPreferenceInput on ARGUMENT_DEFINITION
+directive @hook_addNotifPreference on FIELD_DEFINITION
+directive @hook_updateNotifPreferenceInput on ARGUMENT_DEFINITION
+directive @hook_updateNotifPreference on FIELD_DEFINITION
+directive @hook_deleteNotifPreferenceInput on ARGUMENT_DEFINITION
+directive @hook_deleteNotifPreference on FIELD_DEFINITION
+directive @hook_addMailingSettingInput on ARGUMENT_DEFINITION
+directive @hook_addMailingSetting on FIELD_DEFINITION
+directive @hook_updateMailingSettingInput on ARGUMENT_DEFINITION
+directive @hook_updateMailingSetting on FIELD_DEFINITION
+directive @hook_deleteMailingSettingInput on ARGUMENT_DEFINITION
+directive @hook_deleteMailingSetting on FIELD_DEFINITION
+directive @hook_addMatrixRoomInput on ARGUMENT_DEFINITION
+directive @hook_addMatrixRoom on FIELD_DEFINITION
+directive @hook_updateMatrixRoomInput on ARGUMENT_DEFINITION
+directive @hook_updateMatrixRoom on FIELD_DEFINITION
+directive @hook_deleteMatrixRoomInput on ARGUMENT_DEFINITION
+directive @hook_deleteMatrixRoom on FIELD_DEFINITION
+directive @hook_addAttachmentInput on ARGUMENT_DEFINITION
+directive @hook_addAttachment on FIELD_DEFINITION
+directive @hook_updateAttachmentInput on ARGUMENT_DEFINITION
+directive @hook_updateAttachment on FIELD_DEFINITION
+directive @hook_deleteAttachmentInput on ARGUMENT_DEFINITION
+directive @hook_deleteAttachment on FIELD_DEFINITION
 directive @hook_addContractInput on ARGUMENT_DEFINITION
 directive @hook_addContract on FIELD_DEFINITION
 directive @hook_updateContractInput on ARGUMENT_DEFINITION
@@ -9949,6 +12939,12 @@ directive @hook_getLabelInput on ARGUMENT_DEFINITION
 directive @hook_queryLabelInput on ARGUMENT_DEFINITION
 directive @hook_getRoleExtInput on ARGUMENT_DEFINITION
 directive @hook_queryRoleExtInput on ARGUMENT_DEFINITION
+directive @hook_getTensionTemplateInput on ARGUMENT_DEFINITION
+directive @hook_queryTensionTemplateInput on ARGUMENT_DEFINITION
+directive @hook_getRecurrenceInput on ARGUMENT_DEFINITION
+directive @hook_queryRecurrenceInput on ARGUMENT_DEFINITION
+directive @hook_getTensionViewInput on ARGUMENT_DEFINITION
+directive @hook_queryTensionViewInput on ARGUMENT_DEFINITION
 directive @hook_getProjectInput on ARGUMENT_DEFINITION
 directive @hook_queryProjectInput on ARGUMENT_DEFINITION
 directive @hook_getProjectColumnInput on ARGUMENT_DEFINITION
@@ -9963,6 +12959,14 @@ directive @hook_getCommentInput on ARGUMENT_DEFINITION
 directive @hook_queryCommentInput on ARGUMENT_DEFINITION
 directive @hook_getReactionInput on ARGUMENT_DEFINITION
 directive @hook_queryReactionInput on ARGUMENT_DEFINITION
+directive @hook_getNotifPreferenceInput on ARGUMENT_DEFINITION
+directive @hook_queryNotifPreferenceInput on ARGUMENT_DEFINITION
+directive @hook_getMailingSettingInput on ARGUMENT_DEFINITION
+directive @hook_queryMailingSettingInput on ARGUMENT_DEFINITION
+directive @hook_getMatrixRoomInput on ARGUMENT_DEFINITION
+directive @hook_queryMatrixRoomInput on ARGUMENT_DEFINITION
+directive @hook_getAttachmentInput on ARGUMENT_DEFINITION
+directive @hook_queryAttachmentInput on ARGUMENT_DEFINITION
 directive @hook_getContractInput on ARGUMENT_DEFINITION
 directive @hook_queryContractInput on ARGUMENT_DEFINITION
 directive @hook_getVoteInput on ARGUMENT_DEFINITION
@@ -10032,6 +13036,7 @@ type Node {
   children(filter: NodeFilter, order: NodeOrder, first: Int, offset: Int): [Node!]
   labels(filter: LabelFilter, order: LabelOrder, first: Int, offset: Int): [Label!]
   roles(filter: RoleExtFilter, order: RoleExtOrder, first: Int, offset: Int): [RoleExt!]
+  templates(filter: TensionTemplateFilter, order: TensionTemplateOrder, first: Int, offset: Int): [TensionTemplate!]
   projects(filter: ProjectFilter, order: ProjectOrder, first: Int, offset: Int): [Project!]
   pinned(filter: TensionFilter, order: TensionOrder, first: Int, offset: Int): [Tension!]
   role_ext(filter: RoleExtFilter): RoleExt
@@ -10047,6 +13052,7 @@ type Node {
   childrenAggregate(filter: NodeFilter): NodeAggregateResult
   labelsAggregate(filter: LabelFilter): LabelAggregateResult
   rolesAggregate(filter: RoleExtFilter): RoleExtAggregateResult
+  templatesAggregate(filter: TensionTemplateFilter): TensionTemplateAggregateResult
   projectsAggregate(filter: ProjectFilter): ProjectAggregateResult
   pinnedAggregate(filter: TensionFilter): TensionAggregateResult
   contractsAggregate(filter: VoteFilter): VoteAggregateResult
@@ -10105,6 +13111,53 @@ type RoleExt {
   nodesAggregate(filter: NodeFilter): NodeAggregateResult
 }
 
+type TensionTemplate {
+  id: ID!
+  rootnameid: String!
+  name: String!
+  about: String
+  title: String
+  message: String
+  type_: TensionType
+  labels(filter: LabelFilter, order: LabelOrder, first: Int, offset: Int): [Label!]
+  assignees(filter: UserFilter, order: UserOrder, first: Int, offset: Int): [User!]
+  nodes(filter: NodeFilter, order: NodeOrder, first: Int, offset: Int): [Node!]
+
+  labelsAggregate(filter: LabelFilter): LabelAggregateResult
+  assigneesAggregate(filter: UserFilter): UserAggregateResult
+  nodesAggregate(filter: NodeFilter): NodeAggregateResult
+}
+
+
+type Recurrence {
+  id: ID!
+  createdBy(filter: UserFilter): User!
+  createdAt: DateTime!
+  rootnameid: String!
+  receiverid: String!
+  rrule: String!
+  dtstart: DateTime!
+  paused: Boolean
+  title: String
+  template(filter: TensionTemplateFilter): TensionTemplate
+  tension(filter: TensionFilter): Tension
+  next_at: DateTime
+  count: Int
+}
+type TensionView {
+  id: ID!
+  createdBy(filter: UserFilter): User!
+  createdAt: DateTime!
+  rootnameid: String!
+  nameid: String
+  name: String!
+  query: String!
+  display: String
+  watchers(filter: UserFilter, order: UserOrder, first: Int, offset: Int): [User!]
+  checked_at: DateTime
+
+  watchersAggregate(filter: UserFilter): UserAggregateResult
+}
 type Project {
   id: ID!
   createdBy(filter: UserFilter): User!
@@ -10209,7 +13262,10 @@ type Tension {
   type_: TensionType!
   status: TensionStatus!
   action: TensionAction
+  dueDate: DateTime
+  confidential: Boolean
   assignees(filter: UserFilter, order: UserOrder, first: Int, offset: Int): [User!]
+  participants(filter: UserFilter, order: UserOrder, first: Int, offset: Int): [User!]
   labels(filter: LabelFilter, order: LabelOrder, first: Int, offset: Int): [Label!]
   comments(filter: CommentFilter, order: CommentOrder, first: Int, offset: Int): [Comment!]
   blobs(filter: BlobFilter, order: BlobOrder, first: Int, offset: Int): [Blob!]
@@ -10218,6 +13274,15 @@ type Tension {
   contracts(filter: ContractFilter, order: ContractOrder, first: Int, offset: Int): [Contract!]
   subscribers(filter: UserFilter, order: UserOrder, first: Int, offset: Int): [User!]
   project_statuses(filter: ProjectColumnFilter, order: ProjectColumnOrder, first: Int, offset: Int): [ProjectColumn!]
+  template(filter: TensionTemplateFilter): TensionTemplate
+  blocks(filter: TensionFilter, order: TensionOrder, first: Int, offset: Int): [Tension!]
+  blocked_by(filter: TensionFilter, order: TensionOrder, first: Int, offset: Int): [Tension!]
+  duplicate_of(filter: TensionFilter): Tension
+  duplicates(filter: TensionFilter, order: TensionOrder, first: Int, offset: Int): [Tension!]
+  parent(filter: TensionFilter): Tension
+  subtasks(filter: TensionFilter, order: TensionOrder, first: Int, offset: Int): [Tension!]
+  related(filter: TensionFilter, order: TensionOrder, first: Int, offset: Int): [Tension!]
+  attachments(filter: AttachmentFilter, order: AttachmentOrder, first: Int, offset: Int): [Attachment!]
   n_comments: Int
   id: ID!
   createdBy(filter: UserFilter): User!
@@ -10226,6 +13291,7 @@ type Tension {
   message: String
 
   assigneesAggregate(filter: UserFilter): UserAggregateResult
+  participantsAggregate(filter: UserFilter): UserAggregateResult
   labelsAggregate(filter: LabelFilter): LabelAggregateResult
   commentsAggregate(filter: CommentFilter): CommentAggregateResult
   blobsAggregate(filter: BlobFilter): BlobAggregateResult
@@ -10234,25 +13300,79 @@ type Tension {
   contractsAggregate(filter: ContractFilter): ContractAggregateResult
   subscribersAggregate(filter: UserFilter): UserAggregateResult
   project_statusesAggregate(filter: ProjectColumnFilter): ProjectColumnAggregateResult
+  blocksAggregate(filter: TensionFilter): TensionAggregateResult
+  blocked_byAggregate(filter: TensionFilter): TensionAggregateResult
+  duplicatesAggregate(filter: TensionFilter): TensionAggregateResult
+  subtasksAggregate(filter: TensionFilter): TensionAggregateResult
+  relatedAggregate(filter: TensionFilter): TensionAggregateResult
+  attachmentsAggregate(filter: AttachmentFilter): AttachmentAggregateResult
+}
+
+type Comment {
+  message: String!
+  reactions(filter: ReactionFilter, order: ReactionOrder, first: Int, offset: Int): [Reaction!]
+  attachments(filter: AttachmentFilter, order: AttachmentOrder, first: Int, offset: Int): [Attachment!]
+  id: ID!
+  createdBy(filter: UserFilter): User!
+  createdAt: DateTime!
+  updatedAt: DateTime
+
+  reactionsAggregate(filter: ReactionFilter): ReactionAggregateResult
+  attachmentsAggregate(filter: AttachmentFilter): AttachmentAggregateResult
+}
+
+type Reaction {
+  id: ID!
+  reactionid: String!
+  user(filter: UserFilter): User!
+  comment(filter: CommentFilter): Comment!
+  type_: Int!
+}
+
+type NotifPreference {
+  id: ID!
+  preferenceid: String!
+  user(filter: UserFilter): User!
+  rootnameid: String
+  reason: NotifPreferenceReason
+  event_group: NotifEventGroup
+  channel: NotifChannel!
+}
+
+type MailingSetting {
+  id: ID!
+  createdBy(filter: UserFilter): User!
+  createdAt: DateTime!
+  rootnameid: String!
+  nameid: String!
+  enabled: Boolean!
+  alias: String
+  senders: [MailingSender!]
+  allowlist: [String!]
+  type_: TensionType
+  labels(filter: LabelFilter, order: LabelOrder, first: Int, offset: Int): [Label!]
+  labelsAggregate(filter: LabelFilter): LabelAggregateResult
 }
 
-type Comment {
-  message: String!
-  reactions(filter: ReactionFilter, order: ReactionOrder, first: Int, offset: Int): [Reaction!]
+type MatrixRoom {
   id: ID!
   createdBy(filter: UserFilter): User!
   createdAt: DateTime!
-  updatedAt: DateTime
-
-  reactionsAggregate(filter: ReactionFilter): ReactionAggregateResult
+  rootnameid: String!
+  nameid: String!
+  roomid: String!
 }
 
-type Reaction {
+type Attachment {
   id: ID!
-  reactionid: String!
-  user(filter: UserFilter): User!
-  comment(filter: CommentFilter): Comment!
-  type_: Int!
+  createdBy(filter: UserFilter): User!
+  createdAt: DateTime!
+  tension(filter: TensionFilter): Tension!
+  comment(filter: CommentFilter): Comment
+  filename: String!
+  content_type: String!
+  size: Int!
+  key: String!
 }
 
 type Blob {
@@ -10339,6 +13459,9 @@ type User {
   skills: [String!]
   notifyByEmail: Boolean!
   lang: Lang!
+  emailDelivery: EmailDelivery
+  emailStatus: EmailStatus @private
+  matrixid: String @private
   subscriptions(filter: TensionFilter, order: TensionOrder, first: Int, offset: Int): [Tension!] @private
   watching(filter: NodeFilter, order: NodeOrder, first: Int, offset: Int): [Node!] @private
   rights(filter: UserRightsFilter): UserRights!
@@ -10348,6 +13471,7 @@ type User {
   contracts(filter: ContractFilter, order: ContractOrder, first: Int, offset: Int): [Contract!] @private
   reactions(filter: ReactionFilter, order: ReactionOrder, first: Int, offset: Int): [Reaction!]
   events(filter: UserEventFilter, order: UserEventOrder, first: Int, offset: Int): [UserEvent!] @private
+  notif_preferences(filter: NotifPreferenceFilter, order: NotifPreferenceOrder, first: Int, offset: Int): [NotifPreference!] @private
   markAllAsRead: String
   event_count(filter: EventCountFilter): EventCount @meta(f:"getEventCount", k:"username")
 
@@ -10359,6 +13483,7 @@ type User {
   contractsAggregate(filter: ContractFilter): ContractAggregateResult
   reactionsAggregate(filter: ReactionFilter): ReactionAggregateResult
   eventsAggregate(filter: UserEventFilter): UserEventAggregateResult
+  notif_preferencesAggregate(filter: NotifPreferenceFilter): NotifPreferenceAggregateResult
 }
 
 type PendingUser {
@@ -10457,6 +13582,7 @@ enum NodeMode {
 enum TensionStatus {
   Open
   Closed
+  Pending
 }
 
 enum TensionType {
@@ -10515,6 +13641,21 @@ enum TensionEvent {
   Authority
   Visibility
   Moved
+  OwnerAdded
+  OwnerRemoved
+  OwnerTransferred
+  DueDateUpdated
+  BlockAdded
+  BlockRemoved
+  DuplicateUpdated
+  ParentUpdated
+  RelatedAdded
+  RelatedRemoved
+  Merged
+  ConfidentialUpdated
+  ParticipantAdded
+  ParticipantRemoved
+  ProjectAdded
 }
 
 enum BlobType {
@@ -10566,6 +13707,60 @@ enum Lang {
   FR
 }
 
+enum EmailDelivery {
+  Immediate
+  Hourly
+  Daily
+  Weekly
+}
+
+enum EmailStatus {
+  Delivered
+  SoftBounced
+  HardBounced
+  Complained
+  Held
+}
+
+enum NotifPreferenceReason {
+  Invited
+  LinkCandidate
+  Candidate
+  Participant
+  Coordo
+  Peer
+  FirstLink
+  Assignee
+  Subscriber
+  Mentioned
+  Alert
+  Announcement
+}
+
+enum NotifEventGroup {
+  Creation
+  Status
+  Comment
+  Mandate
+  Membership
+  Contract
+  Notice
+  Other
+}
+
+enum NotifChannel {
+  App
+  Email
+  None
+}
+
+enum MailingSender {
+  Member
+  Guest
+  Allowlist
+  Anyone
+}
+
 # Dgraph.Authorization {"Header":"X-Frac6-Auth","Namespace":"https://fractale.co/jwt/claims","Algo":"RS256","VerificationKey":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAqfBbJAanlwf2mYlBszBA\nxgHw3hTu6gZ9nmej+5fCCdyA85IXhw14+F14o+vLogPe/giFuPMpG9eCOPWKvL/T\nGyahW5Lm8TRB4Pf54fZq5+VKdf5/i9u2e8CelpFvT+zLRdBmNVy9H9MitOF9mSGK\nHviPH1nHzU6TGvuVf44s60LAKliiwagALF+T/3ReDFhoqdLb1J3w4JkxFO6Guw5p\n3aDT+RMjjz9W8XpT3+k8IHocWxcEsuWMKdhuNwOHX2l7yU+/yLOrK1nuAMH7KewC\nCT4gJOan1qFO8NKe37jeQgsuRbhtF5C+L6CKs3n+B2A3ZOYB4gzdJfMLXxW/wwr1\nRQIDAQAB\n-----END PUBLIC KEY-----"}
 
 directive @search(by: [DgraphIndex!]) on FIELD_DEFINITION
@@ -10598,6 +13793,22 @@ directive @lambdaOnMutate(add: Boolean, update: Boolean, delete: Boolean) on OBJ
 
 directive @generate(query: GenerateQueryParams, mutation: GenerateMutationParams, subscription: Boolean) on OBJECT|INTERFACE
 
+input AddAttachmentInput {
+  createdBy: UserRef!
+  createdAt: DateTime!
+  tension: TensionRef!
+  comment: CommentRef
+  filename: String!
+  content_type: String!
+  size: Int!
+  key: String!
+}
+
+type AddAttachmentPayload {
+  attachment(filter: AttachmentFilter, order: AttachmentOrder, first: Int, offset: Int): [Attachment]
+  numUids: Int
+}
+
 input AddBlobInput {
   createdBy: UserRef!
   createdAt: DateTime! @w_add(a:"now")
@@ -10622,6 +13833,7 @@ input AddCommentInput {
   updatedAt: DateTime @x_alter(r:"isOwner", f:"createdBy")
   message: String
   reactions: [ReactionRef!]
+  attachments: [AttachmentRef!]
 }
 
 type AddCommentPayload {
@@ -10763,6 +13975,7 @@ input AddNodeInput {
   children: [NodeRef!]
   labels: [LabelRef!]
   roles: [RoleExtRef!]
+  templates: [TensionTemplateRef!]
   projects: [ProjectRef!]
   pinned: [TensionRef!] @x_add(r:"ref")
   role_ext: RoleExtRef
@@ -10793,6 +14006,51 @@ type AddNotifPayload {
   numUids: Int
 }
 
+input AddNotifPreferenceInput {
+  preferenceid: String!
+  user: UserRef! @x_add(r:"ref")
+  rootnameid: String
+  reason: NotifPreferenceReason
+  event_group: NotifEventGroup
+  channel: NotifChannel!
+}
+
+input AddMailingSettingInput {
+  createdBy: UserRef!
+  createdAt: DateTime! @w_add(a:"now")
+  rootnameid: String!
+  nameid: String!
+  enabled: Boolean!
+  alias: String @w_alter(a:"lower") @x_alter(r:"maxLen", n:64)
+  senders: [MailingSender!]
+  allowlist: [String!]
+  type_: TensionType
+  labels: [LabelRef!] @x_alter(r:"ref")
+}
+
+type AddNotifPreferencePayload {
+  notifPreference(filter: NotifPreferenceFilter, order: NotifPreferenceOrder, first: Int, offset: Int): [NotifPreference]
+  numUids: Int
+}
+
+type AddMailingSettingPayload {
+  mailingSetting(filter: MailingSettingFilter, order: MailingSettingOrder, first: Int, offset: Int): [MailingSetting]
+  numUids: Int
+}
+
+input AddMatrixRoomInput {
+  createdBy: UserRef!
+  createdAt: DateTime! @w_add(a:"now")
+  rootnameid: String!
+  nameid: String!
+  roomid: String! @x_alter(r:"maxLen", n:255)
+}
+
+type AddMatrixRoomPayload {
+  matrixRoom(filter: MatrixRoomFilter, order: MatrixRoomOrder, first: Int, offset: Int): [MatrixRoom]
+  numUids: Int
+}
+
 input AddPendingUserInput {
   updatedAt: DateTime
   username: String! @w_alter(a:"lower")
@@ -10907,6 +14165,26 @@ type AddReactionPayload {
   numUids: Int
 }
 
+input AddRecurrenceInput {
+  createdBy: UserRef!
+  createdAt: DateTime! @w_add(a:"now")
+  rootnameid: String!
+  receiverid: String!
+  rrule: String!
+  dtstart: DateTime!
+  paused: Boolean
+  title: String @x_alter(r:"maxLen", n:280)
+  template: TensionTemplateRef @x_alter(r:"ref")
+  tension: TensionRef @x_alter(r:"ref")
+  next_at: DateTime
+  count: Int
+}
+
+type AddRecurrencePayload {
+  recurrence(filter: RecurrenceFilter, order: RecurrenceOrder, first: Int, offset: Int): [Recurrence]
+  numUids: Int
+}
+
 input AddRoleExtInput {
   rootnameid: String!
   name: String! @w_alter(a:"lower") @x_alter(r:"unique", f:"rootnameid") @x_alter(r:"minLen", n:1)
@@ -10936,7 +14214,10 @@ input AddTensionInput {
   type_: TensionType! @x_alter(r:"tensionTypeCheck")
   status: TensionStatus!
   action: TensionAction
+  dueDate: DateTime
+  confidential: Boolean @x_alter(r:"hasEvent", e:[Created, ConfidentialUpdated])
   assignees: [UserRef!] @x_alter(r:"hasEvent", e:[AssigneeAdded, AssigneeRemoved]) @x_alter(r:"ref")
+  participants: [UserRef!] @x_alter(r:"hasEvent", e:[Created, ParticipantAdded, ParticipantRemoved]) @x_alter(r:"ref")
   labels: [LabelRef!] @x_alter(r:"hasEvent", e:[LabelAdded, LabelRemoved]) @x_alter(r:"ref")
   comments: [CommentRef!] @x_alter(r:"hasEvent", e:[Created, CommentPushed]) @x_alter(r:"oneByOne")
   blobs: [BlobRef!] @x_alter(r:"hasEvent", e:[BlobCreated, BlobCommitted]) @x_alter(r:"oneByOne")
@@ -10945,14 +14226,57 @@ input AddTensionInput {
   contracts: [ContractRef!] @x_add(r:"ref")
   subscribers: [UserRef!] @x_add(r:"ref")
   project_statuses: [ProjectColumnRef!]
+  template: TensionTemplateRef @x_add(r:"ref")
+  blocks: [TensionRef!]
+  blocked_by: [TensionRef!]
+  duplicate_of: TensionRef
+  duplicates: [TensionRef!]
+  parent: TensionRef
+  subtasks: [TensionRef!]
+  related: [TensionRef!]
+  attachments: [AttachmentRef!]
   n_comments: Int
 }
 
+input AddTensionTemplateInput {
+  rootnameid: String!
+  name: String! @w_alter(a:"lower") @x_alter(r:"unique", f:"rootnameid") @x_alter(r:"minLen", n:1)
+  about: String @x_alter(r:"maxLen", n:280)
+  title: String @x_alter(r:"maxLen", n:280)
+  message: String
+  type_: TensionType
+  labels: [LabelRef!] @x_alter(r:"ref")
+  assignees: [UserRef!] @x_alter(r:"ref")
+  nodes: [NodeRef!] @x_alter(r:"oneByOne") @x_alter(r:"ref")
+}
+
 type AddTensionPayload {
   tension(filter: TensionFilter, order: TensionOrder, first: Int, offset: Int): [Tension]
   numUids: Int
 }
 
+type AddTensionTemplatePayload {
+  tensionTemplate(filter: TensionTemplateFilter, order: TensionTemplateOrder, first: Int, offset: Int): [TensionTemplate]
+  numUids: Int
+}
+
+input AddTensionViewInput {
+  createdBy: UserRef!
+  createdAt: DateTime! @w_add(a:"now")
+  rootnameid: String!
+  nameid: String
+  name: String! @x_alter(r:"maxLen", n:100)
+  query: String!
+  display: String
+  watchers: [UserRef!] @x_alter(r:"ref")
+  checked_at: DateTime
+}
+
+type AddTensionViewPayload {
+  tensionView(filter: TensionViewFilter, order: TensionViewOrder, first: Int, offset: Int): [TensionView]
+  numUids: Int
+}
+
 input AddUserEventInput {
   createdAt: DateTime!
   isRead: Boolean!
@@ -10979,6 +14303,9 @@ input AddUserInput {
   skills: [String!]
   notifyByEmail: Boolean!
   lang: Lang!
+  emailDelivery: EmailDelivery
+  emailStatus: EmailStatus
+  matrixid: String
   subscriptions: [TensionRef!] @x_alter(r:"ref")
   watching: [NodeRef!] @x_alter(r:"ref")
   rights: UserRightsRef!
@@ -10988,6 +14315,7 @@ input AddUserInput {
   contracts: [ContractRef!] @x_add(r:"ref")
   reactions: [ReactionRef!]
   events: [UserEventRef!]
+  notif_preferences: [NotifPreferenceRef!]
   markAllAsRead: String
   event_count: EventCountRef
 }
@@ -11034,6 +14362,79 @@ input AuthRule {
   rule: String
 }
 
+type AttachmentAggregateResult {
+  count: Int
+  createdAtMin: DateTime
+  createdAtMax: DateTime
+  filenameMin: String
+  filenameMax: String
+  content_typeMin: String
+  content_typeMax: String
+  sizeMin: Int
+  sizeMax: Int
+  sizeSum: Int
+  sizeAvg: Float
+  keyMin: String
+  keyMax: String
+}
+
+input AttachmentFilter {
+  id: [ID!]
+  createdAt: DateTimeFilter
+  has: [AttachmentHasFilter]
+  and: [AttachmentFilter]
+  or: [AttachmentFilter]
+  not: AttachmentFilter
+}
+
+enum AttachmentHasFilter {
+  createdBy
+  createdAt
+  tension
+  comment
+  filename
+  content_type
+  size
+  key
+}
+
+input AttachmentOrder {
+  asc: AttachmentOrderable
+  desc: AttachmentOrderable
+  then: AttachmentOrder
+}
+
+enum AttachmentOrderable {
+  createdAt
+  filename
+  content_type
+  size
+  key
+}
+
+input AttachmentPatch {
+  createdBy: UserRef @x_patch_ro
+  createdAt: DateTime @x_patch_ro
+  tension: TensionRef @x_patch_ro
+  comment: CommentRef @x_patch_ro
+  filename: String @x_patch_ro
+  content_type: String @x_patch_ro
+  size: Int @x_patch_ro
+  key: String @x_patch_ro
+}
+
+input AttachmentRef {
+  id: ID
+  createdBy: UserRef
+  createdAt: DateTime
+  tension: TensionRef
+  comment: CommentRef
+  filename: String
+  content_type: String
+  size: Int
+  key: String
+}
+
 type BlobAggregateResult {
   count: Int
   createdAtMin: DateTime
@@ -11165,6 +14566,7 @@ enum CommentHasFilter {
   updatedAt
   message
   reactions
+  attachments
 }
 
 input CommentOrder {
@@ -11185,6 +14587,7 @@ input CommentPatch {
   updatedAt: DateTime @x_alter(r:"isOwner", f:"createdBy")
   message: String
   reactions: [ReactionRef!] @x_patch_ro
+  attachments: [AttachmentRef!] @x_ro
 }
 
 input CommentRef {
@@ -11194,6 +14597,7 @@ input CommentRef {
   updatedAt: DateTime @x_alter(r:"isOwner", f:"createdBy")
   message: String
   reactions: [ReactionRef!]
+  attachments: [AttachmentRef!] @x_ro
 }
 
 input ContainsFilter {
@@ -11336,6 +14740,12 @@ input DateTimeRange {
   max: DateTime!
 }
 
+type DeleteAttachmentPayload {
+  attachment(filter: AttachmentFilter, order: AttachmentOrder, first: Int, offset: Int): [Attachment]
+  msg: String
+  numUids: Int
+}
+
 type DeleteBlobPayload {
   blob(filter: BlobFilter, order: BlobOrder, first: Int, offset: Int): [Blob]
   msg: String
@@ -11402,6 +14812,24 @@ type DeleteNotifPayload {
   numUids: Int
 }
 
+type DeleteNotifPreferencePayload {
+  notifPreference(filter: NotifPreferenceFilter, order: NotifPreferenceOrder, first: Int, offset: Int): [NotifPreference]
+  msg: String
+  numUids: Int
+}
+
+type DeleteMailingSettingPayload {
+  mailingSetting(filter: MailingSettingFilter, order: MailingSettingOrder, first: Int, offset: Int): [MailingSetting]
+  msg: String
+  numUids: Int
+}
+
+type DeleteMatrixRoomPayload {
+  matrixRoom(filter: MatrixRoomFilter, order: MatrixRoomOrder, first: Int, offset: Int): [MatrixRoom]
+  msg: String
+  numUids: Int
+}
+
 type DeletePendingUserPayload {
   pendingUser(filter: PendingUserFilter, order: PendingUserOrder, first: Int, offset: Int): [PendingUser]
   msg: String
@@ -11456,6 +14884,12 @@ type DeleteReactionPayload {
   numUids: Int
 }
 
+type DeleteRecurrencePayload {
+  recurrence(filter: RecurrenceFilter, order: RecurrenceOrder, first: Int, offset: Int): [Recurrence]
+  msg: String
+  numUids: Int
+}
+
 type DeleteRoleExtPayload {
   roleExt(filter: RoleExtFilter, order: RoleExtOrder, first: Int, offset: Int): [RoleExt]
   msg: String
@@ -11468,6 +14902,18 @@ type DeleteTensionPayload {
   numUids: Int
 }
 
+type DeleteTensionTemplatePayload {
+  tensionTemplate(filter: TensionTemplateFilter, order: TensionTemplateOrder, first: Int, offset: Int): [TensionTemplate]
+  msg: String
+  numUids: Int
+}
+
+type DeleteTensionViewPayload {
+  tensionView(filter: TensionViewFilter, order: TensionViewOrder, first: Int, offset: Int): [TensionView]
+  msg: String
+  numUids: Int
+}
+
 type DeleteUserEventPayload {
   userEvent(filter: UserEventFilter, order: UserEventOrder, first: Int, offset: Int): [UserEvent]
   msg: String
@@ -11932,6 +15378,15 @@ type Mutation {
   addRoleExt(input: [AddRoleExtInput!]! @hook_addRoleExtInput): AddRoleExtPayload @hook_addRoleExt
   updateRoleExt(input: UpdateRoleExtInput! @hook_updateRoleExtInput): UpdateRoleExtPayload @hook_updateRoleExt
   deleteRoleExt(filter: RoleExtFilter! @hook_deleteRoleExtInput): DeleteRoleExtPayload @hook_deleteRoleExt
+  addTensionTemplate(input: [AddTensionTemplateInput!]! @hook_addTensionTemplateInput): AddTensionTemplatePayload @hook_addTensionTemplate
+  updateTensionTemplate(input: UpdateTensionTemplateInput! @hook_updateTensionTemplateInput): UpdateTensionTemplatePayload @hook_updateTensionTemplate
+  deleteTensionTemplate(filter: TensionTemplateFilter! @hook_deleteTensionTemplateInput): DeleteTensionTemplatePayload @hook_deleteTensionTemplate
+  addRecurrence(input: [AddRecurrenceInput!]! @hook_addRecurrenceInput): AddRecurrencePayload @hook_addRecurrence
+  updateRecurrence(input: UpdateRecurrenceInput! @hook_updateRecurrenceInput): UpdateRecurrencePayload @hook_updateRecurrence
+  deleteRecurrence(filter: RecurrenceFilter! @hook_deleteRecurrenceInput): DeleteRecurrencePayload @hook_deleteRecurrence
+  addTensionView(input: [AddTensionViewInput!]! @hook_addTensionViewInput): AddTensionViewPayload @hook_addTensionView
+  updateTensionView(input: UpdateTensionViewInput! @hook_updateTensionViewInput): UpdateTensionViewPayload @hook_updateTensionView
+  deleteTensionView(filter: TensionViewFilter! @hook_deleteTensionViewInput): DeleteTensionViewPayload @hook_deleteTensionView
   addProject(input: [AddProjectInput!]! @hook_addProjectInput): AddProjectPayload @hook_addProject
   updateProject(input: UpdateProjectInput! @hook_updateProjectInput): UpdateProjectPayload @hook_updateProject
   deleteProject(filter: ProjectFilter! @hook_deleteProjectInput): DeleteProjectPayload @hook_deleteProject
@@ -11961,6 +15416,18 @@ type Mutation {
   addReaction(input: [AddReactionInput!]! @hook_addReactionInput, upsert: Boolean): AddReactionPayload @hook_addReaction
   updateReaction(input: UpdateReactionInput! @hook_updateReactionInput): UpdateReactionPayload @hook_updateReaction
   deleteReaction(filter: ReactionFilter! @hook_deleteReactionInput): DeleteReactionPayload @hook_deleteReaction
+  addNotifPreference(input: [AddNotifPreferenceInput!]! @hook_addNotifPreferenceInput, upsert: Boolean): AddNotifPreferencePayload @hook_addNotifPreference
+  updateNotifPreference(input: UpdateNotifPreferenceInput! @hook_updateNotifPreferenceInput): UpdateNotifPreferencePayload @hook_updateNotifPreference
+  deleteNotifPreference(filter: NotifPreferenceFilter! @hook_deleteNotifPreferenceInput): DeleteNotifPreferencePayload @hook_deleteNotifPreference
+  addMailingSetting(input: [AddMailingSettingInput!]! @hook_addMailingSettingInput, upsert: Boolean): AddMailingSettingPayload @hook_addMailingSetting
+  updateMailingSetting(input: UpdateMailingSettingInput! @hook_updateMailingSettingInput): UpdateMailingSettingPayload @hook_updateMailingSetting
+  deleteMailingSetting(filter: MailingSettingFilter! @hook_deleteMailingSettingInput): DeleteMailingSettingPayload @hook_deleteMailingSetting
+  addMatrixRoom(input: [AddMatrixRoomInput!]! @hook_addMatrixRoomInput, upsert: Boolean): AddMatrixRoomPayload @hook_addMatrixRoom
+  updateMatrixRoom(input: UpdateMatrixRoomInput! @hook_updateMatrixRoomInput): UpdateMatrixRoomPayload @hook_updateMatrixRoom
+  deleteMatrixRoom(filter: MatrixRoomFilter! @hook_deleteMatrixRoomInput): DeleteMatrixRoomPayload @hook_deleteMatrixRoom
+  addAttachment(input: [AddAttachmentInput!]! @hook_addAttachmentInput): AddAttachmentPayload @hook_addAttachment
+  updateAttachment(input: UpdateAttachmentInput! @hook_updateAttachmentInput): UpdateAttachmentPayload @hook_updateAttachment
+  deleteAttachment(filter: AttachmentFilter! @hook_deleteAttachmentInput): DeleteAttachmentPayload @hook_deleteAttachment
   addBlob(input: [AddBlobInput!]!): AddBlobPayload
   updateBlob(input: UpdateBlobInput!): UpdateBlobPayload
   deleteBlob(filter: BlobFilter!): DeleteBlobPayload
@@ -12155,6 +15622,7 @@ enum NodeHasFilter {
   children
   labels
   roles
+  templates
   projects
   pinned
   role_ext
@@ -12212,6 +15680,7 @@ input NodePatch {
   children: [NodeRef!] @x_patch_ro
   labels: [LabelRef!] @x_patch_ro
   roles: [RoleExtRef!] @x_patch_ro
+  templates: [TensionTemplateRef!] @x_patch_ro
   projects: [ProjectRef!] @x_patch_ro
   pinned: [TensionRef!] @x_patch_ro
   role_ext: RoleExtRef @x_patch_ro
@@ -12249,6 +15718,7 @@ input NodeRef {
   children: [NodeRef!]
   labels: [LabelRef!]
   roles: [RoleExtRef!]
+  templates: [TensionTemplateRef!]
   projects: [ProjectRef!]
   pinned: [TensionRef!] @x_add(r:"ref")
   role_ext: RoleExtRef
@@ -12281,47 +15751,252 @@ type NotifAggregateResult {
   linkMax: String
 }
 
-input NotifFilter {
-  id: [ID!]
-  createdAt: DateTimeFilter
-  message: StringFullTextFilter
-  has: [NotifHasFilter]
-  and: [NotifFilter]
-  or: [NotifFilter]
-  not: NotifFilter
+input NotifEventGroup_hash {
+  eq: NotifEventGroup
+  in: [NotifEventGroup]
+}
+
+input NotifFilter {
+  id: [ID!]
+  createdAt: DateTimeFilter
+  message: StringFullTextFilter
+  has: [NotifHasFilter]
+  and: [NotifFilter]
+  or: [NotifFilter]
+  not: NotifFilter
+}
+
+enum NotifHasFilter {
+  createdBy
+  createdAt
+  updatedAt
+  message
+  tension_
+  contract
+  link
+}
+
+input NotifOrder {
+  asc: NotifOrderable
+  desc: NotifOrderable
+  then: NotifOrder
+}
+
+enum NotifOrderable {
+  createdAt
+  updatedAt
+  message
+  link
+}
+
+input NotifPatch {
+  createdBy: UserRef @x_patch_ro
+  createdAt: DateTime @x_patch_ro
+  updatedAt: DateTime @x_alter(r:"isOwner", f:"createdBy")
+  message: String @x_alter(r:"isOwner", f:"createdBy")
+  tension_: TensionRef @x_patch_ro
+  contract: ContractRef @x_patch_ro
+  link: String @x_patch_ro
+}
+
+type NotifPreferenceAggregateResult {
+  count: Int
+  preferenceidMin: String
+  preferenceidMax: String
+  rootnameidMin: String
+  rootnameidMax: String
+}
+
+type MailingSettingAggregateResult {
+  count: Int
+  createdAtMin: DateTime
+  createdAtMax: DateTime
+  rootnameidMin: String
+  rootnameidMax: String
+  nameidMin: String
+  nameidMax: String
+  aliasMin: String
+  aliasMax: String
+}
+
+type MatrixRoomAggregateResult {
+  count: Int
+  createdAtMin: DateTime
+  createdAtMax: DateTime
+  rootnameidMin: String
+  rootnameidMax: String
+  nameidMin: String
+  nameidMax: String
+  roomidMin: String
+  roomidMax: String
+}
+
+input NotifPreferenceFilter {
+  id: [ID!]
+  preferenceid: StringHashFilter
+  rootnameid: StringHashFilter
+  reason: NotifPreferenceReason_hash
+  event_group: NotifEventGroup_hash
+  has: [NotifPreferenceHasFilter]
+  and: [NotifPreferenceFilter]
+  or: [NotifPreferenceFilter]
+  not: NotifPreferenceFilter
+}
+
+input MailingSettingFilter {
+  id: [ID!]
+  createdAt: DateTimeFilter
+  rootnameid: StringHashFilter
+  nameid: StringHashFilter
+  alias: StringHashFilter
+  has: [MailingSettingHasFilter]
+  and: [MailingSettingFilter]
+  or: [MailingSettingFilter]
+  not: MailingSettingFilter
+}
+
+input MatrixRoomFilter {
+  id: [ID!]
+  createdAt: DateTimeFilter
+  rootnameid: StringHashFilter
+  nameid: StringHashFilter
+  roomid: StringHashFilter
+  has: [MatrixRoomHasFilter]
+  and: [MatrixRoomFilter]
+  or: [MatrixRoomFilter]
+  not: MatrixRoomFilter
+}
+
+enum NotifPreferenceHasFilter {
+  preferenceid
+  user
+  rootnameid
+  reason
+  event_group
+  channel
+}
+
+enum MailingSettingHasFilter {
+  createdBy
+  createdAt
+  rootnameid
+  nameid
+  enabled
+  alias
+  senders
+  allowlist
+  type_
+  labels
+}
+
+input NotifPreferenceOrder {
+  asc: NotifPreferenceOrderable
+  desc: NotifPreferenceOrderable
+  then: NotifPreferenceOrder
+}
+
+input MailingSettingOrder {
+  asc: MailingSettingOrderable
+  desc: MailingSettingOrderable
+  then: MailingSettingOrder
 }
 
-enum NotifHasFilter {
+enum NotifPreferenceOrderable {
+  preferenceid
+  rootnameid
+}
+
+enum MailingSettingOrderable {
+  createdAt
+  rootnameid
+  nameid
+  alias
+}
+
+enum MatrixRoomHasFilter {
   createdBy
   createdAt
-  updatedAt
-  message
-  tension_
-  contract
-  link
+  rootnameid
+  nameid
+  roomid
 }
 
-input NotifOrder {
-  asc: NotifOrderable
-  desc: NotifOrderable
-  then: NotifOrder
+input MatrixRoomOrder {
+  asc: MatrixRoomOrderable
+  desc: MatrixRoomOrderable
+  then: MatrixRoomOrder
 }
 
-enum NotifOrderable {
+enum MatrixRoomOrderable {
   createdAt
-  updatedAt
-  message
-  link
+  rootnameid
+  nameid
+  roomid
 }
 
-input NotifPatch {
+input NotifPreferencePatch {
+  user: UserRef @x_patch_ro
+  rootnameid: String @x_patch_ro
+  reason: NotifPreferenceReason @x_patch_ro
+  event_group: NotifEventGroup @x_patch_ro
+  channel: NotifChannel @x_patch
+}
+
+input MailingSettingPatch {
   createdBy: UserRef @x_patch_ro
   createdAt: DateTime @x_patch_ro
-  updatedAt: DateTime @x_alter(r:"isOwner", f:"createdBy")
-  message: String @x_alter(r:"isOwner", f:"createdBy")
-  tension_: TensionRef @x_patch_ro
-  contract: ContractRef @x_patch_ro
-  link: String @x_patch_ro
+  rootnameid: String @x_patch_ro
+  enabled: Boolean
+  alias: String @w_alter(a:"lower") @x_alter(r:"maxLen", n:64)
+  senders: [MailingSender!]
+  allowlist: [String!]
+  type_: TensionType
+  labels: [LabelRef!] @x_alter(r:"ref")
+}
+
+input MatrixRoomPatch {
+  createdBy: UserRef @x_patch_ro
+  createdAt: DateTime @x_patch_ro
+  rootnameid: String @x_patch_ro
+  roomid: String @x_alter(r:"maxLen", n:255)
+}
+
+input NotifPreferenceReason_hash {
+  eq: NotifPreferenceReason
+  in: [NotifPreferenceReason]
+}
+
+input NotifPreferenceRef {
+  id: ID
+  preferenceid: String
+  user: UserRef @x_add(r:"ref")
+  rootnameid: String
+  reason: NotifPreferenceReason
+  event_group: NotifEventGroup
+  channel: NotifChannel @x_patch
+}
+
+input MailingSettingRef {
+  id: ID
+  createdBy: UserRef
+  createdAt: DateTime @w_add(a:"now")
+  rootnameid: String
+  nameid: String
+  enabled: Boolean
+  alias: String @w_alter(a:"lower") @x_alter(r:"maxLen", n:64)
+  senders: [MailingSender!]
+  allowlist: [String!]
+  type_: TensionType
+  labels: [LabelRef!] @x_alter(r:"ref")
+}
+
+input MatrixRoomRef {
+  id: ID
+  createdBy: UserRef
+  createdAt: DateTime @w_add(a:"now")
+  rootnameid: String
+  nameid: String
+  roomid: String @x_alter(r:"maxLen", n:255)
 }
 
 input NotifRef {
@@ -12880,6 +16555,15 @@ type Query {
   getRoleExt(id: ID!): RoleExt
   queryRoleExt(filter: RoleExtFilter @hook_queryRoleExtInput, order: RoleExtOrder, first: Int, offset: Int): [RoleExt]
   aggregateRoleExt(filter: RoleExtFilter): RoleExtAggregateResult
+  getTensionTemplate(id: ID!): TensionTemplate
+  queryTensionTemplate(filter: TensionTemplateFilter @hook_queryTensionTemplateInput, order: TensionTemplateOrder, first: Int, offset: Int): [TensionTemplate]
+  aggregateTensionTemplate(filter: TensionTemplateFilter): TensionTemplateAggregateResult
+  getRecurrence(id: ID!): Recurrence
+  queryRecurrence(filter: RecurrenceFilter @hook_queryRecurrenceInput, order: RecurrenceOrder, first: Int, offset: Int): [Recurrence]
+  aggregateRecurrence(filter: RecurrenceFilter): RecurrenceAggregateResult
+  getTensionView(id: ID!): TensionView
+  queryTensionView(filter: TensionViewFilter @hook_queryTensionViewInput, order: TensionViewOrder, first: Int, offset: Int): [TensionView]
+  aggregateTensionView(filter: TensionViewFilter): TensionViewAggregateResult
   getProject(id: ID!): Project
   queryProject(filter: ProjectFilter @hook_queryProjectInput, order: ProjectOrder, first: Int, offset: Int): [Project]
   aggregateProject(filter: ProjectFilter): ProjectAggregateResult
@@ -12908,6 +16592,18 @@ type Query {
   getReaction(id: ID, reactionid: String): Reaction
   queryReaction(filter: ReactionFilter @hook_queryReactionInput, order: ReactionOrder, first: Int, offset: Int): [Reaction]
   aggregateReaction(filter: ReactionFilter): ReactionAggregateResult
+  getNotifPreference(id: ID, preferenceid: String): NotifPreference
+  queryNotifPreference(filter: NotifPreferenceFilter @hook_queryNotifPreferenceInput, order: NotifPreferenceOrder, first: Int, offset: Int): [NotifPreference]
+  aggregateNotifPreference(filter: NotifPreferenceFilter): NotifPreferenceAggregateResult
+  getMailingSetting(id: ID, nameid: String): MailingSetting
+  queryMailingSetting(filter: MailingSettingFilter @hook_queryMailingSettingInput, order: MailingSettingOrder, first: Int, offset: Int): [MailingSetting]
+  aggregateMailingSetting(filter: MailingSettingFilter): MailingSettingAggregateResult
+  getMatrixRoom(id: ID, nameid: String): MatrixRoom
+  queryMatrixRoom(filter: MatrixRoomFilter @hook_queryMatrixRoomInput, order: MatrixRoomOrder, first: Int, offset: Int): [MatrixRoom]
+  aggregateMatrixRoom(filter: MatrixRoomFilter): MatrixRoomAggregateResult
+  getAttachment(id: ID!): Attachment
+  queryAttachment(filter: AttachmentFilter @hook_queryAttachmentInput, order: AttachmentOrder, first: Int, offset: Int): [Attachment]
+  aggregateAttachment(filter: AttachmentFilter): AttachmentAggregateResult
   getBlob(id: ID!): Blob
   queryBlob(filter: BlobFilter, order: BlobOrder, first: Int, offset: Int): [Blob]
   aggregateBlob(filter: BlobFilter): BlobAggregateResult
@@ -12991,6 +16687,104 @@ input ReactionRef {
   type_: Int
 }
 
+type RecurrenceAggregateResult {
+  count: Int
+  createdAtMin: DateTime
+  createdAtMax: DateTime
+  rootnameidMin: String
+  rootnameidMax: String
+  receiveridMin: String
+  receiveridMax: String
+  rruleMin: String
+  rruleMax: String
+  dtstartMin: DateTime
+  dtstartMax: DateTime
+  titleMin: String
+  titleMax: String
+  next_atMin: DateTime
+  next_atMax: DateTime
+  countMin: Int
+  countMax: Int
+  countSum: Int
+  countAvg: Float
+}
+
+input RecurrenceFilter {
+  id: [ID!]
+  createdAt: DateTimeFilter
+  rootnameid: StringHashFilter
+  receiverid: StringHashFilter
+  paused: Boolean
+  next_at: DateTimeFilter
+  has: [RecurrenceHasFilter]
+  and: [RecurrenceFilter]
+  or: [RecurrenceFilter]
+  not: RecurrenceFilter
+}
+
+enum RecurrenceHasFilter {
+  createdBy
+  createdAt
+  rootnameid
+  receiverid
+  rrule
+  dtstart
+  paused
+  title
+  template
+  tension
+  next_at
+  count
+}
+
+input RecurrenceOrder {
+  asc: RecurrenceOrderable
+  desc: RecurrenceOrderable
+  then: RecurrenceOrder
+}
+
+enum RecurrenceOrderable {
+  createdAt
+  rootnameid
+  receiverid
+  rrule
+  dtstart
+  title
+  next_at
+  count
+}
+
+input RecurrencePatch {
+  createdBy: UserRef @x_patch_ro
+  createdAt: DateTime @x_patch_ro
+  rootnameid: String @x_patch_ro
+  receiverid: String @x_patch_ro
+  rrule: String
+  dtstart: DateTime
+  paused: Boolean
+  title: String @x_alter(r:"maxLen", n:280)
+  template: TensionTemplateRef @x_alter(r:"ref")
+  tension: TensionRef @x_alter(r:"ref")
+  next_at: DateTime @x_patch_ro
+  count: Int @x_patch_ro
+}
+
+input RecurrenceRef {
+  id: ID
+  createdBy: UserRef
+  createdAt: DateTime @w_add(a:"now")
+  rootnameid: String
+  receiverid: String
+  rrule: String
+  dtstart: DateTime
+  paused: Boolean
+  title: String @x_alter(r:"maxLen", n:280)
+  template: TensionTemplateRef @x_alter(r:"ref")
+  tension: TensionRef @x_alter(r:"ref")
+  next_at: DateTime
+  count: Int
+}
+
 type RoleExtAggregateResult {
   count: Int
   rootnameidMin: String
@@ -13126,6 +16920,8 @@ type TensionAggregateResult {
   receiveridMax: String
   titleMin: String
   titleMax: String
+  dueDateMin: DateTime
+  dueDateMax: DateTime
   n_commentsMin: Int
   n_commentsMax: Int
   n_commentsSum: Int
@@ -13146,6 +16942,8 @@ input TensionFilter {
   title: StringFullTextFilter
   type_: TensionType_hash
   status: TensionStatus_hash
+  dueDate: DateTimeFilter
+  confidential: Boolean
   has: [TensionHasFilter]
   and: [TensionFilter]
   or: [TensionFilter]
@@ -13165,7 +16963,10 @@ enum TensionHasFilter {
   type_
   status
   action
+  dueDate
+  confidential
   assignees
+  participants
   labels
   comments
   blobs
@@ -13174,6 +16975,15 @@ enum TensionHasFilter {
   contracts
   subscribers
   project_statuses
+  template
+  blocks
+  blocked_by
+  duplicate_of
+  duplicates
+  parent
+  subtasks
+  related
+  attachments
   n_comments
 }
 
@@ -13190,6 +17000,7 @@ enum TensionOrderable {
   emitterid
   receiverid
   title
+  dueDate
   n_comments
 }
 
@@ -13206,7 +17017,10 @@ input TensionPatch {
   type_: TensionType @x_alter(r:"tensionTypeCheck")
   status: TensionStatus @x_patch_ro
   action: TensionAction @x_patch_ro
+  dueDate: DateTime @x_patch_ro
+  confidential: Boolean @x_alter(r:"hasEvent", e:[Created, ConfidentialUpdated])
   assignees: [UserRef!] @x_alter(r:"hasEvent", e:[AssigneeAdded, AssigneeRemoved]) @x_alter(r:"ref")
+  participants: [UserRef!] @x_alter(r:"hasEvent", e:[Created, ParticipantAdded, ParticipantRemoved]) @x_alter(r:"ref")
   labels: [LabelRef!] @x_alter(r:"hasEvent", e:[LabelAdded, LabelRemoved]) @x_alter(r:"ref")
   comments: [CommentRef!] @x_alter(r:"hasEvent", e:[Created, CommentPushed]) @x_alter(r:"oneByOne")
   blobs: [BlobRef!] @x_alter(r:"hasEvent", e:[BlobCreated, BlobCommitted]) @x_alter(r:"oneByOne")
@@ -13215,6 +17029,15 @@ input TensionPatch {
   contracts: [ContractRef!] @x_patch_ro
   subscribers: [UserRef!] @x_patch_ro
   project_statuses: [ProjectColumnRef!] @x_patch_ro
+  template: TensionTemplateRef @x_patch_ro
+  blocks: [TensionRef!] @x_patch_ro
+  blocked_by: [TensionRef!] @x_patch_ro
+  duplicate_of: TensionRef @x_patch_ro
+  duplicates: [TensionRef!] @x_patch_ro
+  parent: TensionRef @x_patch_ro
+  subtasks: [TensionRef!] @x_patch_ro
+  related: [TensionRef!] @x_patch_ro
+  attachments: [AttachmentRef!] @x_ro
   n_comments: Int @x_patch_ro
 }
 
@@ -13232,7 +17055,10 @@ input TensionRef {
   type_: TensionType @x_alter(r:"tensionTypeCheck")
   status: TensionStatus
   action: TensionAction
+  dueDate: DateTime
+  confidential: Boolean @x_alter(r:"hasEvent", e:[Created, ConfidentialUpdated])
   assignees: [UserRef!] @x_alter(r:"hasEvent", e:[AssigneeAdded, AssigneeRemoved]) @x_alter(r:"ref")
+  participants: [UserRef!] @x_alter(r:"hasEvent", e:[Created, ParticipantAdded, ParticipantRemoved]) @x_alter(r:"ref")
   labels: [LabelRef!] @x_alter(r:"hasEvent", e:[LabelAdded, LabelRemoved]) @x_alter(r:"ref")
   comments: [CommentRef!] @x_alter(r:"hasEvent", e:[Created, CommentPushed]) @x_alter(r:"oneByOne")
   blobs: [BlobRef!] @x_alter(r:"hasEvent", e:[BlobCreated, BlobCommitted]) @x_alter(r:"oneByOne")
@@ -13241,9 +17067,175 @@ input TensionRef {
   contracts: [ContractRef!] @x_add(r:"ref")
   subscribers: [UserRef!] @x_add(r:"ref")
   project_statuses: [ProjectColumnRef!]
+  template: TensionTemplateRef @x_add(r:"ref")
+  blocks: [TensionRef!]
+  blocked_by: [TensionRef!]
+  duplicate_of: TensionRef
+  duplicates: [TensionRef!]
+  parent: TensionRef
+  subtasks: [TensionRef!]
+  related: [TensionRef!]
+  attachments: [AttachmentRef!] @x_ro
   n_comments: Int
 }
 
+type TensionTemplateAggregateResult {
+  count: Int
+  rootnameidMin: String
+  rootnameidMax: String
+  nameMin: String
+  nameMax: String
+  aboutMin: String
+  aboutMax: String
+  titleMin: String
+  titleMax: String
+  messageMin: String
+  messageMax: String
+}
+
+input TensionTemplateFilter {
+  id: [ID!]
+  rootnameid: StringHashFilter
+  name: StringHashFilter_StringTermFilter @w_alter(a:"lower")
+  has: [TensionTemplateHasFilter]
+  and: [TensionTemplateFilter]
+  or: [TensionTemplateFilter]
+  not: TensionTemplateFilter
+}
+
+enum TensionTemplateHasFilter {
+  rootnameid
+  name
+  about
+  title
+  message
+  type_
+  labels
+  assignees
+  nodes
+}
+
+input TensionTemplateOrder {
+  asc: TensionTemplateOrderable
+  desc: TensionTemplateOrderable
+  then: TensionTemplateOrder
+}
+
+enum TensionTemplateOrderable {
+  rootnameid
+  name
+  about
+  title
+  message
+}
+
+input TensionTemplatePatch {
+  rootnameid: String @x_patch_ro
+  name: String @w_alter(a:"lower") @x_alter(r:"unique", f:"rootnameid") @x_alter(r:"minLen", n:1)
+  about: String @x_alter(r:"maxLen", n:280)
+  title: String @x_alter(r:"maxLen", n:280)
+  message: String
+  type_: TensionType
+  labels: [LabelRef!] @x_alter(r:"ref")
+  assignees: [UserRef!] @x_alter(r:"ref")
+  nodes: [NodeRef!] @x_alter(r:"oneByOne") @x_alter(r:"ref")
+}
+
+input TensionTemplateRef {
+  id: ID
+  rootnameid: String
+  name: String @w_alter(a:"lower") @x_alter(r:"unique", f:"rootnameid") @x_alter(r:"minLen", n:1)
+  about: String @x_alter(r:"maxLen", n:280)
+  title: String @x_alter(r:"maxLen", n:280)
+  message: String
+  type_: TensionType
+  labels: [LabelRef!] @x_alter(r:"ref")
+  assignees: [UserRef!] @x_alter(r:"ref")
+  nodes: [NodeRef!] @x_alter(r:"oneByOne") @x_alter(r:"ref")
+}
+
+type TensionViewAggregateResult {
+  count: Int
+  createdAtMin: DateTime
+  createdAtMax: DateTime
+  rootnameidMin: String
+  rootnameidMax: String
+  nameidMin: String
+  nameidMax: String
+  nameMin: String
+  nameMax: String
+  queryMin: String
+  queryMax: String
+  displayMin: String
+  displayMax: String
+  checked_atMin: DateTime
+  checked_atMax: DateTime
+}
+
+input TensionViewFilter {
+  id: [ID!]
+  createdAt: DateTimeFilter
+  rootnameid: StringHashFilter
+  nameid: StringHashFilter
+  has: [TensionViewHasFilter]
+  and: [TensionViewFilter]
+  or: [TensionViewFilter]
+  not: TensionViewFilter
+}
+
+enum TensionViewHasFilter {
+  createdBy
+  createdAt
+  rootnameid
+  nameid
+  name
+  query
+  display
+  watchers
+  checked_at
+}
+
+input TensionViewOrder {
+  asc: TensionViewOrderable
+  desc: TensionViewOrderable
+  then: TensionViewOrder
+}
+
+enum TensionViewOrderable {
+  createdAt
+  rootnameid
+  nameid
+  name
+  query
+  display
+  checked_at
+}
+
+input TensionViewPatch {
+  createdBy: UserRef @x_patch_ro
+  createdAt: DateTime @x_patch_ro
+  rootnameid: String @x_patch_ro
+  nameid: String
+  name: String @x_alter(r:"maxLen", n:100)
+  query: String
+  display: String
+  watchers: [UserRef!] @x_alter(r:"ref")
+  checked_at: DateTime @x_patch_ro
+}
+
+input TensionViewRef {
+  id: ID
+  createdBy: UserRef
+  createdAt: DateTime @w_add(a:"now")
+  rootnameid: String
+  nameid: String
+  name: String @x_alter(r:"maxLen", n:100)
+  query: String
+  display: String
+  watchers: [UserRef!] @x_alter(r:"ref")
+  checked_at: DateTime
+}
+
 input TensionStatus_hash {
   eq: TensionStatus
   in: [TensionStatus]
@@ -13254,6 +17246,17 @@ input TensionType_hash {
   in: [TensionType]
 }
 
+input UpdateAttachmentInput {
+  filter: AttachmentFilter!
+  set: AttachmentPatch
+  remove: AttachmentPatch
+}
+
+type UpdateAttachmentPayload {
+  attachment(filter: AttachmentFilter, order: AttachmentOrder, first: Int, offset: Int): [Attachment]
+  numUids: Int
+}
+
 input UpdateBlobInput {
   filter: BlobFilter!
   set: BlobPatch
@@ -13375,6 +17378,39 @@ type UpdateNotifPayload {
   numUids: Int
 }
 
+input UpdateNotifPreferenceInput {
+  filter: NotifPreferenceFilter!
+  set: NotifPreferencePatch
+  remove: NotifPreferencePatch
+}
+
+input UpdateMailingSettingInput {
+  filter: MailingSettingFilter!
+  set: MailingSettingPatch
+  remove: MailingSettingPatch
+}
+
+type UpdateNotifPreferencePayload {
+  notifPreference(filter: NotifPreferenceFilter, order: NotifPreferenceOrder, first: Int, offset: Int): [NotifPreference]
+  numUids: Int
+}
+
+type UpdateMailingSettingPayload {
+  mailingSetting(filter: MailingSettingFilter, order: MailingSettingOrder, first: Int, offset: Int): [MailingSetting]
+  numUids: Int
+}
+
+input UpdateMatrixRoomInput {
+  filter: MatrixRoomFilter!
+  set: MatrixRoomPatch
+  remove: MatrixRoomPatch
+}
+
+type UpdateMatrixRoomPayload {
+  matrixRoom(filter: MatrixRoomFilter, order: MatrixRoomOrder, first: Int, offset: Int): [MatrixRoom]
+  numUids: Int
+}
+
 input UpdatePendingUserInput {
   filter: PendingUserFilter!
   set: PendingUserPatch
@@ -13474,6 +17510,17 @@ type UpdateReactionPayload {
   numUids: Int
 }
 
+input UpdateRecurrenceInput {
+  filter: RecurrenceFilter!
+  set: RecurrencePatch
+  remove: RecurrencePatch
+}
+
+type UpdateRecurrencePayload {
+  recurrence(filter: RecurrenceFilter, order: RecurrenceOrder, first: Int, offset: Int): [Recurrence]
+  numUids: Int
+}
+
 input UpdateRoleExtInput {
   filter: RoleExtFilter!
   set: RoleExtPatch
@@ -13496,6 +17543,28 @@ type UpdateTensionPayload {
   numUids: Int
 }
 
+input UpdateTensionTemplateInput {
+  filter: TensionTemplateFilter!
+  set: TensionTemplatePatch
+  remove: TensionTemplatePatch
+}
+
+type UpdateTensionTemplatePayload {
+  tensionTemplate(filter: TensionTemplateFilter, order: TensionTemplateOrder, first: Int, offset: Int): [TensionTemplate]
+  numUids: Int
+}
+
+input UpdateTensionViewInput {
+  filter: TensionViewFilter!
+  set: TensionViewPatch
+  remove: TensionViewPatch
+}
+
+type UpdateTensionViewPayload {
+  tensionView(filter: TensionViewFilter, order: TensionViewOrder, first: Int, offset: Int): [TensionView]
+  numUids: Int
+}
+
 input UpdateUserEventInput {
   filter: UserEventFilter!
   set: UserEventPatch
@@ -13560,6 +17629,8 @@ type UserAggregateResult {
   locationMax: String
   utcMin: String
   utcMax: String
+  matrixidMin: String
+  matrixidMax: String
   markAllAsReadMin: String
   markAllAsReadMax: String
 }
@@ -13617,6 +17688,7 @@ input UserFilter {
   username: StringHashFilter_StringRegExpFilter
   name: StringRegExpFilter
   email: StringHashFilter
+  matrixid: StringHashFilter
   has: [UserHasFilter]
   and: [UserFilter]
   or: [UserFilter]
@@ -13637,6 +17709,9 @@ enum UserHasFilter {
   skills
   notifyByEmail
   lang
+  emailDelivery
+  emailStatus
+  matrixid
   subscriptions
   watching
   rights
@@ -13646,6 +17721,7 @@ enum UserHasFilter {
   contracts
   reactions
   events
+  notif_preferences
   markAllAsRead
   event_count
 }
@@ -13666,6 +17742,7 @@ enum UserOrderable {
   bio
   location
   utc
+  matrixid
   markAllAsRead
 }
 
@@ -13681,6 +17758,9 @@ input UserPatch {
   skills: [String!] @x_patch
   notifyByEmail: Boolean @x_patch
   lang: Lang @x_patch
+  emailDelivery: EmailDelivery @x_patch
+  emailStatus: EmailStatus @x_patch_ro
+  matrixid: String @x_patch_ro
   subscriptions: [TensionRef!] @x_patch @x_alter(r:"ref")
   watching: [NodeRef!] @x_patch @x_alter(r:"ref")
   rights: UserRightsRef @x_patch_ro
@@ -13690,6 +17770,7 @@ input UserPatch {
   contracts: [ContractRef!] @x_patch_ro
   reactions: [ReactionRef!]
   events: [UserEventRef!]
+  notif_preferences: [NotifPreferenceRef!]
   markAllAsRead: String @w_meta_patch(f:"markAllAsRead", k:"username")
   event_count: EventCountRef @x_patch_ro
 }
@@ -13709,6 +17790,9 @@ input UserRef {
   skills: [String!] @x_patch
   notifyByEmail: Boolean @x_patch
   lang: Lang @x_patch
+  emailDelivery: EmailDelivery @x_patch
+  emailStatus: EmailStatus
+  matrixid: String
   subscriptions: [TensionRef!] @x_patch @x_alter(r:"ref")
   watching: [NodeRef!] @x_patch @x_alter(r:"ref")
   rights: UserRightsRef
@@ -13718,6 +17802,7 @@ input UserRef {
   contracts: [ContractRef!] @x_add(r:"ref")
   reactions: [ReactionRef!]
   events: [UserEventRef!]
+  notif_preferences: [NotifPreferenceRef!]
   markAllAsRead: String @w_meta_patch(f:"markAllAsRead", k:"username")
   event_count: EventCountRef
 }
@@ -13851,6 +17936,16 @@ input VoteRef {
 input WithinFilter {
   polygon: PolygonRef!
 }
+`, BuiltIn: false},
+	{Name: "../../schema/upload.graphql", Input: `# Operations resolved by the API itself, not forwarded to Dgraph.
+# This file is not generated, edit it by hand.
+
+scalar Upload
+
+extend type Mutation {
+  # Upload a file and attach it to a tension, or to one of its comments.
+  uploadAttachment(tid: String!, cid: String, file: Upload!): Attachment
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
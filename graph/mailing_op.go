@@ -0,0 +1,210 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+// Senders allowed when the circle has no mailing setting.
+var defaultMailingSenders = []model.MailingSender{model.MailingSenderMember, model.MailingSenderGuest}
+
+// GetMailingTarget returns the circle addressed by the local part of a mailing
+// address (its alias, or its nameid with "#" written "/") and its mailing
+// setting, if any.
+func GetMailingTarget(local string) (string, *model.MailingSetting, error) {
+	local = strings.ToLower(local)
+	if !mailingLocalReg.MatchString(local) {
+		return "", nil, fmt.Errorf("invalid recipient address.")
+	}
+
+	receiverid := strings.Replace(local, "/", "#", -1)
+	setting, err := db.GetDB().GetMailingSetting("alias", local)
+	if err != nil {
+		return "", nil, err
+	}
+	if setting != nil {
+		receiverid = setting.Nameid
+	} else if setting, err = db.GetDB().GetMailingSetting("nameid", receiverid); err != nil {
+		return "", nil, err
+	}
+
+	filter := `eq(Node.isArchived, false)`
+	if ex, _ := db.GetDB().Exists("Node.nameid", receiverid, &filter); !ex {
+		return "", nil, fmt.Errorf("NAMEID NOT FOUND")
+	}
+	if setting != nil && !setting.Enabled {
+		return "", nil, fmt.Errorf("This circle does not receive emails.")
+	}
+	return receiverid, setting, nil
+}
+
+// CreateMailingTension creates the tension of an email sent to a circle, where
+// uctx is the sender, or nil if the sender has no account.
+// The members and the guests allowed by the mailing setting post as themselves,
+// with the same checks as addTensionHook. The external senders allowed post a
+// tension waiting for moderation (Pending status), on behalf of the coordinator
+// who set up the mailing.
+// It returns the tension id and its author.
+func CreateMailingTension(uctx *model.UserCtx, from, receiverid string, setting *model.MailingSetting, title, message string) (string, *model.UserCtx, error) {
+	rootnameid, err := codec.Nid2rootid(receiverid)
+	if err != nil {
+		return "", nil, err
+	}
+	senders := defaultMailingSenders
+	if setting != nil && len(setting.Senders) > 0 {
+		senders = setting.Senders
+	}
+	allowed := func(s model.MailingSender) bool {
+		for _, x := range senders {
+			if x == s {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Members and guests
+	if uctx != nil {
+		if auth.UserIsGuest(uctx, rootnameid) >= 0 {
+			if allowed(model.MailingSenderGuest) {
+				tid, err := addMailingTension(uctx, receiverid, setting, title, message, false)
+				return tid, uctx, err
+			}
+		} else if auth.UserIsMember(uctx, rootnameid) >= 0 && allowed(model.MailingSenderMember) {
+			tid, err := addMailingTension(uctx, receiverid, setting, title, message, false)
+			return tid, uctx, err
+		}
+	}
+
+	// External senders
+	if allowed(model.MailingSenderAnyone) || allowed(model.MailingSenderAllowlist) && inAllowlist(setting.Allowlist, from) {
+		author, err := db.GetDB().GetUctx("username", setting.CreatedBy.Username)
+		if err != nil {
+			return "", nil, err
+		}
+		message = fmt.Sprintf("*Sent by %s, waiting for moderation.*\n\n%s", from, message)
+		tid, err := addMailingTension(author, receiverid, setting, title, message, true)
+		if err != nil {
+			return "", nil, err
+		}
+
+		// Notify the coordinators
+		coordos, err := auth.GetCoordosFromTid(tid)
+		if err != nil {
+			return tid, author, err
+		}
+		var to []string
+		for _, u := range coordos {
+			to = append(to, u.Username)
+		}
+		msg := fmt.Sprintf("A tension sent by email from %s is waiting for moderation.", from)
+		PublishNotifEvent(model.NotifNotif{Uctx: author, Tid: &tid, Msg: msg, To: to})
+		return tid, author, nil
+	}
+
+	return "", nil, LogErr("Access denied", fmt.Errorf("You are not allowed to post in this circle by email."))
+}
+
+// addMailingTension adds the tension with the default type and labels of the
+// mailing setting. Pending tensions are not checked nor notified.
+func addMailingTension(uctx *model.UserCtx, receiverid string, setting *model.MailingSetting, title, message string, pending bool) (string, error) {
+	rootnameid, _ := codec.Nid2rootid(receiverid)
+	now := Now()
+	createdBy := model.UserRef{Username: &uctx.Username}
+	emitterid := codec.MemberIdCodec(rootnameid, uctx.Username)
+	evtCreated := model.TensionEventCreated
+	history := []*model.EventRef{
+		&model.EventRef{CreatedAt: &now, CreatedBy: &createdBy, EventType: &evtCreated},
+	}
+
+	input := model.AddTensionInput{
+		CreatedAt:   now,
+		CreatedBy:   &createdBy,
+		Title:       title,
+		Type:        model.TensionTypeOperational,
+		Status:      model.TensionStatusOpen,
+		Emitterid:   emitterid,
+		Emitter:     &model.NodeRef{Nameid: &emitterid},
+		Receiverid:  receiverid,
+		Receiver:    &model.NodeRef{Nameid: &receiverid},
+		Comments:    []*model.CommentRef{&model.CommentRef{CreatedAt: &now, CreatedBy: &createdBy, Message: &message}},
+		Subscribers: []*model.UserRef{&createdBy},
+	}
+	if setting != nil {
+		if setting.Type != nil {
+			input.Type = *setting.Type
+		}
+		for _, l := range setting.Labels {
+			id := l.ID
+			input.Labels = append(input.Labels, &model.LabelRef{ID: &id})
+			color := ""
+			if l.Color != nil {
+				color = *l.Color
+			}
+			eventType := model.TensionEventLabelAdded
+			new := l.Name + "§" + color
+			history = append(history, &model.EventRef{CreatedAt: &now, CreatedBy: &createdBy, EventType: &eventType, New: &new})
+		}
+	}
+
+	if pending {
+		input.Status = model.TensionStatusPending
+		tid, err := db.GetDB().Add(db.GetDB().GetRootUctx(), "tension", input)
+		if err != nil {
+			return "", err
+		}
+		return tid, PushHistory(&model.EventNotif{Uctx: uctx, Tid: tid, History: history})
+	}
+
+	// Add the tension with the author rights
+	tid, err := db.GetDB().Add(*uctx, "tension", input)
+	if err != nil {
+		return "", err
+	}
+	ok, err := ProcessNewTension(uctx, tid, &input, history)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", LogErr("Access denied", fmt.Errorf("You are not allowed to create tensions in this circle."))
+	}
+	return tid, nil
+}
+
+// inAllowlist returns true if the address, or its domain, is in the allowlist.
+func inAllowlist(allowlist []string, address string) bool {
+	address = strings.ToLower(address)
+	for _, a := range allowlist {
+		a = strings.ToLower(a)
+		if a == address || strings.HasPrefix(a, "@") && strings.HasSuffix(address, a) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+////////////////////////////////////////////////
+// MailingSetting Resolver
+////////////////////////////////////////////////
+
+// Local part of the mailing addresses (aliases and nameids with "/" instead of "#").
+var mailingLocalReg = regexp.MustCompile(`^[a-z0-9][a-z0-9._/@-]*$`)
+
+// Add MailingSetting - Must be coordo of the circle
+func addMailingSettingHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+	if !PayloadContains(ctx, "id") {
+		return nil, LogErr("field missing", fmt.Errorf("id field is required in mailingSetting payload"))
+	}
+
+	// Validate input
+	var inputs []model.AddMailingSettingInput
+	ExtractInputs(ctx, &inputs)
+	for _, input := range inputs {
+		if input.CreatedBy == nil || input.CreatedBy.Username == nil || *input.CreatedBy.Username != uctx.Username {
+			return nil, LogErr("Access denied", fmt.Errorf("createdBy must be the current user."))
+		}
+		rootnameid, err := codec.Nid2rootid(input.Nameid)
+		if err != nil {
+			return nil, LogErr("Value error", err)
+		}
+		if rootnameid != input.Rootnameid {
+			return nil, LogErr("Access denied", fmt.Errorf("rootnameid and nameid does not match."))
+		}
+		if codec.IsRole(input.Nameid) {
+			return nil, LogErr("Value error", fmt.Errorf("Only circles can receive emails."))
+		}
+		err = checkMailingSetting(uctx, input.Nameid, input.Alias, input.Allowlist, input.Labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// Update MailingSetting - Must be coordo of the circle
+func updateMailingSettingHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var input model.UpdateMailingSettingInput
+	ExtractInput(ctx, &input)
+	if input.Filter == nil || len(input.Filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	set := input.Set
+	if set == nil {
+		set = &model.MailingSettingPatch{}
+	}
+	if set.CreatedBy != nil || set.CreatedAt != nil || set.Rootnameid != nil {
+		return nil, LogErr("Access denied", fmt.Errorf("createdBy, createdAt and rootnameid cannot be changed."))
+	}
+	for _, id := range input.Filter.ID {
+		nameid, err := db.GetDB().GetFieldById(id, "MailingSetting.nameid")
+		if err != nil || nameid == nil {
+			return nil, LogErr("Access denied", fmt.Errorf("mailing setting not found."))
+		}
+		err = checkMailingSetting(uctx, nameid.(string), set.Alias, set.Allowlist, set.Labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// Delete MailingSetting - Must be coordo of the circle
+func deleteMailingSettingHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var filter model.MailingSettingFilter
+	ExtractFilter(ctx, &filter)
+	if len(filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	for _, id := range filter.ID {
+		nameid, err := db.GetDB().GetFieldById(id, "MailingSetting.nameid")
+		if err != nil || nameid == nil {
+			return nil, LogErr("Access denied", fmt.Errorf("mailing setting not found."))
+		}
+		if err = checkMailingSetting(uctx, nameid.(string), nil, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// checkMailingSetting checks that the user can manage the mailing of the circle
+// and that the given values are valid.
+func checkMailingSetting(uctx *model.UserCtx, nameid string, alias *string, allowlist []string, labels []*model.LabelRef) error {
+	ok, err := auth.HasCoordoAuth(uctx, nameid, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return LogErr("Access denied", fmt.Errorf("Only the coordinators of the circle can manage its mailing settings."))
+	}
+
+	// The alias must be unique, and must not shadow a circle address.
+	if alias != nil && *alias != "" {
+		a := strings.ToLower(*alias)
+		if !mailingLocalReg.MatchString(a) || strings.ContainsAny(a, "/@") {
+			return LogErr("Value error", fmt.Errorf("Invalid alias, use lower case letters, digits, dots, dashes and underscores."))
+		}
+		if ex, err := db.GetDB().Exists("Node.nameid", a, nil); err != nil {
+			return err
+		} else if ex {
+			return LogErr("Value error", fmt.Errorf("This alias is already used."))
+		}
+		x, err := db.GetDB().GetFieldByEq("MailingSetting.alias", a, "MailingSetting.nameid")
+		if err != nil {
+			return err
+		}
+		if x != nil && x.(string) != nameid {
+			return LogErr("Value error", fmt.Errorf("This alias is already used."))
+		}
+	}
+
+	for _, s := range allowlist {
+		if strings.HasPrefix(s, "@") && len(s) > 1 && !strings.Contains(s[1:], "@") {
+			continue
+		}
+		if _, err := mail.ParseAddress(s); err != nil {
+			return LogErr("Value error", fmt.Errorf("Invalid allowlist entry %s, use an email address or a domain (@example.com).", s))
+		}
+	}
+
+	// The labels must belong to the organisation.
+	rootnameid, _ := codec.Nid2rootid(nameid)
+	for _, l := range labels {
+		if l.ID == nil {
+			return LogErr("Value error", fmt.Errorf("Labels must be given by id."))
+		}
+		rid, err := db.GetDB().GetFieldById(*l.ID, "Label.rootnameid")
+		if err != nil {
+			return err
+		}
+		if rid == nil || rid.(string) != rootnameid {
+			return LogErr("Access denied", fmt.Errorf("The labels must belong to the organisation."))
+		}
+	}
+
+	return nil
+}
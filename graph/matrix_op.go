@@ -0,0 +1,344 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+	"fractale/fractal6.go/web/email"
+)
+
+/*
+ *
+ * Matrix bridge: the new tensions, comments and contracts of a circle are
+ * posted in its Matrix room (see MatrixRoom), each tension in its own thread.
+ * The replies in a thread are added as comments of the tension, if the sender
+ * has linked its Matrix id to its account (see auth.NewMatrixLinkToken).
+ *
+ */
+
+var matrixClient *MatrixClient
+var matrixUserId string
+
+// Max length of the messages posted in the rooms.
+const matrixMaxMsg = 1000
+
+func init() {
+	if homeserver := viper.GetString("matrix.homeserver"); homeserver != "" {
+		matrixClient = NewMatrixClient(homeserver, viper.GetString("matrix.access_token"))
+		matrixUserId = viper.GetString("matrix.user_id")
+	}
+}
+
+// matrixThreadKey maps a thread root event of a room to its tension,
+// and matrixTensionKey maps a tension to its thread root event in a room.
+func matrixThreadKey(roomid, eventid string) string {
+	return fmt.Sprintf("matrix:thread:%s:%s", roomid, eventid)
+}
+func matrixTensionKey(roomid, tid string) string {
+	return fmt.Sprintf("matrix:tension:%s:%s", roomid, tid)
+}
+
+// matrixRoomFor returns the Matrix room where the tension is posted, if any,
+// and the tension status. Confidential and pending tensions are never posted.
+func matrixRoomFor(tid, receiverid string) (string, model.TensionStatus, error) {
+	if matrixClient == nil {
+		return "", "", nil
+	}
+	x, err := db.GetDB().GetFieldByEq("MatrixRoom.nameid", receiverid, "MatrixRoom.roomid")
+	if err != nil || x == nil {
+		return "", "", err
+	}
+	if readers, err := db.GetDB().GetConfidentialReaders(tid); err != nil || len(readers) > 0 {
+		return "", "", err
+	}
+	s, err := db.GetDB().GetFieldById(tid, "Tension.status")
+	if err != nil || s == nil {
+		return "", "", err
+	}
+	status := model.TensionStatus(s.(string))
+	if status == model.TensionStatusPending {
+		return "", status, nil
+	}
+	return x.(string), status, nil
+}
+
+// postMatrix posts a message in the thread of the tension, the first message
+// of a tension starts its thread.
+func postMatrix(roomid, tid, body, htmlBody string) error {
+	root, err := cache.Get(ctx, matrixTensionKey(roomid, tid)).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	eid, err := matrixClient.SendMessage(roomid, NewMatrixText(body, htmlBody, root))
+	if err != nil || root != "" {
+		return err
+	}
+	_, err = cache.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, matrixTensionKey(roomid, tid), eid, 0)
+		pipe.Set(ctx, matrixThreadKey(roomid, eid), tid, 0)
+		return nil
+	})
+	return err
+}
+
+func matrixTruncate(msg string) string {
+	msg = strings.TrimSpace(msg)
+	if r := []rune(msg); len(r) > matrixMaxMsg {
+		return string(r[:matrixMaxMsg]) + "…"
+	}
+	return msg
+}
+
+// PushMatrixEvent posts the created tensions and the new comments in the
+// Matrix room of the receiver circle.
+// The notif data (receiverid, title, msg) must be set.
+func PushMatrixEvent(notif model.EventNotif) error {
+	created := notif.HasEvent(model.TensionEventCreated)
+	if !created && !notif.HasEvent(model.TensionEventCommentPushed) || notif.Source == "matrix" {
+		return nil
+	}
+	roomid, status, err := matrixRoomFor(notif.Tid, notif.Receiverid)
+	if err != nil || roomid == "" {
+		return err
+	}
+
+	url := fmt.Sprintf("https://"+email.DOMAIN+"/tension/%s/%s", notif.Rootnameid, notif.Tid)
+	msg := matrixTruncate(notif.Msg)
+	var body, htmlBody string
+	if created {
+		// Roles and circles are created with a closed tension
+		if status == model.TensionStatusClosed {
+			return nil
+		}
+		body = fmt.Sprintf("New tension by %s: %s\n%s\n\n%s", notif.Uctx.Username, notif.Title, url, msg)
+		htmlBody = fmt.Sprintf(`New tension by <b>%s</b>: <a href="%s">%s</a><br><br>%s`,
+			html.EscapeString(notif.Uctx.Username), url, html.EscapeString(notif.Title),
+			strings.ReplaceAll(html.EscapeString(msg), "\n", "<br>"))
+	} else {
+		if msg == "" {
+			return nil
+		}
+		body = fmt.Sprintf("%s: %s", notif.Uctx.Username, msg)
+		htmlBody = fmt.Sprintf("<b>%s</b>: %s", html.EscapeString(notif.Uctx.Username),
+			strings.ReplaceAll(html.EscapeString(msg), "\n", "<br>"))
+	}
+
+	return postMatrix(roomid, notif.Tid, body, htmlBody)
+}
+
+// PushMatrixContract posts the opened and closed contracts in the thread of
+// their tension, in the Matrix room of the receiver circle.
+// The notif data (rootnameid, receiverid) must be set.
+func PushMatrixContract(notif model.ContractNotif) error {
+	var action string
+	switch notif.ContractEvent {
+	case model.NewContract:
+		action = "opened"
+	case model.CloseContract:
+		action = "closed"
+	default:
+		return nil
+	}
+	roomid, _, err := matrixRoomFor(notif.Tid, notif.Receiverid)
+	if err != nil || roomid == "" {
+		return err
+	}
+
+	var title string
+	if x, err := db.GetDB().GetFieldById(notif.Tid, "Tension.title"); err != nil {
+		return err
+	} else if x != nil {
+		title = x.(string)
+	}
+	url := fmt.Sprintf("https://"+email.DOMAIN+"/tension/%s/%s/contract/%s", notif.Rootnameid, notif.Tid, notif.Contract.ID)
+	event := string(notif.Contract.Event.EventType)
+	body := fmt.Sprintf("Contract %s (%s) on %s\n%s", action, event, title, url)
+	htmlBody := fmt.Sprintf(`<a href="%s">Contract %s</a> (%s) on <b>%s</b>`, url, action, event, html.EscapeString(title))
+
+	return postMatrix(roomid, notif.Tid, body, htmlBody)
+}
+
+// RunMatrixBridge syncs the Matrix rooms until done: it joins the rooms it is
+// invited in if they are connected to a circle, and processes their messages.
+func RunMatrixBridge(done context.Context) error {
+	if matrixClient == nil {
+		return fmt.Errorf("matrix homeserver not configured.")
+	}
+
+	// Skip the room history on the first start
+	since, err := cache.Get(ctx, "matrix:since").Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	if since == "" {
+		res, err := matrixClient.Sync("", 0)
+		if err != nil {
+			return err
+		}
+		since = res.NextBatch
+	}
+
+	for done.Err() == nil {
+		res, err := matrixClient.Sync(since, 30*time.Second)
+		if err != nil {
+			log.Printf("Matrix sync error: %v", err)
+			select {
+			case <-done.Done():
+			case <-time.After(10 * time.Second):
+			}
+			continue
+		}
+
+		for roomid := range res.Rooms.Invite {
+			if !IsMatrixRoomId(roomid) {
+				continue
+			}
+			if ex, err := db.GetDB().Exists("MatrixRoom.roomid", roomid, nil); err != nil || !ex {
+				continue
+			}
+			if err := matrixClient.JoinRoom(roomid); err != nil {
+				log.Printf("Matrix join error for %s: %v", roomid, err)
+			}
+		}
+		for roomid, room := range res.Rooms.Join {
+			for _, e := range room.Timeline.Events {
+				if err := ReceiveMatrixEvent(roomid, e); err != nil {
+					log.Printf("Matrix event error for %s: %v", e.EventId, err)
+				}
+			}
+		}
+
+		since = res.NextBatch
+		if err := cache.Set(ctx, "matrix:since", since, 0).Err(); err != nil {
+			log.Printf("Redis error: %v", err)
+		}
+	}
+	return nil
+}
+
+// ReceiveMatrixEvent processes a message posted in a Matrix room:
+// `!link <token>` links the Matrix id of the sender to its account, and
+// the replies in the thread of a tension are added as comments.
+func ReceiveMatrixEvent(roomid string, e MatrixEvent) error {
+	if e.Sender == matrixUserId || e.Type != "m.room.message" || e.Content.Msgtype != "m.text" {
+		return nil
+	}
+	if !IsMatrixUserId(e.Sender) {
+		return fmt.Errorf("invalid matrix sender %s", e.Sender)
+	}
+	body := stripMatrixReply(e.Content.Body)
+
+	// Link the Matrix id
+	if strings.HasPrefix(body, "!link ") {
+		var reply string
+		username, err := auth.ParseMatrixLinkToken(strings.TrimSpace(body[len("!link "):]), e.Sender)
+		if err != nil {
+			reply = "Invalid link token, copy the command from your Fractale settings."
+		} else if err = db.GetDB().SetUserMatrixid(username, e.Sender); err != nil {
+			return err
+		} else {
+			reply = fmt.Sprintf("%s is now linked to the Fractale account %s.", e.Sender, username)
+		}
+		_, err = matrixClient.SendMessage(roomid, NewMatrixText(reply, "", e.ThreadRoot()))
+		return err
+	}
+
+	// Get the tension of the thread
+	root := e.ThreadRoot()
+	if root == "" || body == "" {
+		return nil
+	}
+	tid, err := cache.Get(ctx, matrixThreadKey(roomid, root)).Result()
+	if err == redis.Nil {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// Get author (Matrix users not linked are ignored)
+	x, err := db.GetDB().GetFieldByEq("User.matrixid", e.Sender, "User.username")
+	if err != nil || x == nil {
+		return err
+	}
+	uctx, err := db.GetDB().GetUctx("username", x.(string))
+	if err != nil {
+		return err
+	}
+
+	// Check event
+	createdAt := Now()
+	createdBy := model.UserRef{Username: &uctx.Username}
+	eventType := model.TensionEventCommentPushed
+	history := []*model.EventRef{&model.EventRef{
+		CreatedAt: &createdAt,
+		CreatedBy: &createdBy,
+		EventType: &eventType,
+	}}
+	ok, _, err := TensionEventHook(uctx, tid, history, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return LogErr("Access denied", fmt.Errorf("%s cannot comment the tension %s.", uctx.Username, tid))
+	}
+
+	// Add the comment
+	err = db.GetDB().Update(db.GetDB().GetRootUctx(), "tension", model.UpdateTensionInput{
+		Filter: &model.TensionFilter{ID: []string{tid}},
+		Set: &model.TensionPatch{
+			Comments: []*model.CommentRef{&model.CommentRef{
+				CreatedAt: &createdAt,
+				CreatedBy: &createdBy,
+				Message:   &body,
+			}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Push notification (not to the Matrix room)
+	return PushEventNotifications(model.EventNotif{Uctx: uctx, Tid: tid, History: history, Source: "matrix"})
+}
+
+// stripMatrixReply removes the reply fallback (the quoted message) of a
+// Matrix message body.
+func stripMatrixReply(body string) string {
+	lines := strings.Split(body, "\n")
+	i := 0
+	for i < len(lines) && strings.HasPrefix(lines[i], ">") {
+		i++
+	}
+	return strings.TrimSpace(strings.Join(lines[i:], "\n"))
+}
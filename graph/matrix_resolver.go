@@ -0,0 +1,153 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+////////////////////////////////////////////////
+// MatrixRoom Resolver
+////////////////////////////////////////////////
+
+// Add MatrixRoom - Must be coordo of the circle
+func addMatrixRoomHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+	if !PayloadContains(ctx, "id") {
+		return nil, LogErr("field missing", fmt.Errorf("id field is required in matrixRoom payload"))
+	}
+
+	// Validate input
+	var inputs []model.AddMatrixRoomInput
+	ExtractInputs(ctx, &inputs)
+	for _, input := range inputs {
+		if input.CreatedBy == nil || input.CreatedBy.Username == nil || *input.CreatedBy.Username != uctx.Username {
+			return nil, LogErr("Access denied", fmt.Errorf("createdBy must be the current user."))
+		}
+		rootnameid, err := codec.Nid2rootid(input.Nameid)
+		if err != nil {
+			return nil, LogErr("Value error", err)
+		}
+		if rootnameid != input.Rootnameid {
+			return nil, LogErr("Access denied", fmt.Errorf("rootnameid and nameid does not match."))
+		}
+		if codec.IsRole(input.Nameid) {
+			return nil, LogErr("Value error", fmt.Errorf("Only circles can be connected to a Matrix room."))
+		}
+		if err = checkMatrixRoom(uctx, input.Nameid, &input.Roomid); err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// Update MatrixRoom - Must be coordo of the circle
+func updateMatrixRoomHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var input model.UpdateMatrixRoomInput
+	ExtractInput(ctx, &input)
+	if input.Filter == nil || len(input.Filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	if input.Remove != nil && input.Remove.Roomid != nil {
+		return nil, LogErr("Value error", fmt.Errorf("roomid cannot be removed, delete the matrix room instead."))
+	}
+	var roomid *string
+	if input.Set != nil {
+		roomid = input.Set.Roomid
+	}
+	for _, id := range input.Filter.ID {
+		if err := checkMatrixRoomById(uctx, id, roomid); err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// Delete MatrixRoom - Must be coordo of the circle
+func deleteMatrixRoomHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var filter model.MatrixRoomFilter
+	ExtractFilter(ctx, &filter)
+	if len(filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	for _, id := range filter.ID {
+		if err := checkMatrixRoomById(uctx, id, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+func checkMatrixRoomById(uctx *model.UserCtx, id string, roomid *string) error {
+	nameid, err := db.GetDB().GetFieldById(id, "MatrixRoom.nameid")
+	if err != nil || nameid == nil {
+		return LogErr("Access denied", fmt.Errorf("matrix room not found."))
+	}
+	return checkMatrixRoom(uctx, nameid.(string), roomid)
+}
+
+// checkMatrixRoom checks that the user can manage the Matrix room of the circle
+// and that the given room id is valid.
+func checkMatrixRoom(uctx *model.UserCtx, nameid string, roomid *string) error {
+	ok, err := auth.HasCoordoAuth(uctx, nameid, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return LogErr("Access denied", fmt.Errorf("Only the coordinators of the circle can manage its Matrix room."))
+	}
+	if roomid != nil && !IsMatrixRoomId(*roomid) {
+		return LogErr("Value error", fmt.Errorf("Invalid Matrix room id, it should look like !abcdef:matrix.org."))
+	}
+	return nil
+}
@@ -72,6 +72,34 @@ type OrgaForm struct {
 	Visibility *NodeVisibility `json:"visibility"`
 }
 
+// BulkTensionForm are data sink/form for applying one change to a set of tensions.
+// Value is a label id, a username, a circle nameid or a project column id,
+// depending on the action.
+type BulkTensionForm struct {
+	Tids   []string          `json:"tids"`
+	Action BulkTensionAction `json:"action"`
+	Value  *string           `json:"value"`
+}
+
+type BulkTensionAction string
+
+const (
+	BulkTensionActionClose        BulkTensionAction = "close"
+	BulkTensionActionReopen       BulkTensionAction = "reopen"
+	BulkTensionActionAddLabel     BulkTensionAction = "add_label"
+	BulkTensionActionRemoveLabel  BulkTensionAction = "remove_label"
+	BulkTensionActionAssign       BulkTensionAction = "assign"
+	BulkTensionActionMove         BulkTensionAction = "move"
+	BulkTensionActionAddToProject BulkTensionAction = "add_to_project"
+)
+
+// BulkTensionResult is the result of a bulk operation for one tension.
+type BulkTensionResult struct {
+	Tid   string `json:"tid"`
+	Ok    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
 //
 // Data Patch
 //
@@ -72,37 +72,109 @@ const (
 	ReasonIsAnnouncement
 )
 
-func (n NotifReason) ToText() string {
+// ToKey returns the reason identifier used in the email templates.
+func (n NotifReason) ToKey() string {
 	switch n {
 	case ReasonIsInvited:
-		return `you are invited to join an organisation on <a href="https://fractale.co">Fractale</a>`
+		return "invited"
 	case ReasonIsLinkCandidate:
-		return "you are invited to play a role"
+		return "link_candidate"
 	case ReasonIsCandidate:
-		return "you are candidate"
+		return "candidate"
 	case ReasonIsParticipant:
-		return "you voted to this contract"
+		return "participant"
 	case ReasonIsCoordo:
-		return "you are coordinator in this circle"
+		return "coordo"
 	case ReasonIsPeer:
-		return "you have role in this circle"
+		return "peer"
 	case ReasonIsFirstLink:
-		return "you are lead link of this role"
+		return "first_link"
 	case ReasonIsAssignee:
-		return "you are assigned to this tension"
+		return "assignee"
 	case ReasonIsSubscriber:
-		return "you are subscribed to this tension"
+		return "subscriber"
 	case ReasonIsMentionned:
-		return "you have been mentionned"
+		return "mentioned"
 	case ReasonIsAlert:
-		return "you are a member of this organisation"
+		return "alert"
 	case ReasonIsAnnouncement:
-		return "you are watching this organisation"
+		return "announcement"
 	default:
-		return "unknown reason"
+		return "unknown"
 	}
 }
 
+// ToPreference returns the reason used in the notification preferences.
+func (n NotifReason) ToPreference() *NotifPreferenceReason {
+	var r NotifPreferenceReason
+	switch n {
+	case ReasonIsInvited:
+		r = NotifPreferenceReasonInvited
+	case ReasonIsLinkCandidate:
+		r = NotifPreferenceReasonLinkCandidate
+	case ReasonIsCandidate:
+		r = NotifPreferenceReasonCandidate
+	case ReasonIsParticipant:
+		r = NotifPreferenceReasonParticipant
+	case ReasonIsCoordo:
+		r = NotifPreferenceReasonCoordo
+	case ReasonIsPeer:
+		r = NotifPreferenceReasonPeer
+	case ReasonIsFirstLink:
+		r = NotifPreferenceReasonFirstLink
+	case ReasonIsAssignee:
+		r = NotifPreferenceReasonAssignee
+	case ReasonIsSubscriber:
+		r = NotifPreferenceReasonSubscriber
+	case ReasonIsMentionned:
+		r = NotifPreferenceReasonMentioned
+	case ReasonIsAlert:
+		r = NotifPreferenceReasonAlert
+	case ReasonIsAnnouncement:
+		r = NotifPreferenceReasonAnnouncement
+	default:
+		return nil
+	}
+	return &r
+}
+
+// GetNotifChannel returns the channel of the most specific preference matching
+// the organisation, the reason and the event group of a notification, or nil
+// if no preference match.
+// Organisation overrides take precedence over reasons, which take precedence
+// over event groups.
+func GetNotifChannel(prefs []*NotifPreference, rootnameid string, reason NotifReason, group NotifEventGroup) *NotifChannel {
+	var channel *NotifChannel
+	var best int = -1
+	r := reason.ToPreference()
+	for _, p := range prefs {
+		var score int
+		if p.Rootnameid != nil {
+			if *p.Rootnameid != rootnameid {
+				continue
+			}
+			score += 4
+		}
+		if p.Reason != nil {
+			if r == nil || *p.Reason != *r {
+				continue
+			}
+			score += 2
+		}
+		if p.EventGroup != nil {
+			if *p.EventGroup != group {
+				continue
+			}
+			score += 1
+		}
+		if score > best {
+			best = score
+			channel = &p.Channel
+		}
+	}
+	return channel
+}
+
 // Info about user to notify when pushing notification
 type UserNotifInfo struct {
 	User      User
@@ -130,6 +202,23 @@ type EventNotif struct {
 	Receiverid string `json:"receiverid"`
 	Title      string `json:"title"`
 	Msg        string `json:"msg"`
+	// Bridge the event comes from, if any (e.g. "matrix"), not to echo it.
+	Source string `json:"source"`
+}
+
+// BulkEventNotif groups the event notifications of a bulk tension
+// operation, in order to send one email per recipient for the whole batch.
+type BulkEventNotif struct {
+	Uctx   *UserCtx     `json:"uctx"`
+	Notifs []EventNotif `json:"notifs"`
+}
+
+// DigestNotif is an event notification queued for a digest email
+// (see User.emailDelivery).
+type DigestNotif struct {
+	Notif  EventNotif  `json:"notif"`
+	Eid    string      `json:"eid"`
+	Reason NotifReason `json:"reason"`
 }
 
 type ContractNotif struct {
@@ -251,6 +340,30 @@ func (notif EventNotif) IsNotifiable(ui UserNotifInfo) bool {
 	return ok
 }
 
+// EventGroup returns the event group of the notification used
+// in the notification preferences.
+func (notif EventNotif) EventGroup() NotifEventGroup {
+	switch {
+	case notif.HasEvent(TensionEventCreated):
+		return NotifEventGroupCreation
+	case notif.HasEvent(TensionEventClosed), notif.HasEvent(TensionEventReopened):
+		return NotifEventGroupStatus
+	case notif.HasEvent(TensionEventBlobPushed),
+		notif.HasEvent(TensionEventBlobArchived),
+		notif.HasEvent(TensionEventBlobUnarchived):
+		return NotifEventGroupMandate
+	case notif.HasEvent(TensionEventUserJoined),
+		notif.HasEvent(TensionEventUserLeft),
+		notif.HasEvent(TensionEventMemberLinked),
+		notif.HasEvent(TensionEventMemberUnlinked):
+		return NotifEventGroupMembership
+	case notif.HasEvent(TensionEventCommentPushed):
+		return NotifEventGroupComment
+	default:
+		return NotifEventGroupOther
+	}
+}
+
 func (notif EventNotif) HasEvent(ev TensionEvent) bool {
 	for _, e := range notif.History {
 		if ev == *e.EventType {
@@ -315,23 +428,6 @@ func (notif ContractNotif) IsEmailable(ui UserNotifInfo) bool {
 // TensionEvent methods
 //
 
-func (e TensionEvent) ToContractText() (t string) {
-	switch e {
-	case TensionEventUserJoined:
-		t = "Invitation"
-	case TensionEventMemberLinked:
-		t = "Lead link invitation"
-	case TensionEventMoved:
-		t = "Move tension"
-	case TensionEventMemberUnlinked:
-		t = "Retired first-link"
-	default:
-		// Humanize (@debug: cannot import tools because of cycle error.)
-		t = string(e)
-	}
-	return
-}
-
 func (e TensionEvent) ToContractReason() (r NotifReason) {
 	switch e {
 	case TensionEventUserJoined:
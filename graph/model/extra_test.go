@@ -89,3 +89,43 @@ func TestUserCredsMap(t *testing.T) {
 		}
 	}
 }
+
+func TestGetNotifChannel(t *testing.T) {
+	orga := "orga"
+	other := "other"
+	subscriber := NotifPreferenceReasonSubscriber
+	comment := NotifEventGroupComment
+	prefs := []*NotifPreference{
+		{Channel: NotifChannelEmail},
+		{EventGroup: &comment, Channel: NotifChannelApp},
+		{Reason: &subscriber, Channel: NotifChannelNone},
+		{Rootnameid: &other, Channel: NotifChannelApp},
+		{Rootnameid: &orga, Reason: &subscriber, EventGroup: &comment, Channel: NotifChannelEmail},
+	}
+
+	testcases := []struct {
+		rootnameid string
+		reason     NotifReason
+		group      NotifEventGroup
+		want       NotifChannel
+	}{
+		{"orga", ReasonIsAssignee, NotifEventGroupStatus, NotifChannelEmail},
+		{"orga", ReasonIsAssignee, NotifEventGroupComment, NotifChannelApp},
+		{"orga", ReasonIsSubscriber, NotifEventGroupStatus, NotifChannelNone},
+		{"orga", ReasonIsSubscriber, NotifEventGroupComment, NotifChannelEmail},
+		{"other", ReasonIsSubscriber, NotifEventGroupComment, NotifChannelApp},
+		{"", ReasonUnknown, NotifEventGroupNotice, NotifChannelEmail},
+	}
+
+	for _, test := range testcases {
+		got := GetNotifChannel(prefs, test.rootnameid, test.reason, test.group)
+		if got == nil || *got != test.want {
+			t.Errorf("For %s/%d/%s, want %s. Got %v",
+				test.rootnameid, test.reason, test.group, test.want, got)
+		}
+	}
+
+	if got := GetNotifChannel(nil, "orga", ReasonIsPeer, NotifEventGroupCreation); got != nil {
+		t.Errorf("Without preferences, want nil. Got %v", *got)
+	}
+}
@@ -16,6 +16,22 @@ type EventKind interface {
 	IsEventKind()
 }
 
+type AddAttachmentInput struct {
+	CreatedBy   *UserRef    `json:"createdBy"`
+	CreatedAt   string      `json:"createdAt"`
+	Tension     *TensionRef `json:"tension"`
+	Comment     *CommentRef `json:"comment,omitempty"`
+	Filename    string      `json:"filename"`
+	ContentType string      `json:"content_type"`
+	Size        int         `json:"size"`
+	Key         string      `json:"key"`
+}
+
+type AddAttachmentPayload struct {
+	Attachment []*Attachment `json:"attachment,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type AddBlobInput struct {
 	CreatedBy    *UserRef         `json:"createdBy"`
 	CreatedAt    string           `json:"createdAt"`
@@ -35,11 +51,12 @@ type AddBlobPayload struct {
 }
 
 type AddCommentInput struct {
-	CreatedBy *UserRef       `json:"createdBy"`
-	CreatedAt string         `json:"createdAt"`
-	UpdatedAt *string        `json:"updatedAt,omitempty"`
-	Message   *string        `json:"message,omitempty"`
-	Reactions []*ReactionRef `json:"reactions,omitempty"`
+	CreatedBy   *UserRef         `json:"createdBy"`
+	CreatedAt   string           `json:"createdAt"`
+	UpdatedAt   *string          `json:"updatedAt,omitempty"`
+	Message     *string          `json:"message,omitempty"`
+	Reactions   []*ReactionRef   `json:"reactions,omitempty"`
+	Attachments []*AttachmentRef `json:"attachments,omitempty"`
 }
 
 type AddCommentPayload struct {
@@ -123,6 +140,24 @@ type AddLabelPayload struct {
 	NumUids *int     `json:"numUids,omitempty"`
 }
 
+type AddMailingSettingInput struct {
+	CreatedBy  *UserRef        `json:"createdBy"`
+	CreatedAt  string          `json:"createdAt"`
+	Rootnameid string          `json:"rootnameid"`
+	Nameid     string          `json:"nameid"`
+	Enabled    bool            `json:"enabled"`
+	Alias      *string         `json:"alias,omitempty"`
+	Senders    []MailingSender `json:"senders,omitempty"`
+	Allowlist  []string        `json:"allowlist,omitempty"`
+	Type       *TensionType    `json:"type_,omitempty"`
+	Labels     []*LabelRef     `json:"labels,omitempty"`
+}
+
+type AddMailingSettingPayload struct {
+	MailingSetting []*MailingSetting `json:"mailingSetting,omitempty"`
+	NumUids        *int              `json:"numUids,omitempty"`
+}
+
 type AddMandateInput struct {
 	Purpose          string  `json:"purpose"`
 	Responsabilities *string `json:"responsabilities,omitempty"`
@@ -135,6 +170,19 @@ type AddMandatePayload struct {
 	NumUids *int       `json:"numUids,omitempty"`
 }
 
+type AddMatrixRoomInput struct {
+	CreatedBy  *UserRef `json:"createdBy"`
+	CreatedAt  string   `json:"createdAt"`
+	Rootnameid string   `json:"rootnameid"`
+	Nameid     string   `json:"nameid"`
+	Roomid     string   `json:"roomid"`
+}
+
+type AddMatrixRoomPayload struct {
+	MatrixRoom []*MatrixRoom `json:"matrixRoom,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type AddNodeFragmentInput struct {
 	Nameid     *string         `json:"nameid,omitempty"`
 	Name       *string         `json:"name,omitempty"`
@@ -156,39 +204,40 @@ type AddNodeFragmentPayload struct {
 }
 
 type AddNodeInput struct {
-	CreatedBy             *UserRef       `json:"createdBy"`
-	CreatedAt             string         `json:"createdAt"`
-	UpdatedAt             *string        `json:"updatedAt,omitempty"`
-	Nameid                string         `json:"nameid"`
-	Rootnameid            string         `json:"rootnameid"`
-	Source                *BlobRef       `json:"source,omitempty"`
-	Name                  string         `json:"name"`
-	About                 *string        `json:"about,omitempty"`
-	Skills                []string       `json:"skills,omitempty"`
-	IsRoot                bool           `json:"isRoot"`
-	Parent                *NodeRef       `json:"parent,omitempty"`
-	Type                  NodeType       `json:"type_"`
-	TensionsOut           []*TensionRef  `json:"tensions_out,omitempty"`
-	TensionsIn            []*TensionRef  `json:"tensions_in,omitempty"`
-	Visibility            NodeVisibility `json:"visibility"`
-	Mode                  NodeMode       `json:"mode"`
-	Rights                int            `json:"rights"`
-	IsArchived            bool           `json:"isArchived"`
-	IsPersonal            *bool          `json:"isPersonal,omitempty"`
-	UserCanJoin           *bool          `json:"userCanJoin,omitempty"`
-	GuestCanCreateTension *bool          `json:"guestCanCreateTension,omitempty"`
-	Watchers              []*UserRef     `json:"watchers,omitempty"`
-	Children              []*NodeRef     `json:"children,omitempty"`
-	Labels                []*LabelRef    `json:"labels,omitempty"`
-	Roles                 []*RoleExtRef  `json:"roles,omitempty"`
-	Projects              []*ProjectRef  `json:"projects,omitempty"`
-	Pinned                []*TensionRef  `json:"pinned,omitempty"`
-	RoleExt               *RoleExtRef    `json:"role_ext,omitempty"`
-	RoleType              *RoleType      `json:"role_type,omitempty"`
-	Color                 *string        `json:"color,omitempty"`
-	FirstLink             *UserRef       `json:"first_link,omitempty"`
-	Contracts             []*VoteRef     `json:"contracts,omitempty"`
-	EventsHistory         []*EventRef    `json:"events_history,omitempty"`
+	CreatedBy             *UserRef              `json:"createdBy"`
+	CreatedAt             string                `json:"createdAt"`
+	UpdatedAt             *string               `json:"updatedAt,omitempty"`
+	Nameid                string                `json:"nameid"`
+	Rootnameid            string                `json:"rootnameid"`
+	Source                *BlobRef              `json:"source,omitempty"`
+	Name                  string                `json:"name"`
+	About                 *string               `json:"about,omitempty"`
+	Skills                []string              `json:"skills,omitempty"`
+	IsRoot                bool                  `json:"isRoot"`
+	Parent                *NodeRef              `json:"parent,omitempty"`
+	Type                  NodeType              `json:"type_"`
+	TensionsOut           []*TensionRef         `json:"tensions_out,omitempty"`
+	TensionsIn            []*TensionRef         `json:"tensions_in,omitempty"`
+	Visibility            NodeVisibility        `json:"visibility"`
+	Mode                  NodeMode              `json:"mode"`
+	Rights                int                   `json:"rights"`
+	IsArchived            bool                  `json:"isArchived"`
+	IsPersonal            *bool                 `json:"isPersonal,omitempty"`
+	UserCanJoin           *bool                 `json:"userCanJoin,omitempty"`
+	GuestCanCreateTension *bool                 `json:"guestCanCreateTension,omitempty"`
+	Watchers              []*UserRef            `json:"watchers,omitempty"`
+	Children              []*NodeRef            `json:"children,omitempty"`
+	Labels                []*LabelRef           `json:"labels,omitempty"`
+	Roles                 []*RoleExtRef         `json:"roles,omitempty"`
+	Templates             []*TensionTemplateRef `json:"templates,omitempty"`
+	Projects              []*ProjectRef         `json:"projects,omitempty"`
+	Pinned                []*TensionRef         `json:"pinned,omitempty"`
+	RoleExt               *RoleExtRef           `json:"role_ext,omitempty"`
+	RoleType              *RoleType             `json:"role_type,omitempty"`
+	Color                 *string               `json:"color,omitempty"`
+	FirstLink             *UserRef              `json:"first_link,omitempty"`
+	Contracts             []*VoteRef            `json:"contracts,omitempty"`
+	EventsHistory         []*EventRef           `json:"events_history,omitempty"`
 }
 
 type AddNodePayload struct {
@@ -211,6 +260,20 @@ type AddNotifPayload struct {
 	NumUids *int     `json:"numUids,omitempty"`
 }
 
+type AddNotifPreferenceInput struct {
+	Preferenceid string                 `json:"preferenceid"`
+	User         *UserRef               `json:"user"`
+	Rootnameid   *string                `json:"rootnameid,omitempty"`
+	Reason       *NotifPreferenceReason `json:"reason,omitempty"`
+	EventGroup   *NotifEventGroup       `json:"event_group,omitempty"`
+	Channel      NotifChannel           `json:"channel"`
+}
+
+type AddNotifPreferencePayload struct {
+	NotifPreference []*NotifPreference `json:"notifPreference,omitempty"`
+	NumUids         *int               `json:"numUids,omitempty"`
+}
+
 type AddPendingUserInput struct {
 	UpdatedAt  *string        `json:"updatedAt,omitempty"`
 	Username   string         `json:"username"`
@@ -325,6 +388,26 @@ type AddReactionPayload struct {
 	NumUids  *int        `json:"numUids,omitempty"`
 }
 
+type AddRecurrenceInput struct {
+	CreatedBy  *UserRef            `json:"createdBy"`
+	CreatedAt  string              `json:"createdAt"`
+	Rootnameid string              `json:"rootnameid"`
+	Receiverid string              `json:"receiverid"`
+	Rrule      string              `json:"rrule"`
+	Dtstart    string              `json:"dtstart"`
+	Paused     *bool               `json:"paused,omitempty"`
+	Title      *string             `json:"title,omitempty"`
+	Template   *TensionTemplateRef `json:"template,omitempty"`
+	Tension    *TensionRef         `json:"tension,omitempty"`
+	NextAt     *string             `json:"next_at,omitempty"`
+	Count      *int                `json:"count,omitempty"`
+}
+
+type AddRecurrencePayload struct {
+	Recurrence []*Recurrence `json:"recurrence,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type AddRoleExtInput struct {
 	Rootnameid string      `json:"rootnameid"`
 	Name       string      `json:"name"`
@@ -354,7 +437,10 @@ type AddTensionInput struct {
 	Type            TensionType         `json:"type_"`
 	Status          TensionStatus       `json:"status"`
 	Action          *TensionAction      `json:"action,omitempty"`
+	DueDate         *string             `json:"dueDate,omitempty"`
+	Confidential    *bool               `json:"confidential,omitempty"`
 	Assignees       []*UserRef          `json:"assignees,omitempty"`
+	Participants    []*UserRef          `json:"participants,omitempty"`
 	Labels          []*LabelRef         `json:"labels,omitempty"`
 	Comments        []*CommentRef       `json:"comments,omitempty"`
 	Blobs           []*BlobRef          `json:"blobs,omitempty"`
@@ -363,6 +449,15 @@ type AddTensionInput struct {
 	Contracts       []*ContractRef      `json:"contracts,omitempty"`
 	Subscribers     []*UserRef          `json:"subscribers,omitempty"`
 	ProjectStatuses []*ProjectColumnRef `json:"project_statuses,omitempty"`
+	Template        *TensionTemplateRef `json:"template,omitempty"`
+	Blocks          []*TensionRef       `json:"blocks,omitempty"`
+	BlockedBy       []*TensionRef       `json:"blocked_by,omitempty"`
+	DuplicateOf     *TensionRef         `json:"duplicate_of,omitempty"`
+	Duplicates      []*TensionRef       `json:"duplicates,omitempty"`
+	Parent          *TensionRef         `json:"parent,omitempty"`
+	Subtasks        []*TensionRef       `json:"subtasks,omitempty"`
+	Related         []*TensionRef       `json:"related,omitempty"`
+	Attachments     []*AttachmentRef    `json:"attachments,omitempty"`
 	NComments       *int                `json:"n_comments,omitempty"`
 }
 
@@ -371,6 +466,40 @@ type AddTensionPayload struct {
 	NumUids *int       `json:"numUids,omitempty"`
 }
 
+type AddTensionTemplateInput struct {
+	Rootnameid string       `json:"rootnameid"`
+	Name       string       `json:"name"`
+	About      *string      `json:"about,omitempty"`
+	Title      *string      `json:"title,omitempty"`
+	Message    *string      `json:"message,omitempty"`
+	Type       *TensionType `json:"type_,omitempty"`
+	Labels     []*LabelRef  `json:"labels,omitempty"`
+	Assignees  []*UserRef   `json:"assignees,omitempty"`
+	Nodes      []*NodeRef   `json:"nodes,omitempty"`
+}
+
+type AddTensionTemplatePayload struct {
+	TensionTemplate []*TensionTemplate `json:"tensionTemplate,omitempty"`
+	NumUids         *int               `json:"numUids,omitempty"`
+}
+
+type AddTensionViewInput struct {
+	CreatedBy  *UserRef   `json:"createdBy"`
+	CreatedAt  string     `json:"createdAt"`
+	Rootnameid string     `json:"rootnameid"`
+	Nameid     *string    `json:"nameid,omitempty"`
+	Name       string     `json:"name"`
+	Query      string     `json:"query"`
+	Display    *string    `json:"display,omitempty"`
+	Watchers   []*UserRef `json:"watchers,omitempty"`
+	CheckedAt  *string    `json:"checked_at,omitempty"`
+}
+
+type AddTensionViewPayload struct {
+	TensionView []*TensionView `json:"tensionView,omitempty"`
+	NumUids     *int           `json:"numUids,omitempty"`
+}
+
 type AddUserEventInput struct {
 	CreatedAt string          `json:"createdAt"`
 	IsRead    bool            `json:"isRead"`
@@ -384,30 +513,34 @@ type AddUserEventPayload struct {
 }
 
 type AddUserInput struct {
-	CreatedAt        string          `json:"createdAt"`
-	LastAck          string          `json:"lastAck"`
-	Username         string          `json:"username"`
-	Name             *string         `json:"name,omitempty"`
-	Email            string          `json:"email"`
-	Password         string          `json:"password"`
-	Bio              *string         `json:"bio,omitempty"`
-	Location         *string         `json:"location,omitempty"`
-	Utc              *string         `json:"utc,omitempty"`
-	Links            []string        `json:"links,omitempty"`
-	Skills           []string        `json:"skills,omitempty"`
-	NotifyByEmail    bool            `json:"notifyByEmail"`
-	Lang             Lang            `json:"lang"`
-	Subscriptions    []*TensionRef   `json:"subscriptions,omitempty"`
-	Watching         []*NodeRef      `json:"watching,omitempty"`
-	Rights           *UserRightsRef  `json:"rights"`
-	Roles            []*NodeRef      `json:"roles,omitempty"`
-	TensionsCreated  []*TensionRef   `json:"tensions_created,omitempty"`
-	TensionsAssigned []*TensionRef   `json:"tensions_assigned,omitempty"`
-	Contracts        []*ContractRef  `json:"contracts,omitempty"`
-	Reactions        []*ReactionRef  `json:"reactions,omitempty"`
-	Events           []*UserEventRef `json:"events,omitempty"`
-	MarkAllAsRead    *string         `json:"markAllAsRead,omitempty"`
-	EventCount       *EventCountRef  `json:"event_count,omitempty"`
+	CreatedAt        string                `json:"createdAt"`
+	LastAck          string                `json:"lastAck"`
+	Username         string                `json:"username"`
+	Name             *string               `json:"name,omitempty"`
+	Email            string                `json:"email"`
+	Password         string                `json:"password"`
+	Bio              *string               `json:"bio,omitempty"`
+	Location         *string               `json:"location,omitempty"`
+	Utc              *string               `json:"utc,omitempty"`
+	Links            []string              `json:"links,omitempty"`
+	Skills           []string              `json:"skills,omitempty"`
+	NotifyByEmail    bool                  `json:"notifyByEmail"`
+	Lang             Lang                  `json:"lang"`
+	EmailDelivery    *EmailDelivery        `json:"emailDelivery,omitempty"`
+	EmailStatus      *EmailStatus          `json:"emailStatus,omitempty"`
+	Matrixid         *string               `json:"matrixid,omitempty"`
+	Subscriptions    []*TensionRef         `json:"subscriptions,omitempty"`
+	Watching         []*NodeRef            `json:"watching,omitempty"`
+	Rights           *UserRightsRef        `json:"rights"`
+	Roles            []*NodeRef            `json:"roles,omitempty"`
+	TensionsCreated  []*TensionRef         `json:"tensions_created,omitempty"`
+	TensionsAssigned []*TensionRef         `json:"tensions_assigned,omitempty"`
+	Contracts        []*ContractRef        `json:"contracts,omitempty"`
+	Reactions        []*ReactionRef        `json:"reactions,omitempty"`
+	Events           []*UserEventRef       `json:"events,omitempty"`
+	NotifPreferences []*NotifPreferenceRef `json:"notif_preferences,omitempty"`
+	MarkAllAsRead    *string               `json:"markAllAsRead,omitempty"`
+	EventCount       *EventCountRef        `json:"event_count,omitempty"`
 }
 
 type AddUserPayload struct {
@@ -445,6 +578,72 @@ type AddVotePayload struct {
 	NumUids *int    `json:"numUids,omitempty"`
 }
 
+type Attachment struct {
+	ID          string   `json:"id"`
+	CreatedBy   *User    `json:"createdBy"`
+	CreatedAt   string   `json:"createdAt"`
+	Tension     *Tension `json:"tension"`
+	Comment     *Comment `json:"comment,omitempty"`
+	Filename    string   `json:"filename"`
+	ContentType string   `json:"content_type"`
+	Size        int      `json:"size"`
+	Key         string   `json:"key"`
+}
+
+type AttachmentAggregateResult struct {
+	Count          *int     `json:"count,omitempty"`
+	CreatedAtMin   *string  `json:"createdAtMin,omitempty"`
+	CreatedAtMax   *string  `json:"createdAtMax,omitempty"`
+	FilenameMin    *string  `json:"filenameMin,omitempty"`
+	FilenameMax    *string  `json:"filenameMax,omitempty"`
+	ContentTypeMin *string  `json:"content_typeMin,omitempty"`
+	ContentTypeMax *string  `json:"content_typeMax,omitempty"`
+	SizeMin        *int     `json:"sizeMin,omitempty"`
+	SizeMax        *int     `json:"sizeMax,omitempty"`
+	SizeSum        *int     `json:"sizeSum,omitempty"`
+	SizeAvg        *float64 `json:"sizeAvg,omitempty"`
+	KeyMin         *string  `json:"keyMin,omitempty"`
+	KeyMax         *string  `json:"keyMax,omitempty"`
+}
+
+type AttachmentFilter struct {
+	ID        []string               `json:"id,omitempty"`
+	CreatedAt *DateTimeFilter        `json:"createdAt,omitempty"`
+	Has       []*AttachmentHasFilter `json:"has,omitempty"`
+	And       []*AttachmentFilter    `json:"and,omitempty"`
+	Or        []*AttachmentFilter    `json:"or,omitempty"`
+	Not       *AttachmentFilter      `json:"not,omitempty"`
+}
+
+type AttachmentOrder struct {
+	Asc  *AttachmentOrderable `json:"asc,omitempty"`
+	Desc *AttachmentOrderable `json:"desc,omitempty"`
+	Then *AttachmentOrder     `json:"then,omitempty"`
+}
+
+type AttachmentPatch struct {
+	CreatedBy   *UserRef    `json:"createdBy,omitempty"`
+	CreatedAt   *string     `json:"createdAt,omitempty"`
+	Tension     *TensionRef `json:"tension,omitempty"`
+	Comment     *CommentRef `json:"comment,omitempty"`
+	Filename    *string     `json:"filename,omitempty"`
+	ContentType *string     `json:"content_type,omitempty"`
+	Size        *int        `json:"size,omitempty"`
+	Key         *string     `json:"key,omitempty"`
+}
+
+type AttachmentRef struct {
+	ID          *string     `json:"id,omitempty"`
+	CreatedBy   *UserRef    `json:"createdBy,omitempty"`
+	CreatedAt   *string     `json:"createdAt,omitempty"`
+	Tension     *TensionRef `json:"tension,omitempty"`
+	Comment     *CommentRef `json:"comment,omitempty"`
+	Filename    *string     `json:"filename,omitempty"`
+	ContentType *string     `json:"content_type,omitempty"`
+	Size        *int        `json:"size,omitempty"`
+	Key         *string     `json:"key,omitempty"`
+}
+
 type AuthRule struct {
 	And  []*AuthRule `json:"and,omitempty"`
 	Or   []*AuthRule `json:"or,omitempty"`
@@ -545,13 +744,15 @@ type CardKindRef struct {
 }
 
 type Comment struct {
-	Message            string                   `json:"message"`
-	Reactions          []*Reaction              `json:"reactions,omitempty"`
-	ID                 string                   `json:"id"`
-	CreatedBy          *User                    `json:"createdBy"`
-	CreatedAt          string                   `json:"createdAt"`
-	UpdatedAt          *string                  `json:"updatedAt,omitempty"`
-	ReactionsAggregate *ReactionAggregateResult `json:"reactionsAggregate,omitempty"`
+	Message              string                     `json:"message"`
+	Reactions            []*Reaction                `json:"reactions,omitempty"`
+	Attachments          []*Attachment              `json:"attachments,omitempty"`
+	ID                   string                     `json:"id"`
+	CreatedBy            *User                      `json:"createdBy"`
+	CreatedAt            string                     `json:"createdAt"`
+	UpdatedAt            *string                    `json:"updatedAt,omitempty"`
+	ReactionsAggregate   *ReactionAggregateResult   `json:"reactionsAggregate,omitempty"`
+	AttachmentsAggregate *AttachmentAggregateResult `json:"attachmentsAggregate,omitempty"`
 }
 
 type CommentAggregateResult struct {
@@ -581,20 +782,22 @@ type CommentOrder struct {
 }
 
 type CommentPatch struct {
-	CreatedBy *UserRef       `json:"createdBy,omitempty"`
-	CreatedAt *string        `json:"createdAt,omitempty"`
-	UpdatedAt *string        `json:"updatedAt,omitempty"`
-	Message   *string        `json:"message,omitempty"`
-	Reactions []*ReactionRef `json:"reactions,omitempty"`
+	CreatedBy   *UserRef         `json:"createdBy,omitempty"`
+	CreatedAt   *string          `json:"createdAt,omitempty"`
+	UpdatedAt   *string          `json:"updatedAt,omitempty"`
+	Message     *string          `json:"message,omitempty"`
+	Reactions   []*ReactionRef   `json:"reactions,omitempty"`
+	Attachments []*AttachmentRef `json:"attachments,omitempty"`
 }
 
 type CommentRef struct {
-	ID        *string        `json:"id,omitempty"`
-	CreatedBy *UserRef       `json:"createdBy,omitempty"`
-	CreatedAt *string        `json:"createdAt,omitempty"`
-	UpdatedAt *string        `json:"updatedAt,omitempty"`
-	Message   *string        `json:"message,omitempty"`
-	Reactions []*ReactionRef `json:"reactions,omitempty"`
+	ID          *string          `json:"id,omitempty"`
+	CreatedBy   *UserRef         `json:"createdBy,omitempty"`
+	CreatedAt   *string          `json:"createdAt,omitempty"`
+	UpdatedAt   *string          `json:"updatedAt,omitempty"`
+	Message     *string          `json:"message,omitempty"`
+	Reactions   []*ReactionRef   `json:"reactions,omitempty"`
+	Attachments []*AttachmentRef `json:"attachments,omitempty"`
 }
 
 type ContainsFilter struct {
@@ -734,6 +937,12 @@ type DateTimeRange struct {
 	Max string `json:"max"`
 }
 
+type DeleteAttachmentPayload struct {
+	Attachment []*Attachment `json:"attachment,omitempty"`
+	Msg        *string       `json:"msg,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type DeleteBlobPayload struct {
 	Blob    []*Blob `json:"blob,omitempty"`
 	Msg     *string `json:"msg,omitempty"`
@@ -776,12 +985,24 @@ type DeleteLabelPayload struct {
 	NumUids *int     `json:"numUids,omitempty"`
 }
 
+type DeleteMailingSettingPayload struct {
+	MailingSetting []*MailingSetting `json:"mailingSetting,omitempty"`
+	Msg            *string           `json:"msg,omitempty"`
+	NumUids        *int              `json:"numUids,omitempty"`
+}
+
 type DeleteMandatePayload struct {
 	Mandate []*Mandate `json:"mandate,omitempty"`
 	Msg     *string    `json:"msg,omitempty"`
 	NumUids *int       `json:"numUids,omitempty"`
 }
 
+type DeleteMatrixRoomPayload struct {
+	MatrixRoom []*MatrixRoom `json:"matrixRoom,omitempty"`
+	Msg        *string       `json:"msg,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type DeleteNodeFragmentPayload struct {
 	NodeFragment []*NodeFragment `json:"nodeFragment,omitempty"`
 	Msg          *string         `json:"msg,omitempty"`
@@ -800,6 +1021,12 @@ type DeleteNotifPayload struct {
 	NumUids *int     `json:"numUids,omitempty"`
 }
 
+type DeleteNotifPreferencePayload struct {
+	NotifPreference []*NotifPreference `json:"notifPreference,omitempty"`
+	Msg             *string            `json:"msg,omitempty"`
+	NumUids         *int               `json:"numUids,omitempty"`
+}
+
 type DeletePendingUserPayload struct {
 	PendingUser []*PendingUser `json:"pendingUser,omitempty"`
 	Msg         *string        `json:"msg,omitempty"`
@@ -854,6 +1081,12 @@ type DeleteReactionPayload struct {
 	NumUids  *int        `json:"numUids,omitempty"`
 }
 
+type DeleteRecurrencePayload struct {
+	Recurrence []*Recurrence `json:"recurrence,omitempty"`
+	Msg        *string       `json:"msg,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type DeleteRoleExtPayload struct {
 	RoleExt []*RoleExt `json:"roleExt,omitempty"`
 	Msg     *string    `json:"msg,omitempty"`
@@ -866,6 +1099,18 @@ type DeleteTensionPayload struct {
 	NumUids *int       `json:"numUids,omitempty"`
 }
 
+type DeleteTensionTemplatePayload struct {
+	TensionTemplate []*TensionTemplate `json:"tensionTemplate,omitempty"`
+	Msg             *string            `json:"msg,omitempty"`
+	NumUids         *int               `json:"numUids,omitempty"`
+}
+
+type DeleteTensionViewPayload struct {
+	TensionView []*TensionView `json:"tensionView,omitempty"`
+	Msg         *string        `json:"msg,omitempty"`
+	NumUids     *int           `json:"numUids,omitempty"`
+}
+
 type DeleteUserEventPayload struct {
 	UserEvent []*UserEvent `json:"userEvent,omitempty"`
 	Msg       *string      `json:"msg,omitempty"`
@@ -1183,6 +1428,77 @@ type LabelRef struct {
 	Nodes       []*NodeRef    `json:"nodes,omitempty"`
 }
 
+type MailingSetting struct {
+	ID              string                `json:"id"`
+	CreatedBy       *User                 `json:"createdBy"`
+	CreatedAt       string                `json:"createdAt"`
+	Rootnameid      string                `json:"rootnameid"`
+	Nameid          string                `json:"nameid"`
+	Enabled         bool                  `json:"enabled"`
+	Alias           *string               `json:"alias,omitempty"`
+	Senders         []MailingSender       `json:"senders,omitempty"`
+	Allowlist       []string              `json:"allowlist,omitempty"`
+	Type            *TensionType          `json:"type_,omitempty"`
+	Labels          []*Label              `json:"labels,omitempty"`
+	LabelsAggregate *LabelAggregateResult `json:"labelsAggregate,omitempty"`
+}
+
+type MailingSettingAggregateResult struct {
+	Count         *int    `json:"count,omitempty"`
+	CreatedAtMin  *string `json:"createdAtMin,omitempty"`
+	CreatedAtMax  *string `json:"createdAtMax,omitempty"`
+	RootnameidMin *string `json:"rootnameidMin,omitempty"`
+	RootnameidMax *string `json:"rootnameidMax,omitempty"`
+	NameidMin     *string `json:"nameidMin,omitempty"`
+	NameidMax     *string `json:"nameidMax,omitempty"`
+	AliasMin      *string `json:"aliasMin,omitempty"`
+	AliasMax      *string `json:"aliasMax,omitempty"`
+}
+
+type MailingSettingFilter struct {
+	ID         []string                   `json:"id,omitempty"`
+	CreatedAt  *DateTimeFilter            `json:"createdAt,omitempty"`
+	Rootnameid *StringHashFilter          `json:"rootnameid,omitempty"`
+	Nameid     *StringHashFilter          `json:"nameid,omitempty"`
+	Alias      *StringHashFilter          `json:"alias,omitempty"`
+	Has        []*MailingSettingHasFilter `json:"has,omitempty"`
+	And        []*MailingSettingFilter    `json:"and,omitempty"`
+	Or         []*MailingSettingFilter    `json:"or,omitempty"`
+	Not        *MailingSettingFilter      `json:"not,omitempty"`
+}
+
+type MailingSettingOrder struct {
+	Asc  *MailingSettingOrderable `json:"asc,omitempty"`
+	Desc *MailingSettingOrderable `json:"desc,omitempty"`
+	Then *MailingSettingOrder     `json:"then,omitempty"`
+}
+
+type MailingSettingPatch struct {
+	CreatedBy  *UserRef        `json:"createdBy,omitempty"`
+	CreatedAt  *string         `json:"createdAt,omitempty"`
+	Rootnameid *string         `json:"rootnameid,omitempty"`
+	Enabled    *bool           `json:"enabled,omitempty"`
+	Alias      *string         `json:"alias,omitempty"`
+	Senders    []MailingSender `json:"senders,omitempty"`
+	Allowlist  []string        `json:"allowlist,omitempty"`
+	Type       *TensionType    `json:"type_,omitempty"`
+	Labels     []*LabelRef     `json:"labels,omitempty"`
+}
+
+type MailingSettingRef struct {
+	ID         *string         `json:"id,omitempty"`
+	CreatedBy  *UserRef        `json:"createdBy,omitempty"`
+	CreatedAt  *string         `json:"createdAt,omitempty"`
+	Rootnameid *string         `json:"rootnameid,omitempty"`
+	Nameid     *string         `json:"nameid,omitempty"`
+	Enabled    *bool           `json:"enabled,omitempty"`
+	Alias      *string         `json:"alias,omitempty"`
+	Senders    []MailingSender `json:"senders,omitempty"`
+	Allowlist  []string        `json:"allowlist,omitempty"`
+	Type       *TensionType    `json:"type_,omitempty"`
+	Labels     []*LabelRef     `json:"labels,omitempty"`
+}
+
 type Mandate struct {
 	ID               string  `json:"id"`
 	Purpose          string  `json:"purpose"`
@@ -1233,6 +1549,61 @@ type MandateRef struct {
 	Policies         *string `json:"policies,omitempty"`
 }
 
+type MatrixRoom struct {
+	ID         string `json:"id"`
+	CreatedBy  *User  `json:"createdBy"`
+	CreatedAt  string `json:"createdAt"`
+	Rootnameid string `json:"rootnameid"`
+	Nameid     string `json:"nameid"`
+	Roomid     string `json:"roomid"`
+}
+
+type MatrixRoomAggregateResult struct {
+	Count         *int    `json:"count,omitempty"`
+	CreatedAtMin  *string `json:"createdAtMin,omitempty"`
+	CreatedAtMax  *string `json:"createdAtMax,omitempty"`
+	RootnameidMin *string `json:"rootnameidMin,omitempty"`
+	RootnameidMax *string `json:"rootnameidMax,omitempty"`
+	NameidMin     *string `json:"nameidMin,omitempty"`
+	NameidMax     *string `json:"nameidMax,omitempty"`
+	RoomidMin     *string `json:"roomidMin,omitempty"`
+	RoomidMax     *string `json:"roomidMax,omitempty"`
+}
+
+type MatrixRoomFilter struct {
+	ID         []string               `json:"id,omitempty"`
+	CreatedAt  *DateTimeFilter        `json:"createdAt,omitempty"`
+	Rootnameid *StringHashFilter      `json:"rootnameid,omitempty"`
+	Nameid     *StringHashFilter      `json:"nameid,omitempty"`
+	Roomid     *StringHashFilter      `json:"roomid,omitempty"`
+	Has        []*MatrixRoomHasFilter `json:"has,omitempty"`
+	And        []*MatrixRoomFilter    `json:"and,omitempty"`
+	Or         []*MatrixRoomFilter    `json:"or,omitempty"`
+	Not        *MatrixRoomFilter      `json:"not,omitempty"`
+}
+
+type MatrixRoomOrder struct {
+	Asc  *MatrixRoomOrderable `json:"asc,omitempty"`
+	Desc *MatrixRoomOrderable `json:"desc,omitempty"`
+	Then *MatrixRoomOrder     `json:"then,omitempty"`
+}
+
+type MatrixRoomPatch struct {
+	CreatedBy  *UserRef `json:"createdBy,omitempty"`
+	CreatedAt  *string  `json:"createdAt,omitempty"`
+	Rootnameid *string  `json:"rootnameid,omitempty"`
+	Roomid     *string  `json:"roomid,omitempty"`
+}
+
+type MatrixRoomRef struct {
+	ID         *string  `json:"id,omitempty"`
+	CreatedBy  *UserRef `json:"createdBy,omitempty"`
+	CreatedAt  *string  `json:"createdAt,omitempty"`
+	Rootnameid *string  `json:"rootnameid,omitempty"`
+	Nameid     *string  `json:"nameid,omitempty"`
+	Roomid     *string  `json:"roomid,omitempty"`
+}
+
 type MultiPolygon struct {
 	Polygons []*Polygon `json:"polygons"`
 }
@@ -1250,50 +1621,52 @@ type NearFilter struct {
 }
 
 type Node struct {
-	ID                     string                  `json:"id"`
-	CreatedBy              *User                   `json:"createdBy"`
-	CreatedAt              string                  `json:"createdAt"`
-	UpdatedAt              *string                 `json:"updatedAt,omitempty"`
-	Nameid                 string                  `json:"nameid"`
-	Rootnameid             string                  `json:"rootnameid"`
-	Source                 *Blob                   `json:"source,omitempty"`
-	Name                   string                  `json:"name"`
-	About                  *string                 `json:"about,omitempty"`
-	Skills                 []string                `json:"skills,omitempty"`
-	IsRoot                 bool                    `json:"isRoot"`
-	Parent                 *Node                   `json:"parent,omitempty"`
-	Type                   NodeType                `json:"type_"`
-	TensionsOut            []*Tension              `json:"tensions_out,omitempty"`
-	TensionsIn             []*Tension              `json:"tensions_in,omitempty"`
-	Visibility             NodeVisibility          `json:"visibility"`
-	Mode                   NodeMode                `json:"mode"`
-	Rights                 int                     `json:"rights"`
-	IsArchived             bool                    `json:"isArchived"`
-	IsPersonal             *bool                   `json:"isPersonal,omitempty"`
-	UserCanJoin            *bool                   `json:"userCanJoin,omitempty"`
-	GuestCanCreateTension  *bool                   `json:"guestCanCreateTension,omitempty"`
-	Watchers               []*User                 `json:"watchers,omitempty"`
-	Children               []*Node                 `json:"children,omitempty"`
-	Labels                 []*Label                `json:"labels,omitempty"`
-	Roles                  []*RoleExt              `json:"roles,omitempty"`
-	Projects               []*Project              `json:"projects,omitempty"`
-	Pinned                 []*Tension              `json:"pinned,omitempty"`
-	RoleExt                *RoleExt                `json:"role_ext,omitempty"`
-	RoleType               *RoleType               `json:"role_type,omitempty"`
-	Color                  *string                 `json:"color,omitempty"`
-	FirstLink              *User                   `json:"first_link,omitempty"`
-	Contracts              []*Vote                 `json:"contracts,omitempty"`
-	EventsHistory          []*Event                `json:"events_history,omitempty"`
-	TensionsOutAggregate   *TensionAggregateResult `json:"tensions_outAggregate,omitempty"`
-	TensionsInAggregate    *TensionAggregateResult `json:"tensions_inAggregate,omitempty"`
-	WatchersAggregate      *UserAggregateResult    `json:"watchersAggregate,omitempty"`
-	ChildrenAggregate      *NodeAggregateResult    `json:"childrenAggregate,omitempty"`
-	LabelsAggregate        *LabelAggregateResult   `json:"labelsAggregate,omitempty"`
-	RolesAggregate         *RoleExtAggregateResult `json:"rolesAggregate,omitempty"`
-	ProjectsAggregate      *ProjectAggregateResult `json:"projectsAggregate,omitempty"`
-	PinnedAggregate        *TensionAggregateResult `json:"pinnedAggregate,omitempty"`
-	ContractsAggregate     *VoteAggregateResult    `json:"contractsAggregate,omitempty"`
-	EventsHistoryAggregate *EventAggregateResult   `json:"events_historyAggregate,omitempty"`
+	ID                     string                          `json:"id"`
+	CreatedBy              *User                           `json:"createdBy"`
+	CreatedAt              string                          `json:"createdAt"`
+	UpdatedAt              *string                         `json:"updatedAt,omitempty"`
+	Nameid                 string                          `json:"nameid"`
+	Rootnameid             string                          `json:"rootnameid"`
+	Source                 *Blob                           `json:"source,omitempty"`
+	Name                   string                          `json:"name"`
+	About                  *string                         `json:"about,omitempty"`
+	Skills                 []string                        `json:"skills,omitempty"`
+	IsRoot                 bool                            `json:"isRoot"`
+	Parent                 *Node                           `json:"parent,omitempty"`
+	Type                   NodeType                        `json:"type_"`
+	TensionsOut            []*Tension                      `json:"tensions_out,omitempty"`
+	TensionsIn             []*Tension                      `json:"tensions_in,omitempty"`
+	Visibility             NodeVisibility                  `json:"visibility"`
+	Mode                   NodeMode                        `json:"mode"`
+	Rights                 int                             `json:"rights"`
+	IsArchived             bool                            `json:"isArchived"`
+	IsPersonal             *bool                           `json:"isPersonal,omitempty"`
+	UserCanJoin            *bool                           `json:"userCanJoin,omitempty"`
+	GuestCanCreateTension  *bool                           `json:"guestCanCreateTension,omitempty"`
+	Watchers               []*User                         `json:"watchers,omitempty"`
+	Children               []*Node                         `json:"children,omitempty"`
+	Labels                 []*Label                        `json:"labels,omitempty"`
+	Roles                  []*RoleExt                      `json:"roles,omitempty"`
+	Templates              []*TensionTemplate              `json:"templates,omitempty"`
+	Projects               []*Project                      `json:"projects,omitempty"`
+	Pinned                 []*Tension                      `json:"pinned,omitempty"`
+	RoleExt                *RoleExt                        `json:"role_ext,omitempty"`
+	RoleType               *RoleType                       `json:"role_type,omitempty"`
+	Color                  *string                         `json:"color,omitempty"`
+	FirstLink              *User                           `json:"first_link,omitempty"`
+	Contracts              []*Vote                         `json:"contracts,omitempty"`
+	EventsHistory          []*Event                        `json:"events_history,omitempty"`
+	TensionsOutAggregate   *TensionAggregateResult         `json:"tensions_outAggregate,omitempty"`
+	TensionsInAggregate    *TensionAggregateResult         `json:"tensions_inAggregate,omitempty"`
+	WatchersAggregate      *UserAggregateResult            `json:"watchersAggregate,omitempty"`
+	ChildrenAggregate      *NodeAggregateResult            `json:"childrenAggregate,omitempty"`
+	LabelsAggregate        *LabelAggregateResult           `json:"labelsAggregate,omitempty"`
+	RolesAggregate         *RoleExtAggregateResult         `json:"rolesAggregate,omitempty"`
+	TemplatesAggregate     *TensionTemplateAggregateResult `json:"templatesAggregate,omitempty"`
+	ProjectsAggregate      *ProjectAggregateResult         `json:"projectsAggregate,omitempty"`
+	PinnedAggregate        *TensionAggregateResult         `json:"pinnedAggregate,omitempty"`
+	ContractsAggregate     *VoteAggregateResult            `json:"contractsAggregate,omitempty"`
+	EventsHistoryAggregate *EventAggregateResult           `json:"events_historyAggregate,omitempty"`
 }
 
 type NodeAggregateResult struct {
@@ -1428,75 +1801,77 @@ type NodeOrder struct {
 }
 
 type NodePatch struct {
-	CreatedBy             *UserRef        `json:"createdBy,omitempty"`
-	CreatedAt             *string         `json:"createdAt,omitempty"`
-	UpdatedAt             *string         `json:"updatedAt,omitempty"`
-	Rootnameid            *string         `json:"rootnameid,omitempty"`
-	Source                *BlobRef        `json:"source,omitempty"`
-	Name                  *string         `json:"name,omitempty"`
-	About                 *string         `json:"about,omitempty"`
-	Skills                []string        `json:"skills,omitempty"`
-	IsRoot                *bool           `json:"isRoot,omitempty"`
-	Parent                *NodeRef        `json:"parent,omitempty"`
-	Type                  *NodeType       `json:"type_,omitempty"`
-	TensionsOut           []*TensionRef   `json:"tensions_out,omitempty"`
-	TensionsIn            []*TensionRef   `json:"tensions_in,omitempty"`
-	Visibility            *NodeVisibility `json:"visibility,omitempty"`
-	Mode                  *NodeMode       `json:"mode,omitempty"`
-	Rights                *int            `json:"rights,omitempty"`
-	IsArchived            *bool           `json:"isArchived,omitempty"`
-	IsPersonal            *bool           `json:"isPersonal,omitempty"`
-	UserCanJoin           *bool           `json:"userCanJoin,omitempty"`
-	GuestCanCreateTension *bool           `json:"guestCanCreateTension,omitempty"`
-	Watchers              []*UserRef      `json:"watchers,omitempty"`
-	Children              []*NodeRef      `json:"children,omitempty"`
-	Labels                []*LabelRef     `json:"labels,omitempty"`
-	Roles                 []*RoleExtRef   `json:"roles,omitempty"`
-	Projects              []*ProjectRef   `json:"projects,omitempty"`
-	Pinned                []*TensionRef   `json:"pinned,omitempty"`
-	RoleExt               *RoleExtRef     `json:"role_ext,omitempty"`
-	RoleType              *RoleType       `json:"role_type,omitempty"`
-	Color                 *string         `json:"color,omitempty"`
-	FirstLink             *UserRef        `json:"first_link,omitempty"`
-	Contracts             []*VoteRef      `json:"contracts,omitempty"`
-	EventsHistory         []*EventRef     `json:"events_history,omitempty"`
+	CreatedBy             *UserRef              `json:"createdBy,omitempty"`
+	CreatedAt             *string               `json:"createdAt,omitempty"`
+	UpdatedAt             *string               `json:"updatedAt,omitempty"`
+	Rootnameid            *string               `json:"rootnameid,omitempty"`
+	Source                *BlobRef              `json:"source,omitempty"`
+	Name                  *string               `json:"name,omitempty"`
+	About                 *string               `json:"about,omitempty"`
+	Skills                []string              `json:"skills,omitempty"`
+	IsRoot                *bool                 `json:"isRoot,omitempty"`
+	Parent                *NodeRef              `json:"parent,omitempty"`
+	Type                  *NodeType             `json:"type_,omitempty"`
+	TensionsOut           []*TensionRef         `json:"tensions_out,omitempty"`
+	TensionsIn            []*TensionRef         `json:"tensions_in,omitempty"`
+	Visibility            *NodeVisibility       `json:"visibility,omitempty"`
+	Mode                  *NodeMode             `json:"mode,omitempty"`
+	Rights                *int                  `json:"rights,omitempty"`
+	IsArchived            *bool                 `json:"isArchived,omitempty"`
+	IsPersonal            *bool                 `json:"isPersonal,omitempty"`
+	UserCanJoin           *bool                 `json:"userCanJoin,omitempty"`
+	GuestCanCreateTension *bool                 `json:"guestCanCreateTension,omitempty"`
+	Watchers              []*UserRef            `json:"watchers,omitempty"`
+	Children              []*NodeRef            `json:"children,omitempty"`
+	Labels                []*LabelRef           `json:"labels,omitempty"`
+	Roles                 []*RoleExtRef         `json:"roles,omitempty"`
+	Templates             []*TensionTemplateRef `json:"templates,omitempty"`
+	Projects              []*ProjectRef         `json:"projects,omitempty"`
+	Pinned                []*TensionRef         `json:"pinned,omitempty"`
+	RoleExt               *RoleExtRef           `json:"role_ext,omitempty"`
+	RoleType              *RoleType             `json:"role_type,omitempty"`
+	Color                 *string               `json:"color,omitempty"`
+	FirstLink             *UserRef              `json:"first_link,omitempty"`
+	Contracts             []*VoteRef            `json:"contracts,omitempty"`
+	EventsHistory         []*EventRef           `json:"events_history,omitempty"`
 }
 
 type NodeRef struct {
-	ID                    *string         `json:"id,omitempty"`
-	CreatedBy             *UserRef        `json:"createdBy,omitempty"`
-	CreatedAt             *string         `json:"createdAt,omitempty"`
-	UpdatedAt             *string         `json:"updatedAt,omitempty"`
-	Nameid                *string         `json:"nameid,omitempty"`
-	Rootnameid            *string         `json:"rootnameid,omitempty"`
-	Source                *BlobRef        `json:"source,omitempty"`
-	Name                  *string         `json:"name,omitempty"`
-	About                 *string         `json:"about,omitempty"`
-	Skills                []string        `json:"skills,omitempty"`
-	IsRoot                *bool           `json:"isRoot,omitempty"`
-	Parent                *NodeRef        `json:"parent,omitempty"`
-	Type                  *NodeType       `json:"type_,omitempty"`
-	TensionsOut           []*TensionRef   `json:"tensions_out,omitempty"`
-	TensionsIn            []*TensionRef   `json:"tensions_in,omitempty"`
-	Visibility            *NodeVisibility `json:"visibility,omitempty"`
-	Mode                  *NodeMode       `json:"mode,omitempty"`
-	Rights                *int            `json:"rights,omitempty"`
-	IsArchived            *bool           `json:"isArchived,omitempty"`
-	IsPersonal            *bool           `json:"isPersonal,omitempty"`
-	UserCanJoin           *bool           `json:"userCanJoin,omitempty"`
-	GuestCanCreateTension *bool           `json:"guestCanCreateTension,omitempty"`
-	Watchers              []*UserRef      `json:"watchers,omitempty"`
-	Children              []*NodeRef      `json:"children,omitempty"`
-	Labels                []*LabelRef     `json:"labels,omitempty"`
-	Roles                 []*RoleExtRef   `json:"roles,omitempty"`
-	Projects              []*ProjectRef   `json:"projects,omitempty"`
-	Pinned                []*TensionRef   `json:"pinned,omitempty"`
-	RoleExt               *RoleExtRef     `json:"role_ext,omitempty"`
-	RoleType              *RoleType       `json:"role_type,omitempty"`
-	Color                 *string         `json:"color,omitempty"`
-	FirstLink             *UserRef        `json:"first_link,omitempty"`
-	Contracts             []*VoteRef      `json:"contracts,omitempty"`
-	EventsHistory         []*EventRef     `json:"events_history,omitempty"`
+	ID                    *string               `json:"id,omitempty"`
+	CreatedBy             *UserRef              `json:"createdBy,omitempty"`
+	CreatedAt             *string               `json:"createdAt,omitempty"`
+	UpdatedAt             *string               `json:"updatedAt,omitempty"`
+	Nameid                *string               `json:"nameid,omitempty"`
+	Rootnameid            *string               `json:"rootnameid,omitempty"`
+	Source                *BlobRef              `json:"source,omitempty"`
+	Name                  *string               `json:"name,omitempty"`
+	About                 *string               `json:"about,omitempty"`
+	Skills                []string              `json:"skills,omitempty"`
+	IsRoot                *bool                 `json:"isRoot,omitempty"`
+	Parent                *NodeRef              `json:"parent,omitempty"`
+	Type                  *NodeType             `json:"type_,omitempty"`
+	TensionsOut           []*TensionRef         `json:"tensions_out,omitempty"`
+	TensionsIn            []*TensionRef         `json:"tensions_in,omitempty"`
+	Visibility            *NodeVisibility       `json:"visibility,omitempty"`
+	Mode                  *NodeMode             `json:"mode,omitempty"`
+	Rights                *int                  `json:"rights,omitempty"`
+	IsArchived            *bool                 `json:"isArchived,omitempty"`
+	IsPersonal            *bool                 `json:"isPersonal,omitempty"`
+	UserCanJoin           *bool                 `json:"userCanJoin,omitempty"`
+	GuestCanCreateTension *bool                 `json:"guestCanCreateTension,omitempty"`
+	Watchers              []*UserRef            `json:"watchers,omitempty"`
+	Children              []*NodeRef            `json:"children,omitempty"`
+	Labels                []*LabelRef           `json:"labels,omitempty"`
+	Roles                 []*RoleExtRef         `json:"roles,omitempty"`
+	Templates             []*TensionTemplateRef `json:"templates,omitempty"`
+	Projects              []*ProjectRef         `json:"projects,omitempty"`
+	Pinned                []*TensionRef         `json:"pinned,omitempty"`
+	RoleExt               *RoleExtRef           `json:"role_ext,omitempty"`
+	RoleType              *RoleType             `json:"role_type,omitempty"`
+	Color                 *string               `json:"color,omitempty"`
+	FirstLink             *UserRef              `json:"first_link,omitempty"`
+	Contracts             []*VoteRef            `json:"contracts,omitempty"`
+	EventsHistory         []*EventRef           `json:"events_history,omitempty"`
 }
 
 type NodeTypeHash struct {
@@ -1534,6 +1909,11 @@ type NotifAggregateResult struct {
 	LinkMax      *string `json:"linkMax,omitempty"`
 }
 
+type NotifEventGroupHash struct {
+	Eq *NotifEventGroup   `json:"eq,omitempty"`
+	In []*NotifEventGroup `json:"in,omitempty"`
+}
+
 type NotifFilter struct {
 	ID        []string              `json:"id,omitempty"`
 	CreatedAt *DateTimeFilter       `json:"createdAt,omitempty"`
@@ -1560,6 +1940,65 @@ type NotifPatch struct {
 	Link      *string      `json:"link,omitempty"`
 }
 
+type NotifPreference struct {
+	ID           string                 `json:"id"`
+	Preferenceid string                 `json:"preferenceid"`
+	User         *User                  `json:"user"`
+	Rootnameid   *string                `json:"rootnameid,omitempty"`
+	Reason       *NotifPreferenceReason `json:"reason,omitempty"`
+	EventGroup   *NotifEventGroup       `json:"event_group,omitempty"`
+	Channel      NotifChannel           `json:"channel"`
+}
+
+type NotifPreferenceAggregateResult struct {
+	Count           *int    `json:"count,omitempty"`
+	PreferenceidMin *string `json:"preferenceidMin,omitempty"`
+	PreferenceidMax *string `json:"preferenceidMax,omitempty"`
+	RootnameidMin   *string `json:"rootnameidMin,omitempty"`
+	RootnameidMax   *string `json:"rootnameidMax,omitempty"`
+}
+
+type NotifPreferenceFilter struct {
+	ID           []string                    `json:"id,omitempty"`
+	Preferenceid *StringHashFilter           `json:"preferenceid,omitempty"`
+	Rootnameid   *StringHashFilter           `json:"rootnameid,omitempty"`
+	Reason       *NotifPreferenceReasonHash  `json:"reason,omitempty"`
+	EventGroup   *NotifEventGroupHash        `json:"event_group,omitempty"`
+	Has          []*NotifPreferenceHasFilter `json:"has,omitempty"`
+	And          []*NotifPreferenceFilter    `json:"and,omitempty"`
+	Or           []*NotifPreferenceFilter    `json:"or,omitempty"`
+	Not          *NotifPreferenceFilter      `json:"not,omitempty"`
+}
+
+type NotifPreferenceOrder struct {
+	Asc  *NotifPreferenceOrderable `json:"asc,omitempty"`
+	Desc *NotifPreferenceOrderable `json:"desc,omitempty"`
+	Then *NotifPreferenceOrder     `json:"then,omitempty"`
+}
+
+type NotifPreferencePatch struct {
+	User       *UserRef               `json:"user,omitempty"`
+	Rootnameid *string                `json:"rootnameid,omitempty"`
+	Reason     *NotifPreferenceReason `json:"reason,omitempty"`
+	EventGroup *NotifEventGroup       `json:"event_group,omitempty"`
+	Channel    *NotifChannel          `json:"channel,omitempty"`
+}
+
+type NotifPreferenceReasonHash struct {
+	Eq *NotifPreferenceReason   `json:"eq,omitempty"`
+	In []*NotifPreferenceReason `json:"in,omitempty"`
+}
+
+type NotifPreferenceRef struct {
+	ID           *string                `json:"id,omitempty"`
+	Preferenceid *string                `json:"preferenceid,omitempty"`
+	User         *UserRef               `json:"user,omitempty"`
+	Rootnameid   *string                `json:"rootnameid,omitempty"`
+	Reason       *NotifPreferenceReason `json:"reason,omitempty"`
+	EventGroup   *NotifEventGroup       `json:"event_group,omitempty"`
+	Channel      *NotifChannel          `json:"channel,omitempty"`
+}
+
 type NotifRef struct {
 	ID        *string      `json:"id,omitempty"`
 	CreatedBy *UserRef     `json:"createdBy,omitempty"`
@@ -2120,6 +2559,94 @@ type ReactionRef struct {
 	Type       *int        `json:"type_,omitempty"`
 }
 
+type Recurrence struct {
+	ID         string           `json:"id"`
+	CreatedBy  *User            `json:"createdBy"`
+	CreatedAt  string           `json:"createdAt"`
+	Rootnameid string           `json:"rootnameid"`
+	Receiverid string           `json:"receiverid"`
+	Rrule      string           `json:"rrule"`
+	Dtstart    string           `json:"dtstart"`
+	Paused     *bool            `json:"paused,omitempty"`
+	Title      *string          `json:"title,omitempty"`
+	Template   *TensionTemplate `json:"template,omitempty"`
+	Tension    *Tension         `json:"tension,omitempty"`
+	NextAt     *string          `json:"next_at,omitempty"`
+	Count      *int             `json:"count,omitempty"`
+}
+
+type RecurrenceAggregateResult struct {
+	Count         *int     `json:"count,omitempty"`
+	CreatedAtMin  *string  `json:"createdAtMin,omitempty"`
+	CreatedAtMax  *string  `json:"createdAtMax,omitempty"`
+	RootnameidMin *string  `json:"rootnameidMin,omitempty"`
+	RootnameidMax *string  `json:"rootnameidMax,omitempty"`
+	ReceiveridMin *string  `json:"receiveridMin,omitempty"`
+	ReceiveridMax *string  `json:"receiveridMax,omitempty"`
+	RruleMin      *string  `json:"rruleMin,omitempty"`
+	RruleMax      *string  `json:"rruleMax,omitempty"`
+	DtstartMin    *string  `json:"dtstartMin,omitempty"`
+	DtstartMax    *string  `json:"dtstartMax,omitempty"`
+	TitleMin      *string  `json:"titleMin,omitempty"`
+	TitleMax      *string  `json:"titleMax,omitempty"`
+	NextAtMin     *string  `json:"next_atMin,omitempty"`
+	NextAtMax     *string  `json:"next_atMax,omitempty"`
+	CountMin      *int     `json:"countMin,omitempty"`
+	CountMax      *int     `json:"countMax,omitempty"`
+	CountSum      *int     `json:"countSum,omitempty"`
+	CountAvg      *float64 `json:"countAvg,omitempty"`
+}
+
+type RecurrenceFilter struct {
+	ID         []string               `json:"id,omitempty"`
+	CreatedAt  *DateTimeFilter        `json:"createdAt,omitempty"`
+	Rootnameid *StringHashFilter      `json:"rootnameid,omitempty"`
+	Receiverid *StringHashFilter      `json:"receiverid,omitempty"`
+	Paused     *bool                  `json:"paused,omitempty"`
+	NextAt     *DateTimeFilter        `json:"next_at,omitempty"`
+	Has        []*RecurrenceHasFilter `json:"has,omitempty"`
+	And        []*RecurrenceFilter    `json:"and,omitempty"`
+	Or         []*RecurrenceFilter    `json:"or,omitempty"`
+	Not        *RecurrenceFilter      `json:"not,omitempty"`
+}
+
+type RecurrenceOrder struct {
+	Asc  *RecurrenceOrderable `json:"asc,omitempty"`
+	Desc *RecurrenceOrderable `json:"desc,omitempty"`
+	Then *RecurrenceOrder     `json:"then,omitempty"`
+}
+
+type RecurrencePatch struct {
+	CreatedBy  *UserRef            `json:"createdBy,omitempty"`
+	CreatedAt  *string             `json:"createdAt,omitempty"`
+	Rootnameid *string             `json:"rootnameid,omitempty"`
+	Receiverid *string             `json:"receiverid,omitempty"`
+	Rrule      *string             `json:"rrule,omitempty"`
+	Dtstart    *string             `json:"dtstart,omitempty"`
+	Paused     *bool               `json:"paused,omitempty"`
+	Title      *string             `json:"title,omitempty"`
+	Template   *TensionTemplateRef `json:"template,omitempty"`
+	Tension    *TensionRef         `json:"tension,omitempty"`
+	NextAt     *string             `json:"next_at,omitempty"`
+	Count      *int                `json:"count,omitempty"`
+}
+
+type RecurrenceRef struct {
+	ID         *string             `json:"id,omitempty"`
+	CreatedBy  *UserRef            `json:"createdBy,omitempty"`
+	CreatedAt  *string             `json:"createdAt,omitempty"`
+	Rootnameid *string             `json:"rootnameid,omitempty"`
+	Receiverid *string             `json:"receiverid,omitempty"`
+	Rrule      *string             `json:"rrule,omitempty"`
+	Dtstart    *string             `json:"dtstart,omitempty"`
+	Paused     *bool               `json:"paused,omitempty"`
+	Title      *string             `json:"title,omitempty"`
+	Template   *TensionTemplateRef `json:"template,omitempty"`
+	Tension    *TensionRef         `json:"tension,omitempty"`
+	NextAt     *string             `json:"next_at,omitempty"`
+	Count      *int                `json:"count,omitempty"`
+}
+
 type RoleExt struct {
 	ID             string               `json:"id"`
 	Rootnameid     string               `json:"rootnameid"`
@@ -2246,7 +2773,10 @@ type Tension struct {
 	Type                     TensionType                   `json:"type_"`
 	Status                   TensionStatus                 `json:"status"`
 	Action                   *TensionAction                `json:"action,omitempty"`
+	DueDate                  *string                       `json:"dueDate,omitempty"`
+	Confidential             *bool                         `json:"confidential,omitempty"`
 	Assignees                []*User                       `json:"assignees,omitempty"`
+	Participants             []*User                       `json:"participants,omitempty"`
 	Labels                   []*Label                      `json:"labels,omitempty"`
 	Comments                 []*Comment                    `json:"comments,omitempty"`
 	Blobs                    []*Blob                       `json:"blobs,omitempty"`
@@ -2255,6 +2785,15 @@ type Tension struct {
 	Contracts                []*Contract                   `json:"contracts,omitempty"`
 	Subscribers              []*User                       `json:"subscribers,omitempty"`
 	ProjectStatuses          []*ProjectColumn              `json:"project_statuses,omitempty"`
+	Template                 *TensionTemplate              `json:"template,omitempty"`
+	Blocks                   []*Tension                    `json:"blocks,omitempty"`
+	BlockedBy                []*Tension                    `json:"blocked_by,omitempty"`
+	DuplicateOf              *Tension                      `json:"duplicate_of,omitempty"`
+	Duplicates               []*Tension                    `json:"duplicates,omitempty"`
+	Parent                   *Tension                      `json:"parent,omitempty"`
+	Subtasks                 []*Tension                    `json:"subtasks,omitempty"`
+	Related                  []*Tension                    `json:"related,omitempty"`
+	Attachments              []*Attachment                 `json:"attachments,omitempty"`
 	NComments                *int                          `json:"n_comments,omitempty"`
 	ID                       string                        `json:"id"`
 	CreatedBy                *User                         `json:"createdBy"`
@@ -2262,6 +2801,7 @@ type Tension struct {
 	UpdatedAt                *string                       `json:"updatedAt,omitempty"`
 	Message                  *string                       `json:"message,omitempty"`
 	AssigneesAggregate       *UserAggregateResult          `json:"assigneesAggregate,omitempty"`
+	ParticipantsAggregate    *UserAggregateResult          `json:"participantsAggregate,omitempty"`
 	LabelsAggregate          *LabelAggregateResult         `json:"labelsAggregate,omitempty"`
 	CommentsAggregate        *CommentAggregateResult       `json:"commentsAggregate,omitempty"`
 	BlobsAggregate           *BlobAggregateResult          `json:"blobsAggregate,omitempty"`
@@ -2270,6 +2810,12 @@ type Tension struct {
 	ContractsAggregate       *ContractAggregateResult      `json:"contractsAggregate,omitempty"`
 	SubscribersAggregate     *UserAggregateResult          `json:"subscribersAggregate,omitempty"`
 	ProjectStatusesAggregate *ProjectColumnAggregateResult `json:"project_statusesAggregate,omitempty"`
+	BlocksAggregate          *TensionAggregateResult       `json:"blocksAggregate,omitempty"`
+	BlockedByAggregate       *TensionAggregateResult       `json:"blocked_byAggregate,omitempty"`
+	DuplicatesAggregate      *TensionAggregateResult       `json:"duplicatesAggregate,omitempty"`
+	SubtasksAggregate        *TensionAggregateResult       `json:"subtasksAggregate,omitempty"`
+	RelatedAggregate         *TensionAggregateResult       `json:"relatedAggregate,omitempty"`
+	AttachmentsAggregate     *AttachmentAggregateResult    `json:"attachmentsAggregate,omitempty"`
 }
 
 func (Tension) IsCardKind() {}
@@ -2288,6 +2834,8 @@ type TensionAggregateResult struct {
 	ReceiveridMax *string  `json:"receiveridMax,omitempty"`
 	TitleMin      *string  `json:"titleMin,omitempty"`
 	TitleMax      *string  `json:"titleMax,omitempty"`
+	DueDateMin    *string  `json:"dueDateMin,omitempty"`
+	DueDateMax    *string  `json:"dueDateMax,omitempty"`
 	NCommentsMin  *int     `json:"n_commentsMin,omitempty"`
 	NCommentsMax  *int     `json:"n_commentsMax,omitempty"`
 	NCommentsSum  *int     `json:"n_commentsSum,omitempty"`
@@ -2300,18 +2848,20 @@ type TensionEventHash struct {
 }
 
 type TensionFilter struct {
-	ID         []string                            `json:"id,omitempty"`
-	CreatedAt  *DateTimeFilter                     `json:"createdAt,omitempty"`
-	Message    *StringFullTextFilter               `json:"message,omitempty"`
-	Emitterid  *StringHashFilterStringRegExpFilter `json:"emitterid,omitempty"`
-	Receiverid *StringHashFilterStringRegExpFilter `json:"receiverid,omitempty"`
-	Title      *StringFullTextFilter               `json:"title,omitempty"`
-	Type       *TensionTypeHash                    `json:"type_,omitempty"`
-	Status     *TensionStatusHash                  `json:"status,omitempty"`
-	Has        []*TensionHasFilter                 `json:"has,omitempty"`
-	And        []*TensionFilter                    `json:"and,omitempty"`
-	Or         []*TensionFilter                    `json:"or,omitempty"`
-	Not        *TensionFilter                      `json:"not,omitempty"`
+	ID           []string                            `json:"id,omitempty"`
+	CreatedAt    *DateTimeFilter                     `json:"createdAt,omitempty"`
+	Message      *StringFullTextFilter               `json:"message,omitempty"`
+	Emitterid    *StringHashFilterStringRegExpFilter `json:"emitterid,omitempty"`
+	Receiverid   *StringHashFilterStringRegExpFilter `json:"receiverid,omitempty"`
+	Title        *StringFullTextFilter               `json:"title,omitempty"`
+	Type         *TensionTypeHash                    `json:"type_,omitempty"`
+	Status       *TensionStatusHash                  `json:"status,omitempty"`
+	DueDate      *DateTimeFilter                     `json:"dueDate,omitempty"`
+	Confidential *bool                               `json:"confidential,omitempty"`
+	Has          []*TensionHasFilter                 `json:"has,omitempty"`
+	And          []*TensionFilter                    `json:"and,omitempty"`
+	Or           []*TensionFilter                    `json:"or,omitempty"`
+	Not          *TensionFilter                      `json:"not,omitempty"`
 }
 
 type TensionOrder struct {
@@ -2333,7 +2883,10 @@ type TensionPatch struct {
 	Type            *TensionType        `json:"type_,omitempty"`
 	Status          *TensionStatus      `json:"status,omitempty"`
 	Action          *TensionAction      `json:"action,omitempty"`
+	DueDate         *string             `json:"dueDate,omitempty"`
+	Confidential    *bool               `json:"confidential,omitempty"`
 	Assignees       []*UserRef          `json:"assignees,omitempty"`
+	Participants    []*UserRef          `json:"participants,omitempty"`
 	Labels          []*LabelRef         `json:"labels,omitempty"`
 	Comments        []*CommentRef       `json:"comments,omitempty"`
 	Blobs           []*BlobRef          `json:"blobs,omitempty"`
@@ -2342,6 +2895,15 @@ type TensionPatch struct {
 	Contracts       []*ContractRef      `json:"contracts,omitempty"`
 	Subscribers     []*UserRef          `json:"subscribers,omitempty"`
 	ProjectStatuses []*ProjectColumnRef `json:"project_statuses,omitempty"`
+	Template        *TensionTemplateRef `json:"template,omitempty"`
+	Blocks          []*TensionRef       `json:"blocks,omitempty"`
+	BlockedBy       []*TensionRef       `json:"blocked_by,omitempty"`
+	DuplicateOf     *TensionRef         `json:"duplicate_of,omitempty"`
+	Duplicates      []*TensionRef       `json:"duplicates,omitempty"`
+	Parent          *TensionRef         `json:"parent,omitempty"`
+	Subtasks        []*TensionRef       `json:"subtasks,omitempty"`
+	Related         []*TensionRef       `json:"related,omitempty"`
+	Attachments     []*AttachmentRef    `json:"attachments,omitempty"`
 	NComments       *int                `json:"n_comments,omitempty"`
 }
 
@@ -2359,7 +2921,10 @@ type TensionRef struct {
 	Type            *TensionType        `json:"type_,omitempty"`
 	Status          *TensionStatus      `json:"status,omitempty"`
 	Action          *TensionAction      `json:"action,omitempty"`
+	DueDate         *string             `json:"dueDate,omitempty"`
+	Confidential    *bool               `json:"confidential,omitempty"`
 	Assignees       []*UserRef          `json:"assignees,omitempty"`
+	Participants    []*UserRef          `json:"participants,omitempty"`
 	Labels          []*LabelRef         `json:"labels,omitempty"`
 	Comments        []*CommentRef       `json:"comments,omitempty"`
 	Blobs           []*BlobRef          `json:"blobs,omitempty"`
@@ -2368,6 +2933,15 @@ type TensionRef struct {
 	Contracts       []*ContractRef      `json:"contracts,omitempty"`
 	Subscribers     []*UserRef          `json:"subscribers,omitempty"`
 	ProjectStatuses []*ProjectColumnRef `json:"project_statuses,omitempty"`
+	Template        *TensionTemplateRef `json:"template,omitempty"`
+	Blocks          []*TensionRef       `json:"blocks,omitempty"`
+	BlockedBy       []*TensionRef       `json:"blocked_by,omitempty"`
+	DuplicateOf     *TensionRef         `json:"duplicate_of,omitempty"`
+	Duplicates      []*TensionRef       `json:"duplicates,omitempty"`
+	Parent          *TensionRef         `json:"parent,omitempty"`
+	Subtasks        []*TensionRef       `json:"subtasks,omitempty"`
+	Related         []*TensionRef       `json:"related,omitempty"`
+	Attachments     []*AttachmentRef    `json:"attachments,omitempty"`
 	NComments       *int                `json:"n_comments,omitempty"`
 }
 
@@ -2376,11 +2950,167 @@ type TensionStatusHash struct {
 	In []*TensionStatus `json:"in,omitempty"`
 }
 
+type TensionTemplate struct {
+	ID                 string                `json:"id"`
+	Rootnameid         string                `json:"rootnameid"`
+	Name               string                `json:"name"`
+	About              *string               `json:"about,omitempty"`
+	Title              *string               `json:"title,omitempty"`
+	Message            *string               `json:"message,omitempty"`
+	Type               *TensionType          `json:"type_,omitempty"`
+	Labels             []*Label              `json:"labels,omitempty"`
+	Assignees          []*User               `json:"assignees,omitempty"`
+	Nodes              []*Node               `json:"nodes,omitempty"`
+	LabelsAggregate    *LabelAggregateResult `json:"labelsAggregate,omitempty"`
+	AssigneesAggregate *UserAggregateResult  `json:"assigneesAggregate,omitempty"`
+	NodesAggregate     *NodeAggregateResult  `json:"nodesAggregate,omitempty"`
+}
+
+type TensionTemplateAggregateResult struct {
+	Count         *int    `json:"count,omitempty"`
+	RootnameidMin *string `json:"rootnameidMin,omitempty"`
+	RootnameidMax *string `json:"rootnameidMax,omitempty"`
+	NameMin       *string `json:"nameMin,omitempty"`
+	NameMax       *string `json:"nameMax,omitempty"`
+	AboutMin      *string `json:"aboutMin,omitempty"`
+	AboutMax      *string `json:"aboutMax,omitempty"`
+	TitleMin      *string `json:"titleMin,omitempty"`
+	TitleMax      *string `json:"titleMax,omitempty"`
+	MessageMin    *string `json:"messageMin,omitempty"`
+	MessageMax    *string `json:"messageMax,omitempty"`
+}
+
+type TensionTemplateFilter struct {
+	ID         []string                          `json:"id,omitempty"`
+	Rootnameid *StringHashFilter                 `json:"rootnameid,omitempty"`
+	Name       *StringHashFilterStringTermFilter `json:"name,omitempty"`
+	Has        []*TensionTemplateHasFilter       `json:"has,omitempty"`
+	And        []*TensionTemplateFilter          `json:"and,omitempty"`
+	Or         []*TensionTemplateFilter          `json:"or,omitempty"`
+	Not        *TensionTemplateFilter            `json:"not,omitempty"`
+}
+
+type TensionTemplateOrder struct {
+	Asc  *TensionTemplateOrderable `json:"asc,omitempty"`
+	Desc *TensionTemplateOrderable `json:"desc,omitempty"`
+	Then *TensionTemplateOrder     `json:"then,omitempty"`
+}
+
+type TensionTemplatePatch struct {
+	Rootnameid *string      `json:"rootnameid,omitempty"`
+	Name       *string      `json:"name,omitempty"`
+	About      *string      `json:"about,omitempty"`
+	Title      *string      `json:"title,omitempty"`
+	Message    *string      `json:"message,omitempty"`
+	Type       *TensionType `json:"type_,omitempty"`
+	Labels     []*LabelRef  `json:"labels,omitempty"`
+	Assignees  []*UserRef   `json:"assignees,omitempty"`
+	Nodes      []*NodeRef   `json:"nodes,omitempty"`
+}
+
+type TensionTemplateRef struct {
+	ID         *string      `json:"id,omitempty"`
+	Rootnameid *string      `json:"rootnameid,omitempty"`
+	Name       *string      `json:"name,omitempty"`
+	About      *string      `json:"about,omitempty"`
+	Title      *string      `json:"title,omitempty"`
+	Message    *string      `json:"message,omitempty"`
+	Type       *TensionType `json:"type_,omitempty"`
+	Labels     []*LabelRef  `json:"labels,omitempty"`
+	Assignees  []*UserRef   `json:"assignees,omitempty"`
+	Nodes      []*NodeRef   `json:"nodes,omitempty"`
+}
+
 type TensionTypeHash struct {
 	Eq *TensionType   `json:"eq,omitempty"`
 	In []*TensionType `json:"in,omitempty"`
 }
 
+type TensionView struct {
+	ID                string               `json:"id"`
+	CreatedBy         *User                `json:"createdBy"`
+	CreatedAt         string               `json:"createdAt"`
+	Rootnameid        string               `json:"rootnameid"`
+	Nameid            *string              `json:"nameid,omitempty"`
+	Name              string               `json:"name"`
+	Query             string               `json:"query"`
+	Display           *string              `json:"display,omitempty"`
+	Watchers          []*User              `json:"watchers,omitempty"`
+	CheckedAt         *string              `json:"checked_at,omitempty"`
+	WatchersAggregate *UserAggregateResult `json:"watchersAggregate,omitempty"`
+}
+
+type TensionViewAggregateResult struct {
+	Count         *int    `json:"count,omitempty"`
+	CreatedAtMin  *string `json:"createdAtMin,omitempty"`
+	CreatedAtMax  *string `json:"createdAtMax,omitempty"`
+	RootnameidMin *string `json:"rootnameidMin,omitempty"`
+	RootnameidMax *string `json:"rootnameidMax,omitempty"`
+	NameidMin     *string `json:"nameidMin,omitempty"`
+	NameidMax     *string `json:"nameidMax,omitempty"`
+	NameMin       *string `json:"nameMin,omitempty"`
+	NameMax       *string `json:"nameMax,omitempty"`
+	QueryMin      *string `json:"queryMin,omitempty"`
+	QueryMax      *string `json:"queryMax,omitempty"`
+	DisplayMin    *string `json:"displayMin,omitempty"`
+	DisplayMax    *string `json:"displayMax,omitempty"`
+	CheckedAtMin  *string `json:"checked_atMin,omitempty"`
+	CheckedAtMax  *string `json:"checked_atMax,omitempty"`
+}
+
+type TensionViewFilter struct {
+	ID         []string                `json:"id,omitempty"`
+	CreatedAt  *DateTimeFilter         `json:"createdAt,omitempty"`
+	Rootnameid *StringHashFilter       `json:"rootnameid,omitempty"`
+	Nameid     *StringHashFilter       `json:"nameid,omitempty"`
+	Has        []*TensionViewHasFilter `json:"has,omitempty"`
+	And        []*TensionViewFilter    `json:"and,omitempty"`
+	Or         []*TensionViewFilter    `json:"or,omitempty"`
+	Not        *TensionViewFilter      `json:"not,omitempty"`
+}
+
+type TensionViewOrder struct {
+	Asc  *TensionViewOrderable `json:"asc,omitempty"`
+	Desc *TensionViewOrderable `json:"desc,omitempty"`
+	Then *TensionViewOrder     `json:"then,omitempty"`
+}
+
+type TensionViewPatch struct {
+	CreatedBy  *UserRef   `json:"createdBy,omitempty"`
+	CreatedAt  *string    `json:"createdAt,omitempty"`
+	Rootnameid *string    `json:"rootnameid,omitempty"`
+	Nameid     *string    `json:"nameid,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+	Query      *string    `json:"query,omitempty"`
+	Display    *string    `json:"display,omitempty"`
+	Watchers   []*UserRef `json:"watchers,omitempty"`
+	CheckedAt  *string    `json:"checked_at,omitempty"`
+}
+
+type TensionViewRef struct {
+	ID         *string    `json:"id,omitempty"`
+	CreatedBy  *UserRef   `json:"createdBy,omitempty"`
+	CreatedAt  *string    `json:"createdAt,omitempty"`
+	Rootnameid *string    `json:"rootnameid,omitempty"`
+	Nameid     *string    `json:"nameid,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+	Query      *string    `json:"query,omitempty"`
+	Display    *string    `json:"display,omitempty"`
+	Watchers   []*UserRef `json:"watchers,omitempty"`
+	CheckedAt  *string    `json:"checked_at,omitempty"`
+}
+
+type UpdateAttachmentInput struct {
+	Filter *AttachmentFilter `json:"filter"`
+	Set    *AttachmentPatch  `json:"set,omitempty"`
+	Remove *AttachmentPatch  `json:"remove,omitempty"`
+}
+
+type UpdateAttachmentPayload struct {
+	Attachment []*Attachment `json:"attachment,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type UpdateBlobInput struct {
 	Filter *BlobFilter `json:"filter"`
 	Set    *BlobPatch  `json:"set,omitempty"`
@@ -2458,6 +3188,17 @@ type UpdateLabelPayload struct {
 	NumUids *int     `json:"numUids,omitempty"`
 }
 
+type UpdateMailingSettingInput struct {
+	Filter *MailingSettingFilter `json:"filter"`
+	Set    *MailingSettingPatch  `json:"set,omitempty"`
+	Remove *MailingSettingPatch  `json:"remove,omitempty"`
+}
+
+type UpdateMailingSettingPayload struct {
+	MailingSetting []*MailingSetting `json:"mailingSetting,omitempty"`
+	NumUids        *int              `json:"numUids,omitempty"`
+}
+
 type UpdateMandateInput struct {
 	Filter *MandateFilter `json:"filter"`
 	Set    *MandatePatch  `json:"set,omitempty"`
@@ -2469,6 +3210,17 @@ type UpdateMandatePayload struct {
 	NumUids *int       `json:"numUids,omitempty"`
 }
 
+type UpdateMatrixRoomInput struct {
+	Filter *MatrixRoomFilter `json:"filter"`
+	Set    *MatrixRoomPatch  `json:"set,omitempty"`
+	Remove *MatrixRoomPatch  `json:"remove,omitempty"`
+}
+
+type UpdateMatrixRoomPayload struct {
+	MatrixRoom []*MatrixRoom `json:"matrixRoom,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type UpdateNodeFragmentInput struct {
 	Filter *NodeFragmentFilter `json:"filter"`
 	Set    *NodeFragmentPatch  `json:"set,omitempty"`
@@ -2502,6 +3254,17 @@ type UpdateNotifPayload struct {
 	NumUids *int     `json:"numUids,omitempty"`
 }
 
+type UpdateNotifPreferenceInput struct {
+	Filter *NotifPreferenceFilter `json:"filter"`
+	Set    *NotifPreferencePatch  `json:"set,omitempty"`
+	Remove *NotifPreferencePatch  `json:"remove,omitempty"`
+}
+
+type UpdateNotifPreferencePayload struct {
+	NotifPreference []*NotifPreference `json:"notifPreference,omitempty"`
+	NumUids         *int               `json:"numUids,omitempty"`
+}
+
 type UpdatePendingUserInput struct {
 	Filter *PendingUserFilter `json:"filter"`
 	Set    *PendingUserPatch  `json:"set,omitempty"`
@@ -2601,6 +3364,17 @@ type UpdateReactionPayload struct {
 	NumUids  *int        `json:"numUids,omitempty"`
 }
 
+type UpdateRecurrenceInput struct {
+	Filter *RecurrenceFilter `json:"filter"`
+	Set    *RecurrencePatch  `json:"set,omitempty"`
+	Remove *RecurrencePatch  `json:"remove,omitempty"`
+}
+
+type UpdateRecurrencePayload struct {
+	Recurrence []*Recurrence `json:"recurrence,omitempty"`
+	NumUids    *int          `json:"numUids,omitempty"`
+}
+
 type UpdateRoleExtInput struct {
 	Filter *RoleExtFilter `json:"filter"`
 	Set    *RoleExtPatch  `json:"set,omitempty"`
@@ -2623,6 +3397,28 @@ type UpdateTensionPayload struct {
 	NumUids *int       `json:"numUids,omitempty"`
 }
 
+type UpdateTensionTemplateInput struct {
+	Filter *TensionTemplateFilter `json:"filter"`
+	Set    *TensionTemplatePatch  `json:"set,omitempty"`
+	Remove *TensionTemplatePatch  `json:"remove,omitempty"`
+}
+
+type UpdateTensionTemplatePayload struct {
+	TensionTemplate []*TensionTemplate `json:"tensionTemplate,omitempty"`
+	NumUids         *int               `json:"numUids,omitempty"`
+}
+
+type UpdateTensionViewInput struct {
+	Filter *TensionViewFilter `json:"filter"`
+	Set    *TensionViewPatch  `json:"set,omitempty"`
+	Remove *TensionViewPatch  `json:"remove,omitempty"`
+}
+
+type UpdateTensionViewPayload struct {
+	TensionView []*TensionView `json:"tensionView,omitempty"`
+	NumUids     *int           `json:"numUids,omitempty"`
+}
+
 type UpdateUserEventInput struct {
 	Filter *UserEventFilter `json:"filter"`
 	Set    *UserEventPatch  `json:"set,omitempty"`
@@ -2668,39 +3464,44 @@ type UpdateVotePayload struct {
 }
 
 type User struct {
-	ID                        string                    `json:"id"`
-	CreatedAt                 string                    `json:"createdAt"`
-	LastAck                   string                    `json:"lastAck"`
-	Username                  string                    `json:"username"`
-	Name                      *string                   `json:"name,omitempty"`
-	Email                     string                    `json:"email"`
-	Password                  string                    `json:"password"`
-	Bio                       *string                   `json:"bio,omitempty"`
-	Location                  *string                   `json:"location,omitempty"`
-	Utc                       *string                   `json:"utc,omitempty"`
-	Links                     []string                  `json:"links,omitempty"`
-	Skills                    []string                  `json:"skills,omitempty"`
-	NotifyByEmail             bool                      `json:"notifyByEmail"`
-	Lang                      Lang                      `json:"lang"`
-	Subscriptions             []*Tension                `json:"subscriptions,omitempty"`
-	Watching                  []*Node                   `json:"watching,omitempty"`
-	Rights                    *UserRights               `json:"rights"`
-	Roles                     []*Node                   `json:"roles,omitempty"`
-	TensionsCreated           []*Tension                `json:"tensions_created,omitempty"`
-	TensionsAssigned          []*Tension                `json:"tensions_assigned,omitempty"`
-	Contracts                 []*Contract               `json:"contracts,omitempty"`
-	Reactions                 []*Reaction               `json:"reactions,omitempty"`
-	Events                    []*UserEvent              `json:"events,omitempty"`
-	MarkAllAsRead             *string                   `json:"markAllAsRead,omitempty"`
-	EventCount                *EventCount               `json:"event_count,omitempty"`
-	SubscriptionsAggregate    *TensionAggregateResult   `json:"subscriptionsAggregate,omitempty"`
-	WatchingAggregate         *NodeAggregateResult      `json:"watchingAggregate,omitempty"`
-	RolesAggregate            *NodeAggregateResult      `json:"rolesAggregate,omitempty"`
-	TensionsCreatedAggregate  *TensionAggregateResult   `json:"tensions_createdAggregate,omitempty"`
-	TensionsAssignedAggregate *TensionAggregateResult   `json:"tensions_assignedAggregate,omitempty"`
-	ContractsAggregate        *ContractAggregateResult  `json:"contractsAggregate,omitempty"`
-	ReactionsAggregate        *ReactionAggregateResult  `json:"reactionsAggregate,omitempty"`
-	EventsAggregate           *UserEventAggregateResult `json:"eventsAggregate,omitempty"`
+	ID                        string                          `json:"id"`
+	CreatedAt                 string                          `json:"createdAt"`
+	LastAck                   string                          `json:"lastAck"`
+	Username                  string                          `json:"username"`
+	Name                      *string                         `json:"name,omitempty"`
+	Email                     string                          `json:"email"`
+	Password                  string                          `json:"password"`
+	Bio                       *string                         `json:"bio,omitempty"`
+	Location                  *string                         `json:"location,omitempty"`
+	Utc                       *string                         `json:"utc,omitempty"`
+	Links                     []string                        `json:"links,omitempty"`
+	Skills                    []string                        `json:"skills,omitempty"`
+	NotifyByEmail             bool                            `json:"notifyByEmail"`
+	Lang                      Lang                            `json:"lang"`
+	EmailDelivery             *EmailDelivery                  `json:"emailDelivery,omitempty"`
+	EmailStatus               *EmailStatus                    `json:"emailStatus,omitempty"`
+	Matrixid                  *string                         `json:"matrixid,omitempty"`
+	Subscriptions             []*Tension                      `json:"subscriptions,omitempty"`
+	Watching                  []*Node                         `json:"watching,omitempty"`
+	Rights                    *UserRights                     `json:"rights"`
+	Roles                     []*Node                         `json:"roles,omitempty"`
+	TensionsCreated           []*Tension                      `json:"tensions_created,omitempty"`
+	TensionsAssigned          []*Tension                      `json:"tensions_assigned,omitempty"`
+	Contracts                 []*Contract                     `json:"contracts,omitempty"`
+	Reactions                 []*Reaction                     `json:"reactions,omitempty"`
+	Events                    []*UserEvent                    `json:"events,omitempty"`
+	NotifPreferences          []*NotifPreference              `json:"notif_preferences,omitempty"`
+	MarkAllAsRead             *string                         `json:"markAllAsRead,omitempty"`
+	EventCount                *EventCount                     `json:"event_count,omitempty"`
+	SubscriptionsAggregate    *TensionAggregateResult         `json:"subscriptionsAggregate,omitempty"`
+	WatchingAggregate         *NodeAggregateResult            `json:"watchingAggregate,omitempty"`
+	RolesAggregate            *NodeAggregateResult            `json:"rolesAggregate,omitempty"`
+	TensionsCreatedAggregate  *TensionAggregateResult         `json:"tensions_createdAggregate,omitempty"`
+	TensionsAssignedAggregate *TensionAggregateResult         `json:"tensions_assignedAggregate,omitempty"`
+	ContractsAggregate        *ContractAggregateResult        `json:"contractsAggregate,omitempty"`
+	ReactionsAggregate        *ReactionAggregateResult        `json:"reactionsAggregate,omitempty"`
+	EventsAggregate           *UserEventAggregateResult       `json:"eventsAggregate,omitempty"`
+	NotifPreferencesAggregate *NotifPreferenceAggregateResult `json:"notif_preferencesAggregate,omitempty"`
 }
 
 type UserAggregateResult struct {
@@ -2723,6 +3524,8 @@ type UserAggregateResult struct {
 	LocationMax      *string `json:"locationMax,omitempty"`
 	UtcMin           *string `json:"utcMin,omitempty"`
 	UtcMax           *string `json:"utcMax,omitempty"`
+	MatrixidMin      *string `json:"matrixidMin,omitempty"`
+	MatrixidMax      *string `json:"matrixidMax,omitempty"`
 	MarkAllAsReadMin *string `json:"markAllAsReadMin,omitempty"`
 	MarkAllAsReadMax *string `json:"markAllAsReadMax,omitempty"`
 }
@@ -2777,6 +3580,7 @@ type UserFilter struct {
 	Username *StringHashFilterStringRegExpFilter `json:"username,omitempty"`
 	Name     *StringRegExpFilter                 `json:"name,omitempty"`
 	Email    *StringHashFilter                   `json:"email,omitempty"`
+	Matrixid *StringHashFilter                   `json:"matrixid,omitempty"`
 	Has      []*UserHasFilter                    `json:"has,omitempty"`
 	And      []*UserFilter                       `json:"and,omitempty"`
 	Or       []*UserFilter                       `json:"or,omitempty"`
@@ -2790,56 +3594,64 @@ type UserOrder struct {
 }
 
 type UserPatch struct {
-	CreatedAt        *string         `json:"createdAt,omitempty"`
-	LastAck          *string         `json:"lastAck,omitempty"`
-	Name             *string         `json:"name,omitempty"`
-	Password         *string         `json:"password,omitempty"`
-	Bio              *string         `json:"bio,omitempty"`
-	Location         *string         `json:"location,omitempty"`
-	Utc              *string         `json:"utc,omitempty"`
-	Links            []string        `json:"links,omitempty"`
-	Skills           []string        `json:"skills,omitempty"`
-	NotifyByEmail    *bool           `json:"notifyByEmail,omitempty"`
-	Lang             *Lang           `json:"lang,omitempty"`
-	Subscriptions    []*TensionRef   `json:"subscriptions,omitempty"`
-	Watching         []*NodeRef      `json:"watching,omitempty"`
-	Rights           *UserRightsRef  `json:"rights,omitempty"`
-	Roles            []*NodeRef      `json:"roles,omitempty"`
-	TensionsCreated  []*TensionRef   `json:"tensions_created,omitempty"`
-	TensionsAssigned []*TensionRef   `json:"tensions_assigned,omitempty"`
-	Contracts        []*ContractRef  `json:"contracts,omitempty"`
-	Reactions        []*ReactionRef  `json:"reactions,omitempty"`
-	Events           []*UserEventRef `json:"events,omitempty"`
-	MarkAllAsRead    *string         `json:"markAllAsRead,omitempty"`
-	EventCount       *EventCountRef  `json:"event_count,omitempty"`
+	CreatedAt        *string               `json:"createdAt,omitempty"`
+	LastAck          *string               `json:"lastAck,omitempty"`
+	Name             *string               `json:"name,omitempty"`
+	Password         *string               `json:"password,omitempty"`
+	Bio              *string               `json:"bio,omitempty"`
+	Location         *string               `json:"location,omitempty"`
+	Utc              *string               `json:"utc,omitempty"`
+	Links            []string              `json:"links,omitempty"`
+	Skills           []string              `json:"skills,omitempty"`
+	NotifyByEmail    *bool                 `json:"notifyByEmail,omitempty"`
+	Lang             *Lang                 `json:"lang,omitempty"`
+	EmailDelivery    *EmailDelivery        `json:"emailDelivery,omitempty"`
+	EmailStatus      *EmailStatus          `json:"emailStatus,omitempty"`
+	Matrixid         *string               `json:"matrixid,omitempty"`
+	Subscriptions    []*TensionRef         `json:"subscriptions,omitempty"`
+	Watching         []*NodeRef            `json:"watching,omitempty"`
+	Rights           *UserRightsRef        `json:"rights,omitempty"`
+	Roles            []*NodeRef            `json:"roles,omitempty"`
+	TensionsCreated  []*TensionRef         `json:"tensions_created,omitempty"`
+	TensionsAssigned []*TensionRef         `json:"tensions_assigned,omitempty"`
+	Contracts        []*ContractRef        `json:"contracts,omitempty"`
+	Reactions        []*ReactionRef        `json:"reactions,omitempty"`
+	Events           []*UserEventRef       `json:"events,omitempty"`
+	NotifPreferences []*NotifPreferenceRef `json:"notif_preferences,omitempty"`
+	MarkAllAsRead    *string               `json:"markAllAsRead,omitempty"`
+	EventCount       *EventCountRef        `json:"event_count,omitempty"`
 }
 
 type UserRef struct {
-	ID               *string         `json:"id,omitempty"`
-	CreatedAt        *string         `json:"createdAt,omitempty"`
-	LastAck          *string         `json:"lastAck,omitempty"`
-	Username         *string         `json:"username,omitempty"`
-	Name             *string         `json:"name,omitempty"`
-	Email            *string         `json:"email,omitempty"`
-	Password         *string         `json:"password,omitempty"`
-	Bio              *string         `json:"bio,omitempty"`
-	Location         *string         `json:"location,omitempty"`
-	Utc              *string         `json:"utc,omitempty"`
-	Links            []string        `json:"links,omitempty"`
-	Skills           []string        `json:"skills,omitempty"`
-	NotifyByEmail    *bool           `json:"notifyByEmail,omitempty"`
-	Lang             *Lang           `json:"lang,omitempty"`
-	Subscriptions    []*TensionRef   `json:"subscriptions,omitempty"`
-	Watching         []*NodeRef      `json:"watching,omitempty"`
-	Rights           *UserRightsRef  `json:"rights,omitempty"`
-	Roles            []*NodeRef      `json:"roles,omitempty"`
-	TensionsCreated  []*TensionRef   `json:"tensions_created,omitempty"`
-	TensionsAssigned []*TensionRef   `json:"tensions_assigned,omitempty"`
-	Contracts        []*ContractRef  `json:"contracts,omitempty"`
-	Reactions        []*ReactionRef  `json:"reactions,omitempty"`
-	Events           []*UserEventRef `json:"events,omitempty"`
-	MarkAllAsRead    *string         `json:"markAllAsRead,omitempty"`
-	EventCount       *EventCountRef  `json:"event_count,omitempty"`
+	ID               *string               `json:"id,omitempty"`
+	CreatedAt        *string               `json:"createdAt,omitempty"`
+	LastAck          *string               `json:"lastAck,omitempty"`
+	Username         *string               `json:"username,omitempty"`
+	Name             *string               `json:"name,omitempty"`
+	Email            *string               `json:"email,omitempty"`
+	Password         *string               `json:"password,omitempty"`
+	Bio              *string               `json:"bio,omitempty"`
+	Location         *string               `json:"location,omitempty"`
+	Utc              *string               `json:"utc,omitempty"`
+	Links            []string              `json:"links,omitempty"`
+	Skills           []string              `json:"skills,omitempty"`
+	NotifyByEmail    *bool                 `json:"notifyByEmail,omitempty"`
+	Lang             *Lang                 `json:"lang,omitempty"`
+	EmailDelivery    *EmailDelivery        `json:"emailDelivery,omitempty"`
+	EmailStatus      *EmailStatus          `json:"emailStatus,omitempty"`
+	Matrixid         *string               `json:"matrixid,omitempty"`
+	Subscriptions    []*TensionRef         `json:"subscriptions,omitempty"`
+	Watching         []*NodeRef            `json:"watching,omitempty"`
+	Rights           *UserRightsRef        `json:"rights,omitempty"`
+	Roles            []*NodeRef            `json:"roles,omitempty"`
+	TensionsCreated  []*TensionRef         `json:"tensions_created,omitempty"`
+	TensionsAssigned []*TensionRef         `json:"tensions_assigned,omitempty"`
+	Contracts        []*ContractRef        `json:"contracts,omitempty"`
+	Reactions        []*ReactionRef        `json:"reactions,omitempty"`
+	Events           []*UserEventRef       `json:"events,omitempty"`
+	NotifPreferences []*NotifPreferenceRef `json:"notif_preferences,omitempty"`
+	MarkAllAsRead    *string               `json:"markAllAsRead,omitempty"`
+	EventCount       *EventCountRef        `json:"event_count,omitempty"`
 }
 
 type UserRights struct {
@@ -2961,6 +3773,106 @@ type WithinFilter struct {
 	Polygon *PolygonRef `json:"polygon"`
 }
 
+type AttachmentHasFilter string
+
+const (
+	AttachmentHasFilterCreatedBy   AttachmentHasFilter = "createdBy"
+	AttachmentHasFilterCreatedAt   AttachmentHasFilter = "createdAt"
+	AttachmentHasFilterTension     AttachmentHasFilter = "tension"
+	AttachmentHasFilterComment     AttachmentHasFilter = "comment"
+	AttachmentHasFilterFilename    AttachmentHasFilter = "filename"
+	AttachmentHasFilterContentType AttachmentHasFilter = "content_type"
+	AttachmentHasFilterSize        AttachmentHasFilter = "size"
+	AttachmentHasFilterKey         AttachmentHasFilter = "key"
+)
+
+var AllAttachmentHasFilter = []AttachmentHasFilter{
+	AttachmentHasFilterCreatedBy,
+	AttachmentHasFilterCreatedAt,
+	AttachmentHasFilterTension,
+	AttachmentHasFilterComment,
+	AttachmentHasFilterFilename,
+	AttachmentHasFilterContentType,
+	AttachmentHasFilterSize,
+	AttachmentHasFilterKey,
+}
+
+func (e AttachmentHasFilter) IsValid() bool {
+	switch e {
+	case AttachmentHasFilterCreatedBy, AttachmentHasFilterCreatedAt, AttachmentHasFilterTension, AttachmentHasFilterComment, AttachmentHasFilterFilename, AttachmentHasFilterContentType, AttachmentHasFilterSize, AttachmentHasFilterKey:
+		return true
+	}
+	return false
+}
+
+func (e AttachmentHasFilter) String() string {
+	return string(e)
+}
+
+func (e *AttachmentHasFilter) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AttachmentHasFilter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AttachmentHasFilter", str)
+	}
+	return nil
+}
+
+func (e AttachmentHasFilter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AttachmentOrderable string
+
+const (
+	AttachmentOrderableCreatedAt   AttachmentOrderable = "createdAt"
+	AttachmentOrderableFilename    AttachmentOrderable = "filename"
+	AttachmentOrderableContentType AttachmentOrderable = "content_type"
+	AttachmentOrderableSize        AttachmentOrderable = "size"
+	AttachmentOrderableKey         AttachmentOrderable = "key"
+)
+
+var AllAttachmentOrderable = []AttachmentOrderable{
+	AttachmentOrderableCreatedAt,
+	AttachmentOrderableFilename,
+	AttachmentOrderableContentType,
+	AttachmentOrderableSize,
+	AttachmentOrderableKey,
+}
+
+func (e AttachmentOrderable) IsValid() bool {
+	switch e {
+	case AttachmentOrderableCreatedAt, AttachmentOrderableFilename, AttachmentOrderableContentType, AttachmentOrderableSize, AttachmentOrderableKey:
+		return true
+	}
+	return false
+}
+
+func (e AttachmentOrderable) String() string {
+	return string(e)
+}
+
+func (e *AttachmentOrderable) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AttachmentOrderable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AttachmentOrderable", str)
+	}
+	return nil
+}
+
+func (e AttachmentOrderable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type BlobHasFilter string
 
 const (
@@ -3158,11 +4070,12 @@ func (e CardKindType) MarshalGQL(w io.Writer) {
 type CommentHasFilter string
 
 const (
-	CommentHasFilterCreatedBy CommentHasFilter = "createdBy"
-	CommentHasFilterCreatedAt CommentHasFilter = "createdAt"
-	CommentHasFilterUpdatedAt CommentHasFilter = "updatedAt"
-	CommentHasFilterMessage   CommentHasFilter = "message"
-	CommentHasFilterReactions CommentHasFilter = "reactions"
+	CommentHasFilterCreatedBy   CommentHasFilter = "createdBy"
+	CommentHasFilterCreatedAt   CommentHasFilter = "createdAt"
+	CommentHasFilterUpdatedAt   CommentHasFilter = "updatedAt"
+	CommentHasFilterMessage     CommentHasFilter = "message"
+	CommentHasFilterReactions   CommentHasFilter = "reactions"
+	CommentHasFilterAttachments CommentHasFilter = "attachments"
 )
 
 var AllCommentHasFilter = []CommentHasFilter{
@@ -3171,11 +4084,12 @@ var AllCommentHasFilter = []CommentHasFilter{
 	CommentHasFilterUpdatedAt,
 	CommentHasFilterMessage,
 	CommentHasFilterReactions,
+	CommentHasFilterAttachments,
 }
 
 func (e CommentHasFilter) IsValid() bool {
 	switch e {
-	case CommentHasFilterCreatedBy, CommentHasFilterCreatedAt, CommentHasFilterUpdatedAt, CommentHasFilterMessage, CommentHasFilterReactions:
+	case CommentHasFilterCreatedBy, CommentHasFilterCreatedAt, CommentHasFilterUpdatedAt, CommentHasFilterMessage, CommentHasFilterReactions, CommentHasFilterAttachments:
 		return true
 	}
 	return false
@@ -3514,50 +4428,142 @@ func (e DgraphIndex) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type ErrorBla string
+type EmailDelivery string
 
 const (
-	ErrorBlaContactCoordo       ErrorBla = "ContactCoordo"
-	ErrorBlaOrgaLimitReached    ErrorBla = "OrgaLimitReached"
-	ErrorBlaMemberLimitReached  ErrorBla = "MemberLimitReached"
-	ErrorBlaEmailLimitReached   ErrorBla = "EmailLimitReached"
-	ErrorBlaStorageLimitReached ErrorBla = "StorageLimitReached"
+	EmailDeliveryImmediate EmailDelivery = "Immediate"
+	EmailDeliveryHourly    EmailDelivery = "Hourly"
+	EmailDeliveryDaily     EmailDelivery = "Daily"
+	EmailDeliveryWeekly    EmailDelivery = "Weekly"
 )
 
-var AllErrorBla = []ErrorBla{
-	ErrorBlaContactCoordo,
-	ErrorBlaOrgaLimitReached,
-	ErrorBlaMemberLimitReached,
-	ErrorBlaEmailLimitReached,
-	ErrorBlaStorageLimitReached,
+var AllEmailDelivery = []EmailDelivery{
+	EmailDeliveryImmediate,
+	EmailDeliveryHourly,
+	EmailDeliveryDaily,
+	EmailDeliveryWeekly,
 }
 
-func (e ErrorBla) IsValid() bool {
+func (e EmailDelivery) IsValid() bool {
 	switch e {
-	case ErrorBlaContactCoordo, ErrorBlaOrgaLimitReached, ErrorBlaMemberLimitReached, ErrorBlaEmailLimitReached, ErrorBlaStorageLimitReached:
+	case EmailDeliveryImmediate, EmailDeliveryHourly, EmailDeliveryDaily, EmailDeliveryWeekly:
 		return true
 	}
 	return false
 }
 
-func (e ErrorBla) String() string {
+func (e EmailDelivery) String() string {
 	return string(e)
 }
 
-func (e *ErrorBla) UnmarshalGQL(v interface{}) error {
+func (e *EmailDelivery) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ErrorBla(str)
+	*e = EmailDelivery(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ErrorBla", str)
+		return fmt.Errorf("%s is not a valid EmailDelivery", str)
 	}
 	return nil
 }
 
-func (e ErrorBla) MarshalGQL(w io.Writer) {
+func (e EmailDelivery) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type EmailStatus string
+
+const (
+	EmailStatusDelivered   EmailStatus = "Delivered"
+	EmailStatusSoftBounced EmailStatus = "SoftBounced"
+	EmailStatusHardBounced EmailStatus = "HardBounced"
+	EmailStatusComplained  EmailStatus = "Complained"
+	EmailStatusHeld        EmailStatus = "Held"
+)
+
+var AllEmailStatus = []EmailStatus{
+	EmailStatusDelivered,
+	EmailStatusSoftBounced,
+	EmailStatusHardBounced,
+	EmailStatusComplained,
+	EmailStatusHeld,
+}
+
+func (e EmailStatus) IsValid() bool {
+	switch e {
+	case EmailStatusDelivered, EmailStatusSoftBounced, EmailStatusHardBounced, EmailStatusComplained, EmailStatusHeld:
+		return true
+	}
+	return false
+}
+
+func (e EmailStatus) String() string {
+	return string(e)
+}
+
+func (e *EmailStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EmailStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EmailStatus", str)
+	}
+	return nil
+}
+
+func (e EmailStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ErrorBla string
+
+const (
+	ErrorBlaContactCoordo       ErrorBla = "ContactCoordo"
+	ErrorBlaOrgaLimitReached    ErrorBla = "OrgaLimitReached"
+	ErrorBlaMemberLimitReached  ErrorBla = "MemberLimitReached"
+	ErrorBlaEmailLimitReached   ErrorBla = "EmailLimitReached"
+	ErrorBlaStorageLimitReached ErrorBla = "StorageLimitReached"
+)
+
+var AllErrorBla = []ErrorBla{
+	ErrorBlaContactCoordo,
+	ErrorBlaOrgaLimitReached,
+	ErrorBlaMemberLimitReached,
+	ErrorBlaEmailLimitReached,
+	ErrorBlaStorageLimitReached,
+}
+
+func (e ErrorBla) IsValid() bool {
+	switch e {
+	case ErrorBlaContactCoordo, ErrorBlaOrgaLimitReached, ErrorBlaMemberLimitReached, ErrorBlaEmailLimitReached, ErrorBlaStorageLimitReached:
+		return true
+	}
+	return false
+}
+
+func (e ErrorBla) String() string {
+	return string(e)
+}
+
+func (e *ErrorBla) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ErrorBla(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ErrorBla", str)
+	}
+	return nil
+}
+
+func (e ErrorBla) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
@@ -4058,6 +5064,153 @@ func (e Lang) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type MailingSender string
+
+const (
+	MailingSenderMember    MailingSender = "Member"
+	MailingSenderGuest     MailingSender = "Guest"
+	MailingSenderAllowlist MailingSender = "Allowlist"
+	MailingSenderAnyone    MailingSender = "Anyone"
+)
+
+var AllMailingSender = []MailingSender{
+	MailingSenderMember,
+	MailingSenderGuest,
+	MailingSenderAllowlist,
+	MailingSenderAnyone,
+}
+
+func (e MailingSender) IsValid() bool {
+	switch e {
+	case MailingSenderMember, MailingSenderGuest, MailingSenderAllowlist, MailingSenderAnyone:
+		return true
+	}
+	return false
+}
+
+func (e MailingSender) String() string {
+	return string(e)
+}
+
+func (e *MailingSender) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MailingSender(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MailingSender", str)
+	}
+	return nil
+}
+
+func (e MailingSender) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MailingSettingHasFilter string
+
+const (
+	MailingSettingHasFilterCreatedBy  MailingSettingHasFilter = "createdBy"
+	MailingSettingHasFilterCreatedAt  MailingSettingHasFilter = "createdAt"
+	MailingSettingHasFilterRootnameid MailingSettingHasFilter = "rootnameid"
+	MailingSettingHasFilterNameid     MailingSettingHasFilter = "nameid"
+	MailingSettingHasFilterEnabled    MailingSettingHasFilter = "enabled"
+	MailingSettingHasFilterAlias      MailingSettingHasFilter = "alias"
+	MailingSettingHasFilterSenders    MailingSettingHasFilter = "senders"
+	MailingSettingHasFilterAllowlist  MailingSettingHasFilter = "allowlist"
+	MailingSettingHasFilterType       MailingSettingHasFilter = "type_"
+	MailingSettingHasFilterLabels     MailingSettingHasFilter = "labels"
+)
+
+var AllMailingSettingHasFilter = []MailingSettingHasFilter{
+	MailingSettingHasFilterCreatedBy,
+	MailingSettingHasFilterCreatedAt,
+	MailingSettingHasFilterRootnameid,
+	MailingSettingHasFilterNameid,
+	MailingSettingHasFilterEnabled,
+	MailingSettingHasFilterAlias,
+	MailingSettingHasFilterSenders,
+	MailingSettingHasFilterAllowlist,
+	MailingSettingHasFilterType,
+	MailingSettingHasFilterLabels,
+}
+
+func (e MailingSettingHasFilter) IsValid() bool {
+	switch e {
+	case MailingSettingHasFilterCreatedBy, MailingSettingHasFilterCreatedAt, MailingSettingHasFilterRootnameid, MailingSettingHasFilterNameid, MailingSettingHasFilterEnabled, MailingSettingHasFilterAlias, MailingSettingHasFilterSenders, MailingSettingHasFilterAllowlist, MailingSettingHasFilterType, MailingSettingHasFilterLabels:
+		return true
+	}
+	return false
+}
+
+func (e MailingSettingHasFilter) String() string {
+	return string(e)
+}
+
+func (e *MailingSettingHasFilter) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MailingSettingHasFilter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MailingSettingHasFilter", str)
+	}
+	return nil
+}
+
+func (e MailingSettingHasFilter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MailingSettingOrderable string
+
+const (
+	MailingSettingOrderableCreatedAt  MailingSettingOrderable = "createdAt"
+	MailingSettingOrderableRootnameid MailingSettingOrderable = "rootnameid"
+	MailingSettingOrderableNameid     MailingSettingOrderable = "nameid"
+	MailingSettingOrderableAlias      MailingSettingOrderable = "alias"
+)
+
+var AllMailingSettingOrderable = []MailingSettingOrderable{
+	MailingSettingOrderableCreatedAt,
+	MailingSettingOrderableRootnameid,
+	MailingSettingOrderableNameid,
+	MailingSettingOrderableAlias,
+}
+
+func (e MailingSettingOrderable) IsValid() bool {
+	switch e {
+	case MailingSettingOrderableCreatedAt, MailingSettingOrderableRootnameid, MailingSettingOrderableNameid, MailingSettingOrderableAlias:
+		return true
+	}
+	return false
+}
+
+func (e MailingSettingOrderable) String() string {
+	return string(e)
+}
+
+func (e *MailingSettingOrderable) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MailingSettingOrderable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MailingSettingOrderable", str)
+	}
+	return nil
+}
+
+func (e MailingSettingOrderable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type MandateHasFilter string
 
 const (
@@ -4148,6 +5301,98 @@ func (e MandateOrderable) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type MatrixRoomHasFilter string
+
+const (
+	MatrixRoomHasFilterCreatedBy  MatrixRoomHasFilter = "createdBy"
+	MatrixRoomHasFilterCreatedAt  MatrixRoomHasFilter = "createdAt"
+	MatrixRoomHasFilterRootnameid MatrixRoomHasFilter = "rootnameid"
+	MatrixRoomHasFilterNameid     MatrixRoomHasFilter = "nameid"
+	MatrixRoomHasFilterRoomid     MatrixRoomHasFilter = "roomid"
+)
+
+var AllMatrixRoomHasFilter = []MatrixRoomHasFilter{
+	MatrixRoomHasFilterCreatedBy,
+	MatrixRoomHasFilterCreatedAt,
+	MatrixRoomHasFilterRootnameid,
+	MatrixRoomHasFilterNameid,
+	MatrixRoomHasFilterRoomid,
+}
+
+func (e MatrixRoomHasFilter) IsValid() bool {
+	switch e {
+	case MatrixRoomHasFilterCreatedBy, MatrixRoomHasFilterCreatedAt, MatrixRoomHasFilterRootnameid, MatrixRoomHasFilterNameid, MatrixRoomHasFilterRoomid:
+		return true
+	}
+	return false
+}
+
+func (e MatrixRoomHasFilter) String() string {
+	return string(e)
+}
+
+func (e *MatrixRoomHasFilter) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MatrixRoomHasFilter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MatrixRoomHasFilter", str)
+	}
+	return nil
+}
+
+func (e MatrixRoomHasFilter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MatrixRoomOrderable string
+
+const (
+	MatrixRoomOrderableCreatedAt  MatrixRoomOrderable = "createdAt"
+	MatrixRoomOrderableRootnameid MatrixRoomOrderable = "rootnameid"
+	MatrixRoomOrderableNameid     MatrixRoomOrderable = "nameid"
+	MatrixRoomOrderableRoomid     MatrixRoomOrderable = "roomid"
+)
+
+var AllMatrixRoomOrderable = []MatrixRoomOrderable{
+	MatrixRoomOrderableCreatedAt,
+	MatrixRoomOrderableRootnameid,
+	MatrixRoomOrderableNameid,
+	MatrixRoomOrderableRoomid,
+}
+
+func (e MatrixRoomOrderable) IsValid() bool {
+	switch e {
+	case MatrixRoomOrderableCreatedAt, MatrixRoomOrderableRootnameid, MatrixRoomOrderableNameid, MatrixRoomOrderableRoomid:
+		return true
+	}
+	return false
+}
+
+func (e MatrixRoomOrderable) String() string {
+	return string(e)
+}
+
+func (e *MatrixRoomOrderable) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MatrixRoomOrderable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MatrixRoomOrderable", str)
+	}
+	return nil
+}
+
+func (e MatrixRoomOrderable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Mode string
 
 const (
@@ -4327,6 +5572,7 @@ const (
 	NodeHasFilterChildren              NodeHasFilter = "children"
 	NodeHasFilterLabels                NodeHasFilter = "labels"
 	NodeHasFilterRoles                 NodeHasFilter = "roles"
+	NodeHasFilterTemplates             NodeHasFilter = "templates"
 	NodeHasFilterProjects              NodeHasFilter = "projects"
 	NodeHasFilterPinned                NodeHasFilter = "pinned"
 	NodeHasFilterRoleExt               NodeHasFilter = "role_ext"
@@ -4363,6 +5609,7 @@ var AllNodeHasFilter = []NodeHasFilter{
 	NodeHasFilterChildren,
 	NodeHasFilterLabels,
 	NodeHasFilterRoles,
+	NodeHasFilterTemplates,
 	NodeHasFilterProjects,
 	NodeHasFilterPinned,
 	NodeHasFilterRoleExt,
@@ -4375,7 +5622,7 @@ var AllNodeHasFilter = []NodeHasFilter{
 
 func (e NodeHasFilter) IsValid() bool {
 	switch e {
-	case NodeHasFilterCreatedBy, NodeHasFilterCreatedAt, NodeHasFilterUpdatedAt, NodeHasFilterNameid, NodeHasFilterRootnameid, NodeHasFilterSource, NodeHasFilterName, NodeHasFilterAbout, NodeHasFilterSkills, NodeHasFilterIsRoot, NodeHasFilterParent, NodeHasFilterType, NodeHasFilterTensionsOut, NodeHasFilterTensionsIn, NodeHasFilterVisibility, NodeHasFilterMode, NodeHasFilterRights, NodeHasFilterIsArchived, NodeHasFilterIsPersonal, NodeHasFilterUserCanJoin, NodeHasFilterGuestCanCreateTension, NodeHasFilterWatchers, NodeHasFilterChildren, NodeHasFilterLabels, NodeHasFilterRoles, NodeHasFilterProjects, NodeHasFilterPinned, NodeHasFilterRoleExt, NodeHasFilterRoleType, NodeHasFilterColor, NodeHasFilterFirstLink, NodeHasFilterContracts, NodeHasFilterEventsHistory:
+	case NodeHasFilterCreatedBy, NodeHasFilterCreatedAt, NodeHasFilterUpdatedAt, NodeHasFilterNameid, NodeHasFilterRootnameid, NodeHasFilterSource, NodeHasFilterName, NodeHasFilterAbout, NodeHasFilterSkills, NodeHasFilterIsRoot, NodeHasFilterParent, NodeHasFilterType, NodeHasFilterTensionsOut, NodeHasFilterTensionsIn, NodeHasFilterVisibility, NodeHasFilterMode, NodeHasFilterRights, NodeHasFilterIsArchived, NodeHasFilterIsPersonal, NodeHasFilterUserCanJoin, NodeHasFilterGuestCanCreateTension, NodeHasFilterWatchers, NodeHasFilterChildren, NodeHasFilterLabels, NodeHasFilterRoles, NodeHasFilterTemplates, NodeHasFilterProjects, NodeHasFilterPinned, NodeHasFilterRoleExt, NodeHasFilterRoleType, NodeHasFilterColor, NodeHasFilterFirstLink, NodeHasFilterContracts, NodeHasFilterEventsHistory:
 		return true
 	}
 	return false
@@ -4551,32 +5798,128 @@ var AllNodeVisibility = []NodeVisibility{
 	NodeVisibilitySecret,
 }
 
-func (e NodeVisibility) IsValid() bool {
+func (e NodeVisibility) IsValid() bool {
+	switch e {
+	case NodeVisibilityPublic, NodeVisibilityPrivate, NodeVisibilitySecret:
+		return true
+	}
+	return false
+}
+
+func (e NodeVisibility) String() string {
+	return string(e)
+}
+
+func (e *NodeVisibility) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NodeVisibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NodeVisibility", str)
+	}
+	return nil
+}
+
+func (e NodeVisibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotifChannel string
+
+const (
+	NotifChannelApp   NotifChannel = "App"
+	NotifChannelEmail NotifChannel = "Email"
+	NotifChannelNone  NotifChannel = "None"
+)
+
+var AllNotifChannel = []NotifChannel{
+	NotifChannelApp,
+	NotifChannelEmail,
+	NotifChannelNone,
+}
+
+func (e NotifChannel) IsValid() bool {
+	switch e {
+	case NotifChannelApp, NotifChannelEmail, NotifChannelNone:
+		return true
+	}
+	return false
+}
+
+func (e NotifChannel) String() string {
+	return string(e)
+}
+
+func (e *NotifChannel) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotifChannel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotifChannel", str)
+	}
+	return nil
+}
+
+func (e NotifChannel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotifEventGroup string
+
+const (
+	NotifEventGroupCreation   NotifEventGroup = "Creation"
+	NotifEventGroupStatus     NotifEventGroup = "Status"
+	NotifEventGroupComment    NotifEventGroup = "Comment"
+	NotifEventGroupMandate    NotifEventGroup = "Mandate"
+	NotifEventGroupMembership NotifEventGroup = "Membership"
+	NotifEventGroupContract   NotifEventGroup = "Contract"
+	NotifEventGroupNotice     NotifEventGroup = "Notice"
+	NotifEventGroupOther      NotifEventGroup = "Other"
+)
+
+var AllNotifEventGroup = []NotifEventGroup{
+	NotifEventGroupCreation,
+	NotifEventGroupStatus,
+	NotifEventGroupComment,
+	NotifEventGroupMandate,
+	NotifEventGroupMembership,
+	NotifEventGroupContract,
+	NotifEventGroupNotice,
+	NotifEventGroupOther,
+}
+
+func (e NotifEventGroup) IsValid() bool {
 	switch e {
-	case NodeVisibilityPublic, NodeVisibilityPrivate, NodeVisibilitySecret:
+	case NotifEventGroupCreation, NotifEventGroupStatus, NotifEventGroupComment, NotifEventGroupMandate, NotifEventGroupMembership, NotifEventGroupContract, NotifEventGroupNotice, NotifEventGroupOther:
 		return true
 	}
 	return false
 }
 
-func (e NodeVisibility) String() string {
+func (e NotifEventGroup) String() string {
 	return string(e)
 }
 
-func (e *NodeVisibility) UnmarshalGQL(v interface{}) error {
+func (e *NotifEventGroup) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = NodeVisibility(str)
+	*e = NotifEventGroup(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid NodeVisibility", str)
+		return fmt.Errorf("%s is not a valid NotifEventGroup", str)
 	}
 	return nil
 }
 
-func (e NodeVisibility) MarshalGQL(w io.Writer) {
+func (e NotifEventGroup) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
@@ -4676,6 +6019,157 @@ func (e NotifOrderable) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type NotifPreferenceHasFilter string
+
+const (
+	NotifPreferenceHasFilterPreferenceid NotifPreferenceHasFilter = "preferenceid"
+	NotifPreferenceHasFilterUser         NotifPreferenceHasFilter = "user"
+	NotifPreferenceHasFilterRootnameid   NotifPreferenceHasFilter = "rootnameid"
+	NotifPreferenceHasFilterReason       NotifPreferenceHasFilter = "reason"
+	NotifPreferenceHasFilterEventGroup   NotifPreferenceHasFilter = "event_group"
+	NotifPreferenceHasFilterChannel      NotifPreferenceHasFilter = "channel"
+)
+
+var AllNotifPreferenceHasFilter = []NotifPreferenceHasFilter{
+	NotifPreferenceHasFilterPreferenceid,
+	NotifPreferenceHasFilterUser,
+	NotifPreferenceHasFilterRootnameid,
+	NotifPreferenceHasFilterReason,
+	NotifPreferenceHasFilterEventGroup,
+	NotifPreferenceHasFilterChannel,
+}
+
+func (e NotifPreferenceHasFilter) IsValid() bool {
+	switch e {
+	case NotifPreferenceHasFilterPreferenceid, NotifPreferenceHasFilterUser, NotifPreferenceHasFilterRootnameid, NotifPreferenceHasFilterReason, NotifPreferenceHasFilterEventGroup, NotifPreferenceHasFilterChannel:
+		return true
+	}
+	return false
+}
+
+func (e NotifPreferenceHasFilter) String() string {
+	return string(e)
+}
+
+func (e *NotifPreferenceHasFilter) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotifPreferenceHasFilter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotifPreferenceHasFilter", str)
+	}
+	return nil
+}
+
+func (e NotifPreferenceHasFilter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotifPreferenceOrderable string
+
+const (
+	NotifPreferenceOrderablePreferenceid NotifPreferenceOrderable = "preferenceid"
+	NotifPreferenceOrderableRootnameid   NotifPreferenceOrderable = "rootnameid"
+)
+
+var AllNotifPreferenceOrderable = []NotifPreferenceOrderable{
+	NotifPreferenceOrderablePreferenceid,
+	NotifPreferenceOrderableRootnameid,
+}
+
+func (e NotifPreferenceOrderable) IsValid() bool {
+	switch e {
+	case NotifPreferenceOrderablePreferenceid, NotifPreferenceOrderableRootnameid:
+		return true
+	}
+	return false
+}
+
+func (e NotifPreferenceOrderable) String() string {
+	return string(e)
+}
+
+func (e *NotifPreferenceOrderable) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotifPreferenceOrderable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotifPreferenceOrderable", str)
+	}
+	return nil
+}
+
+func (e NotifPreferenceOrderable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotifPreferenceReason string
+
+const (
+	NotifPreferenceReasonInvited       NotifPreferenceReason = "Invited"
+	NotifPreferenceReasonLinkCandidate NotifPreferenceReason = "LinkCandidate"
+	NotifPreferenceReasonCandidate     NotifPreferenceReason = "Candidate"
+	NotifPreferenceReasonParticipant   NotifPreferenceReason = "Participant"
+	NotifPreferenceReasonCoordo        NotifPreferenceReason = "Coordo"
+	NotifPreferenceReasonPeer          NotifPreferenceReason = "Peer"
+	NotifPreferenceReasonFirstLink     NotifPreferenceReason = "FirstLink"
+	NotifPreferenceReasonAssignee      NotifPreferenceReason = "Assignee"
+	NotifPreferenceReasonSubscriber    NotifPreferenceReason = "Subscriber"
+	NotifPreferenceReasonMentioned     NotifPreferenceReason = "Mentioned"
+	NotifPreferenceReasonAlert         NotifPreferenceReason = "Alert"
+	NotifPreferenceReasonAnnouncement  NotifPreferenceReason = "Announcement"
+)
+
+var AllNotifPreferenceReason = []NotifPreferenceReason{
+	NotifPreferenceReasonInvited,
+	NotifPreferenceReasonLinkCandidate,
+	NotifPreferenceReasonCandidate,
+	NotifPreferenceReasonParticipant,
+	NotifPreferenceReasonCoordo,
+	NotifPreferenceReasonPeer,
+	NotifPreferenceReasonFirstLink,
+	NotifPreferenceReasonAssignee,
+	NotifPreferenceReasonSubscriber,
+	NotifPreferenceReasonMentioned,
+	NotifPreferenceReasonAlert,
+	NotifPreferenceReasonAnnouncement,
+}
+
+func (e NotifPreferenceReason) IsValid() bool {
+	switch e {
+	case NotifPreferenceReasonInvited, NotifPreferenceReasonLinkCandidate, NotifPreferenceReasonCandidate, NotifPreferenceReasonParticipant, NotifPreferenceReasonCoordo, NotifPreferenceReasonPeer, NotifPreferenceReasonFirstLink, NotifPreferenceReasonAssignee, NotifPreferenceReasonSubscriber, NotifPreferenceReasonMentioned, NotifPreferenceReasonAlert, NotifPreferenceReasonAnnouncement:
+		return true
+	}
+	return false
+}
+
+func (e NotifPreferenceReason) String() string {
+	return string(e)
+}
+
+func (e *NotifPreferenceReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotifPreferenceReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotifPreferenceReason", str)
+	}
+	return nil
+}
+
+func (e NotifPreferenceReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PendingUserHasFilter string
 
 const (
@@ -5598,6 +7092,120 @@ func (e ReactionOrderable) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type RecurrenceHasFilter string
+
+const (
+	RecurrenceHasFilterCreatedBy  RecurrenceHasFilter = "createdBy"
+	RecurrenceHasFilterCreatedAt  RecurrenceHasFilter = "createdAt"
+	RecurrenceHasFilterRootnameid RecurrenceHasFilter = "rootnameid"
+	RecurrenceHasFilterReceiverid RecurrenceHasFilter = "receiverid"
+	RecurrenceHasFilterRrule      RecurrenceHasFilter = "rrule"
+	RecurrenceHasFilterDtstart    RecurrenceHasFilter = "dtstart"
+	RecurrenceHasFilterPaused     RecurrenceHasFilter = "paused"
+	RecurrenceHasFilterTitle      RecurrenceHasFilter = "title"
+	RecurrenceHasFilterTemplate   RecurrenceHasFilter = "template"
+	RecurrenceHasFilterTension    RecurrenceHasFilter = "tension"
+	RecurrenceHasFilterNextAt     RecurrenceHasFilter = "next_at"
+	RecurrenceHasFilterCount      RecurrenceHasFilter = "count"
+)
+
+var AllRecurrenceHasFilter = []RecurrenceHasFilter{
+	RecurrenceHasFilterCreatedBy,
+	RecurrenceHasFilterCreatedAt,
+	RecurrenceHasFilterRootnameid,
+	RecurrenceHasFilterReceiverid,
+	RecurrenceHasFilterRrule,
+	RecurrenceHasFilterDtstart,
+	RecurrenceHasFilterPaused,
+	RecurrenceHasFilterTitle,
+	RecurrenceHasFilterTemplate,
+	RecurrenceHasFilterTension,
+	RecurrenceHasFilterNextAt,
+	RecurrenceHasFilterCount,
+}
+
+func (e RecurrenceHasFilter) IsValid() bool {
+	switch e {
+	case RecurrenceHasFilterCreatedBy, RecurrenceHasFilterCreatedAt, RecurrenceHasFilterRootnameid, RecurrenceHasFilterReceiverid, RecurrenceHasFilterRrule, RecurrenceHasFilterDtstart, RecurrenceHasFilterPaused, RecurrenceHasFilterTitle, RecurrenceHasFilterTemplate, RecurrenceHasFilterTension, RecurrenceHasFilterNextAt, RecurrenceHasFilterCount:
+		return true
+	}
+	return false
+}
+
+func (e RecurrenceHasFilter) String() string {
+	return string(e)
+}
+
+func (e *RecurrenceHasFilter) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecurrenceHasFilter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecurrenceHasFilter", str)
+	}
+	return nil
+}
+
+func (e RecurrenceHasFilter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RecurrenceOrderable string
+
+const (
+	RecurrenceOrderableCreatedAt  RecurrenceOrderable = "createdAt"
+	RecurrenceOrderableRootnameid RecurrenceOrderable = "rootnameid"
+	RecurrenceOrderableReceiverid RecurrenceOrderable = "receiverid"
+	RecurrenceOrderableRrule      RecurrenceOrderable = "rrule"
+	RecurrenceOrderableDtstart    RecurrenceOrderable = "dtstart"
+	RecurrenceOrderableTitle      RecurrenceOrderable = "title"
+	RecurrenceOrderableNextAt     RecurrenceOrderable = "next_at"
+	RecurrenceOrderableCount      RecurrenceOrderable = "count"
+)
+
+var AllRecurrenceOrderable = []RecurrenceOrderable{
+	RecurrenceOrderableCreatedAt,
+	RecurrenceOrderableRootnameid,
+	RecurrenceOrderableReceiverid,
+	RecurrenceOrderableRrule,
+	RecurrenceOrderableDtstart,
+	RecurrenceOrderableTitle,
+	RecurrenceOrderableNextAt,
+	RecurrenceOrderableCount,
+}
+
+func (e RecurrenceOrderable) IsValid() bool {
+	switch e {
+	case RecurrenceOrderableCreatedAt, RecurrenceOrderableRootnameid, RecurrenceOrderableReceiverid, RecurrenceOrderableRrule, RecurrenceOrderableDtstart, RecurrenceOrderableTitle, RecurrenceOrderableNextAt, RecurrenceOrderableCount:
+		return true
+	}
+	return false
+}
+
+func (e RecurrenceOrderable) String() string {
+	return string(e)
+}
+
+func (e *RecurrenceOrderable) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecurrenceOrderable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecurrenceOrderable", str)
+	}
+	return nil
+}
+
+func (e RecurrenceOrderable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RoleExtHasFilter string
 
 const (
@@ -5807,31 +7415,46 @@ func (e TensionAction) MarshalGQL(w io.Writer) {
 type TensionEvent string
 
 const (
-	TensionEventCreated         TensionEvent = "Created"
-	TensionEventReopened        TensionEvent = "Reopened"
-	TensionEventClosed          TensionEvent = "Closed"
-	TensionEventTitleUpdated    TensionEvent = "TitleUpdated"
-	TensionEventTypeUpdated     TensionEvent = "TypeUpdated"
-	TensionEventCommentPushed   TensionEvent = "CommentPushed"
-	TensionEventAssigneeAdded   TensionEvent = "AssigneeAdded"
-	TensionEventAssigneeRemoved TensionEvent = "AssigneeRemoved"
-	TensionEventLabelAdded      TensionEvent = "LabelAdded"
-	TensionEventLabelRemoved    TensionEvent = "LabelRemoved"
-	TensionEventBlobCreated     TensionEvent = "BlobCreated"
-	TensionEventBlobCommitted   TensionEvent = "BlobCommitted"
-	TensionEventMentioned       TensionEvent = "Mentioned"
-	TensionEventPinned          TensionEvent = "Pinned"
-	TensionEventUnpinned        TensionEvent = "Unpinned"
-	TensionEventBlobPushed      TensionEvent = "BlobPushed"
-	TensionEventBlobArchived    TensionEvent = "BlobArchived"
-	TensionEventBlobUnarchived  TensionEvent = "BlobUnarchived"
-	TensionEventUserJoined      TensionEvent = "UserJoined"
-	TensionEventUserLeft        TensionEvent = "UserLeft"
-	TensionEventMemberLinked    TensionEvent = "MemberLinked"
-	TensionEventMemberUnlinked  TensionEvent = "MemberUnlinked"
-	TensionEventAuthority       TensionEvent = "Authority"
-	TensionEventVisibility      TensionEvent = "Visibility"
-	TensionEventMoved           TensionEvent = "Moved"
+	TensionEventCreated             TensionEvent = "Created"
+	TensionEventReopened            TensionEvent = "Reopened"
+	TensionEventClosed              TensionEvent = "Closed"
+	TensionEventTitleUpdated        TensionEvent = "TitleUpdated"
+	TensionEventTypeUpdated         TensionEvent = "TypeUpdated"
+	TensionEventCommentPushed       TensionEvent = "CommentPushed"
+	TensionEventAssigneeAdded       TensionEvent = "AssigneeAdded"
+	TensionEventAssigneeRemoved     TensionEvent = "AssigneeRemoved"
+	TensionEventLabelAdded          TensionEvent = "LabelAdded"
+	TensionEventLabelRemoved        TensionEvent = "LabelRemoved"
+	TensionEventBlobCreated         TensionEvent = "BlobCreated"
+	TensionEventBlobCommitted       TensionEvent = "BlobCommitted"
+	TensionEventMentioned           TensionEvent = "Mentioned"
+	TensionEventPinned              TensionEvent = "Pinned"
+	TensionEventUnpinned            TensionEvent = "Unpinned"
+	TensionEventBlobPushed          TensionEvent = "BlobPushed"
+	TensionEventBlobArchived        TensionEvent = "BlobArchived"
+	TensionEventBlobUnarchived      TensionEvent = "BlobUnarchived"
+	TensionEventUserJoined          TensionEvent = "UserJoined"
+	TensionEventUserLeft            TensionEvent = "UserLeft"
+	TensionEventMemberLinked        TensionEvent = "MemberLinked"
+	TensionEventMemberUnlinked      TensionEvent = "MemberUnlinked"
+	TensionEventAuthority           TensionEvent = "Authority"
+	TensionEventVisibility          TensionEvent = "Visibility"
+	TensionEventMoved               TensionEvent = "Moved"
+	TensionEventOwnerAdded          TensionEvent = "OwnerAdded"
+	TensionEventOwnerRemoved        TensionEvent = "OwnerRemoved"
+	TensionEventOwnerTransferred    TensionEvent = "OwnerTransferred"
+	TensionEventDueDateUpdated      TensionEvent = "DueDateUpdated"
+	TensionEventBlockAdded          TensionEvent = "BlockAdded"
+	TensionEventBlockRemoved        TensionEvent = "BlockRemoved"
+	TensionEventDuplicateUpdated    TensionEvent = "DuplicateUpdated"
+	TensionEventParentUpdated       TensionEvent = "ParentUpdated"
+	TensionEventRelatedAdded        TensionEvent = "RelatedAdded"
+	TensionEventRelatedRemoved      TensionEvent = "RelatedRemoved"
+	TensionEventMerged              TensionEvent = "Merged"
+	TensionEventConfidentialUpdated TensionEvent = "ConfidentialUpdated"
+	TensionEventParticipantAdded    TensionEvent = "ParticipantAdded"
+	TensionEventParticipantRemoved  TensionEvent = "ParticipantRemoved"
+	TensionEventProjectAdded        TensionEvent = "ProjectAdded"
 )
 
 var AllTensionEvent = []TensionEvent{
@@ -5860,11 +7483,26 @@ var AllTensionEvent = []TensionEvent{
 	TensionEventAuthority,
 	TensionEventVisibility,
 	TensionEventMoved,
+	TensionEventOwnerAdded,
+	TensionEventOwnerRemoved,
+	TensionEventOwnerTransferred,
+	TensionEventDueDateUpdated,
+	TensionEventBlockAdded,
+	TensionEventBlockRemoved,
+	TensionEventDuplicateUpdated,
+	TensionEventParentUpdated,
+	TensionEventRelatedAdded,
+	TensionEventRelatedRemoved,
+	TensionEventMerged,
+	TensionEventConfidentialUpdated,
+	TensionEventParticipantAdded,
+	TensionEventParticipantRemoved,
+	TensionEventProjectAdded,
 }
 
 func (e TensionEvent) IsValid() bool {
 	switch e {
-	case TensionEventCreated, TensionEventReopened, TensionEventClosed, TensionEventTitleUpdated, TensionEventTypeUpdated, TensionEventCommentPushed, TensionEventAssigneeAdded, TensionEventAssigneeRemoved, TensionEventLabelAdded, TensionEventLabelRemoved, TensionEventBlobCreated, TensionEventBlobCommitted, TensionEventMentioned, TensionEventPinned, TensionEventUnpinned, TensionEventBlobPushed, TensionEventBlobArchived, TensionEventBlobUnarchived, TensionEventUserJoined, TensionEventUserLeft, TensionEventMemberLinked, TensionEventMemberUnlinked, TensionEventAuthority, TensionEventVisibility, TensionEventMoved:
+	case TensionEventCreated, TensionEventReopened, TensionEventClosed, TensionEventTitleUpdated, TensionEventTypeUpdated, TensionEventCommentPushed, TensionEventAssigneeAdded, TensionEventAssigneeRemoved, TensionEventLabelAdded, TensionEventLabelRemoved, TensionEventBlobCreated, TensionEventBlobCommitted, TensionEventMentioned, TensionEventPinned, TensionEventUnpinned, TensionEventBlobPushed, TensionEventBlobArchived, TensionEventBlobUnarchived, TensionEventUserJoined, TensionEventUserLeft, TensionEventMemberLinked, TensionEventMemberUnlinked, TensionEventAuthority, TensionEventVisibility, TensionEventMoved, TensionEventOwnerAdded, TensionEventOwnerRemoved, TensionEventOwnerTransferred, TensionEventDueDateUpdated, TensionEventBlockAdded, TensionEventBlockRemoved, TensionEventDuplicateUpdated, TensionEventParentUpdated, TensionEventRelatedAdded, TensionEventRelatedRemoved, TensionEventMerged, TensionEventConfidentialUpdated, TensionEventParticipantAdded, TensionEventParticipantRemoved, TensionEventProjectAdded:
 		return true
 	}
 	return false
@@ -5906,7 +7544,10 @@ const (
 	TensionHasFilterType            TensionHasFilter = "type_"
 	TensionHasFilterStatus          TensionHasFilter = "status"
 	TensionHasFilterAction          TensionHasFilter = "action"
+	TensionHasFilterDueDate         TensionHasFilter = "dueDate"
+	TensionHasFilterConfidential    TensionHasFilter = "confidential"
 	TensionHasFilterAssignees       TensionHasFilter = "assignees"
+	TensionHasFilterParticipants    TensionHasFilter = "participants"
 	TensionHasFilterLabels          TensionHasFilter = "labels"
 	TensionHasFilterComments        TensionHasFilter = "comments"
 	TensionHasFilterBlobs           TensionHasFilter = "blobs"
@@ -5915,6 +7556,15 @@ const (
 	TensionHasFilterContracts       TensionHasFilter = "contracts"
 	TensionHasFilterSubscribers     TensionHasFilter = "subscribers"
 	TensionHasFilterProjectStatuses TensionHasFilter = "project_statuses"
+	TensionHasFilterTemplate        TensionHasFilter = "template"
+	TensionHasFilterBlocks          TensionHasFilter = "blocks"
+	TensionHasFilterBlockedBy       TensionHasFilter = "blocked_by"
+	TensionHasFilterDuplicateOf     TensionHasFilter = "duplicate_of"
+	TensionHasFilterDuplicates      TensionHasFilter = "duplicates"
+	TensionHasFilterParent          TensionHasFilter = "parent"
+	TensionHasFilterSubtasks        TensionHasFilter = "subtasks"
+	TensionHasFilterRelated         TensionHasFilter = "related"
+	TensionHasFilterAttachments     TensionHasFilter = "attachments"
 	TensionHasFilterNComments       TensionHasFilter = "n_comments"
 )
 
@@ -5931,7 +7581,10 @@ var AllTensionHasFilter = []TensionHasFilter{
 	TensionHasFilterType,
 	TensionHasFilterStatus,
 	TensionHasFilterAction,
+	TensionHasFilterDueDate,
+	TensionHasFilterConfidential,
 	TensionHasFilterAssignees,
+	TensionHasFilterParticipants,
 	TensionHasFilterLabels,
 	TensionHasFilterComments,
 	TensionHasFilterBlobs,
@@ -5940,12 +7593,21 @@ var AllTensionHasFilter = []TensionHasFilter{
 	TensionHasFilterContracts,
 	TensionHasFilterSubscribers,
 	TensionHasFilterProjectStatuses,
+	TensionHasFilterTemplate,
+	TensionHasFilterBlocks,
+	TensionHasFilterBlockedBy,
+	TensionHasFilterDuplicateOf,
+	TensionHasFilterDuplicates,
+	TensionHasFilterParent,
+	TensionHasFilterSubtasks,
+	TensionHasFilterRelated,
+	TensionHasFilterAttachments,
 	TensionHasFilterNComments,
 }
 
 func (e TensionHasFilter) IsValid() bool {
 	switch e {
-	case TensionHasFilterCreatedBy, TensionHasFilterCreatedAt, TensionHasFilterUpdatedAt, TensionHasFilterMessage, TensionHasFilterEmitter, TensionHasFilterEmitterid, TensionHasFilterReceiver, TensionHasFilterReceiverid, TensionHasFilterTitle, TensionHasFilterType, TensionHasFilterStatus, TensionHasFilterAction, TensionHasFilterAssignees, TensionHasFilterLabels, TensionHasFilterComments, TensionHasFilterBlobs, TensionHasFilterHistory, TensionHasFilterMentions, TensionHasFilterContracts, TensionHasFilterSubscribers, TensionHasFilterProjectStatuses, TensionHasFilterNComments:
+	case TensionHasFilterCreatedBy, TensionHasFilterCreatedAt, TensionHasFilterUpdatedAt, TensionHasFilterMessage, TensionHasFilterEmitter, TensionHasFilterEmitterid, TensionHasFilterReceiver, TensionHasFilterReceiverid, TensionHasFilterTitle, TensionHasFilterType, TensionHasFilterStatus, TensionHasFilterAction, TensionHasFilterDueDate, TensionHasFilterConfidential, TensionHasFilterAssignees, TensionHasFilterParticipants, TensionHasFilterLabels, TensionHasFilterComments, TensionHasFilterBlobs, TensionHasFilterHistory, TensionHasFilterMentions, TensionHasFilterContracts, TensionHasFilterSubscribers, TensionHasFilterProjectStatuses, TensionHasFilterTemplate, TensionHasFilterBlocks, TensionHasFilterBlockedBy, TensionHasFilterDuplicateOf, TensionHasFilterDuplicates, TensionHasFilterParent, TensionHasFilterSubtasks, TensionHasFilterRelated, TensionHasFilterAttachments, TensionHasFilterNComments:
 		return true
 	}
 	return false
@@ -5981,6 +7643,7 @@ const (
 	TensionOrderableEmitterid  TensionOrderable = "emitterid"
 	TensionOrderableReceiverid TensionOrderable = "receiverid"
 	TensionOrderableTitle      TensionOrderable = "title"
+	TensionOrderableDueDate    TensionOrderable = "dueDate"
 	TensionOrderableNComments  TensionOrderable = "n_comments"
 )
 
@@ -5991,12 +7654,13 @@ var AllTensionOrderable = []TensionOrderable{
 	TensionOrderableEmitterid,
 	TensionOrderableReceiverid,
 	TensionOrderableTitle,
+	TensionOrderableDueDate,
 	TensionOrderableNComments,
 }
 
 func (e TensionOrderable) IsValid() bool {
 	switch e {
-	case TensionOrderableCreatedAt, TensionOrderableUpdatedAt, TensionOrderableMessage, TensionOrderableEmitterid, TensionOrderableReceiverid, TensionOrderableTitle, TensionOrderableNComments:
+	case TensionOrderableCreatedAt, TensionOrderableUpdatedAt, TensionOrderableMessage, TensionOrderableEmitterid, TensionOrderableReceiverid, TensionOrderableTitle, TensionOrderableDueDate, TensionOrderableNComments:
 		return true
 	}
 	return false
@@ -6026,18 +7690,20 @@ func (e TensionOrderable) MarshalGQL(w io.Writer) {
 type TensionStatus string
 
 const (
-	TensionStatusOpen   TensionStatus = "Open"
-	TensionStatusClosed TensionStatus = "Closed"
+	TensionStatusOpen    TensionStatus = "Open"
+	TensionStatusClosed  TensionStatus = "Closed"
+	TensionStatusPending TensionStatus = "Pending"
 )
 
 var AllTensionStatus = []TensionStatus{
 	TensionStatusOpen,
 	TensionStatusClosed,
+	TensionStatusPending,
 }
 
 func (e TensionStatus) IsValid() bool {
 	switch e {
-	case TensionStatusOpen, TensionStatusClosed:
+	case TensionStatusOpen, TensionStatusClosed, TensionStatusPending:
 		return true
 	}
 	return false
@@ -6064,6 +7730,108 @@ func (e TensionStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type TensionTemplateHasFilter string
+
+const (
+	TensionTemplateHasFilterRootnameid TensionTemplateHasFilter = "rootnameid"
+	TensionTemplateHasFilterName       TensionTemplateHasFilter = "name"
+	TensionTemplateHasFilterAbout      TensionTemplateHasFilter = "about"
+	TensionTemplateHasFilterTitle      TensionTemplateHasFilter = "title"
+	TensionTemplateHasFilterMessage    TensionTemplateHasFilter = "message"
+	TensionTemplateHasFilterType       TensionTemplateHasFilter = "type_"
+	TensionTemplateHasFilterLabels     TensionTemplateHasFilter = "labels"
+	TensionTemplateHasFilterAssignees  TensionTemplateHasFilter = "assignees"
+	TensionTemplateHasFilterNodes      TensionTemplateHasFilter = "nodes"
+)
+
+var AllTensionTemplateHasFilter = []TensionTemplateHasFilter{
+	TensionTemplateHasFilterRootnameid,
+	TensionTemplateHasFilterName,
+	TensionTemplateHasFilterAbout,
+	TensionTemplateHasFilterTitle,
+	TensionTemplateHasFilterMessage,
+	TensionTemplateHasFilterType,
+	TensionTemplateHasFilterLabels,
+	TensionTemplateHasFilterAssignees,
+	TensionTemplateHasFilterNodes,
+}
+
+func (e TensionTemplateHasFilter) IsValid() bool {
+	switch e {
+	case TensionTemplateHasFilterRootnameid, TensionTemplateHasFilterName, TensionTemplateHasFilterAbout, TensionTemplateHasFilterTitle, TensionTemplateHasFilterMessage, TensionTemplateHasFilterType, TensionTemplateHasFilterLabels, TensionTemplateHasFilterAssignees, TensionTemplateHasFilterNodes:
+		return true
+	}
+	return false
+}
+
+func (e TensionTemplateHasFilter) String() string {
+	return string(e)
+}
+
+func (e *TensionTemplateHasFilter) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TensionTemplateHasFilter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TensionTemplateHasFilter", str)
+	}
+	return nil
+}
+
+func (e TensionTemplateHasFilter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TensionTemplateOrderable string
+
+const (
+	TensionTemplateOrderableRootnameid TensionTemplateOrderable = "rootnameid"
+	TensionTemplateOrderableName       TensionTemplateOrderable = "name"
+	TensionTemplateOrderableAbout      TensionTemplateOrderable = "about"
+	TensionTemplateOrderableTitle      TensionTemplateOrderable = "title"
+	TensionTemplateOrderableMessage    TensionTemplateOrderable = "message"
+)
+
+var AllTensionTemplateOrderable = []TensionTemplateOrderable{
+	TensionTemplateOrderableRootnameid,
+	TensionTemplateOrderableName,
+	TensionTemplateOrderableAbout,
+	TensionTemplateOrderableTitle,
+	TensionTemplateOrderableMessage,
+}
+
+func (e TensionTemplateOrderable) IsValid() bool {
+	switch e {
+	case TensionTemplateOrderableRootnameid, TensionTemplateOrderableName, TensionTemplateOrderableAbout, TensionTemplateOrderableTitle, TensionTemplateOrderableMessage:
+		return true
+	}
+	return false
+}
+
+func (e TensionTemplateOrderable) String() string {
+	return string(e)
+}
+
+func (e *TensionTemplateOrderable) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TensionTemplateOrderable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TensionTemplateOrderable", str)
+	}
+	return nil
+}
+
+func (e TensionTemplateOrderable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type TensionType string
 
 const (
@@ -6111,6 +7879,112 @@ func (e TensionType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type TensionViewHasFilter string
+
+const (
+	TensionViewHasFilterCreatedBy  TensionViewHasFilter = "createdBy"
+	TensionViewHasFilterCreatedAt  TensionViewHasFilter = "createdAt"
+	TensionViewHasFilterRootnameid TensionViewHasFilter = "rootnameid"
+	TensionViewHasFilterNameid     TensionViewHasFilter = "nameid"
+	TensionViewHasFilterName       TensionViewHasFilter = "name"
+	TensionViewHasFilterQuery      TensionViewHasFilter = "query"
+	TensionViewHasFilterDisplay    TensionViewHasFilter = "display"
+	TensionViewHasFilterWatchers   TensionViewHasFilter = "watchers"
+	TensionViewHasFilterCheckedAt  TensionViewHasFilter = "checked_at"
+)
+
+var AllTensionViewHasFilter = []TensionViewHasFilter{
+	TensionViewHasFilterCreatedBy,
+	TensionViewHasFilterCreatedAt,
+	TensionViewHasFilterRootnameid,
+	TensionViewHasFilterNameid,
+	TensionViewHasFilterName,
+	TensionViewHasFilterQuery,
+	TensionViewHasFilterDisplay,
+	TensionViewHasFilterWatchers,
+	TensionViewHasFilterCheckedAt,
+}
+
+func (e TensionViewHasFilter) IsValid() bool {
+	switch e {
+	case TensionViewHasFilterCreatedBy, TensionViewHasFilterCreatedAt, TensionViewHasFilterRootnameid, TensionViewHasFilterNameid, TensionViewHasFilterName, TensionViewHasFilterQuery, TensionViewHasFilterDisplay, TensionViewHasFilterWatchers, TensionViewHasFilterCheckedAt:
+		return true
+	}
+	return false
+}
+
+func (e TensionViewHasFilter) String() string {
+	return string(e)
+}
+
+func (e *TensionViewHasFilter) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TensionViewHasFilter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TensionViewHasFilter", str)
+	}
+	return nil
+}
+
+func (e TensionViewHasFilter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TensionViewOrderable string
+
+const (
+	TensionViewOrderableCreatedAt  TensionViewOrderable = "createdAt"
+	TensionViewOrderableRootnameid TensionViewOrderable = "rootnameid"
+	TensionViewOrderableNameid     TensionViewOrderable = "nameid"
+	TensionViewOrderableName       TensionViewOrderable = "name"
+	TensionViewOrderableQuery      TensionViewOrderable = "query"
+	TensionViewOrderableDisplay    TensionViewOrderable = "display"
+	TensionViewOrderableCheckedAt  TensionViewOrderable = "checked_at"
+)
+
+var AllTensionViewOrderable = []TensionViewOrderable{
+	TensionViewOrderableCreatedAt,
+	TensionViewOrderableRootnameid,
+	TensionViewOrderableNameid,
+	TensionViewOrderableName,
+	TensionViewOrderableQuery,
+	TensionViewOrderableDisplay,
+	TensionViewOrderableCheckedAt,
+}
+
+func (e TensionViewOrderable) IsValid() bool {
+	switch e {
+	case TensionViewOrderableCreatedAt, TensionViewOrderableRootnameid, TensionViewOrderableNameid, TensionViewOrderableName, TensionViewOrderableQuery, TensionViewOrderableDisplay, TensionViewOrderableCheckedAt:
+		return true
+	}
+	return false
+}
+
+func (e TensionViewOrderable) String() string {
+	return string(e)
+}
+
+func (e *TensionViewOrderable) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TensionViewOrderable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TensionViewOrderable", str)
+	}
+	return nil
+}
+
+func (e TensionViewOrderable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type UserEventHasFilter string
 
 const (
@@ -6211,6 +8085,9 @@ const (
 	UserHasFilterSkills           UserHasFilter = "skills"
 	UserHasFilterNotifyByEmail    UserHasFilter = "notifyByEmail"
 	UserHasFilterLang             UserHasFilter = "lang"
+	UserHasFilterEmailDelivery    UserHasFilter = "emailDelivery"
+	UserHasFilterEmailStatus      UserHasFilter = "emailStatus"
+	UserHasFilterMatrixid         UserHasFilter = "matrixid"
 	UserHasFilterSubscriptions    UserHasFilter = "subscriptions"
 	UserHasFilterWatching         UserHasFilter = "watching"
 	UserHasFilterRights           UserHasFilter = "rights"
@@ -6220,6 +8097,7 @@ const (
 	UserHasFilterContracts        UserHasFilter = "contracts"
 	UserHasFilterReactions        UserHasFilter = "reactions"
 	UserHasFilterEvents           UserHasFilter = "events"
+	UserHasFilterNotifPreferences UserHasFilter = "notif_preferences"
 	UserHasFilterMarkAllAsRead    UserHasFilter = "markAllAsRead"
 	UserHasFilterEventCount       UserHasFilter = "event_count"
 )
@@ -6238,6 +8116,9 @@ var AllUserHasFilter = []UserHasFilter{
 	UserHasFilterSkills,
 	UserHasFilterNotifyByEmail,
 	UserHasFilterLang,
+	UserHasFilterEmailDelivery,
+	UserHasFilterEmailStatus,
+	UserHasFilterMatrixid,
 	UserHasFilterSubscriptions,
 	UserHasFilterWatching,
 	UserHasFilterRights,
@@ -6247,13 +8128,14 @@ var AllUserHasFilter = []UserHasFilter{
 	UserHasFilterContracts,
 	UserHasFilterReactions,
 	UserHasFilterEvents,
+	UserHasFilterNotifPreferences,
 	UserHasFilterMarkAllAsRead,
 	UserHasFilterEventCount,
 }
 
 func (e UserHasFilter) IsValid() bool {
 	switch e {
-	case UserHasFilterCreatedAt, UserHasFilterLastAck, UserHasFilterUsername, UserHasFilterName, UserHasFilterEmail, UserHasFilterPassword, UserHasFilterBio, UserHasFilterLocation, UserHasFilterUtc, UserHasFilterLinks, UserHasFilterSkills, UserHasFilterNotifyByEmail, UserHasFilterLang, UserHasFilterSubscriptions, UserHasFilterWatching, UserHasFilterRights, UserHasFilterRoles, UserHasFilterTensionsCreated, UserHasFilterTensionsAssigned, UserHasFilterContracts, UserHasFilterReactions, UserHasFilterEvents, UserHasFilterMarkAllAsRead, UserHasFilterEventCount:
+	case UserHasFilterCreatedAt, UserHasFilterLastAck, UserHasFilterUsername, UserHasFilterName, UserHasFilterEmail, UserHasFilterPassword, UserHasFilterBio, UserHasFilterLocation, UserHasFilterUtc, UserHasFilterLinks, UserHasFilterSkills, UserHasFilterNotifyByEmail, UserHasFilterLang, UserHasFilterEmailDelivery, UserHasFilterEmailStatus, UserHasFilterMatrixid, UserHasFilterSubscriptions, UserHasFilterWatching, UserHasFilterRights, UserHasFilterRoles, UserHasFilterTensionsCreated, UserHasFilterTensionsAssigned, UserHasFilterContracts, UserHasFilterReactions, UserHasFilterEvents, UserHasFilterNotifPreferences, UserHasFilterMarkAllAsRead, UserHasFilterEventCount:
 		return true
 	}
 	return false
@@ -6292,6 +8174,7 @@ const (
 	UserOrderableBio           UserOrderable = "bio"
 	UserOrderableLocation      UserOrderable = "location"
 	UserOrderableUtc           UserOrderable = "utc"
+	UserOrderableMatrixid      UserOrderable = "matrixid"
 	UserOrderableMarkAllAsRead UserOrderable = "markAllAsRead"
 )
 
@@ -6305,12 +8188,13 @@ var AllUserOrderable = []UserOrderable{
 	UserOrderableBio,
 	UserOrderableLocation,
 	UserOrderableUtc,
+	UserOrderableMatrixid,
 	UserOrderableMarkAllAsRead,
 }
 
 func (e UserOrderable) IsValid() bool {
 	switch e {
-	case UserOrderableCreatedAt, UserOrderableLastAck, UserOrderableUsername, UserOrderableName, UserOrderableEmail, UserOrderablePassword, UserOrderableBio, UserOrderableLocation, UserOrderableUtc, UserOrderableMarkAllAsRead:
+	case UserOrderableCreatedAt, UserOrderableLastAck, UserOrderableUsername, UserOrderableName, UserOrderableEmail, UserOrderablePassword, UserOrderableBio, UserOrderableLocation, UserOrderableUtc, UserOrderableMatrixid, UserOrderableMarkAllAsRead:
 		return true
 	}
 	return false
@@ -109,7 +109,7 @@ func updateNodeArtefactHook(ctx context.Context, obj interface{}, next graphql.R
 	// Protected Object has more restrivive conditions to be updated.
 	// @TODO: Clarify how the ressources access policy for artefacts object (that can belongs to multiple nodes)
 	// Ex: { Leaders: (mandate acess, tension access), Coordinators: (artefact access, tension access) }?
-	protecteds := []string{"Label", "RoleExt"}
+	protecteds := []string{"Label", "RoleExt", "TensionTemplate"}
 	isProtected := false
 	_, typeName, _, err := queryTypeFromGraphqlContext(ctx)
 	for _, obj := range protecteds {
@@ -259,3 +259,45 @@ func updateNodeArtefactHook(ctx context.Context, obj interface{}, next graphql.R
 
 	return data, err
 }
+
+// Delete "Artefact" - Must be coordo of the linked nodes
+func deleteNodeArtefactHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	_, typeName, _, err := queryTypeFromGraphqlContext(ctx)
+	if err != nil {
+		return nil, LogErr("Internal error", err)
+	}
+
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var filter FilterArtefactInput
+	ExtractFilter(ctx, &filter)
+	if len(filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+
+	// Authorization with regards to the linked nodes.
+	for _, id := range filter.ID {
+		nodes := []model.NodeRef{}
+		x, err := db.GetDB().GetSubFieldById(id, typeName+".nodes", "Node.nameid")
+		if err != nil {
+			return nil, err
+		}
+		if x != nil {
+			for _, n := range x.([]interface{}) {
+				nameid := n.(string)
+				nodes = append(nodes, model.NodeRef{Nameid: &nameid})
+			}
+		}
+		if err = auth.CheckNodesAuth(uctx, nodes, false); err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
@@ -24,7 +24,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"fractale/fractal6.go/db"
 	"fractale/fractal6.go/graph/codec"
@@ -58,6 +60,18 @@ func PublishTensionEvent(notif model.EventNotif) error {
 	return nil
 }
 
+// Will trigger Event notifications in cmd/notifier.go
+// PublishBulkTensionEvent -> cmd.processBulkTensionNotification -> PushBulkEventNotifications
+func PublishBulkTensionEvent(notif model.BulkEventNotif) error {
+	payload, _ := json.Marshal(notif)
+	if err := cache.Publish(ctx, "api-tension-bulk-notification", payload).Err(); err != nil {
+		fmt.Printf("Redis publish error: %v", err)
+		panic(err)
+	}
+
+	return nil
+}
+
 // Will trigger Contract notifications in cmd/notifier.go
 // PublishContractEvent -> cmd.processContractNotification -> PushContractNotifications
 func PublishContractEvent(notif model.ContractNotif) error {
@@ -114,8 +128,41 @@ func PushHistory(notif *model.EventNotif) error {
 
 /* EXTERNAL (email, chat, etc) */
 
+// emailBatch groups the emailable notifications by recipient.
+type emailBatch map[string]*userNotifs
+
+type userNotifs struct {
+	ui     model.UserNotifInfo
+	notifs []model.EventNotif
+}
+
 // Notify users for Event events, where events can be batch of event.
 func PushEventNotifications(notif model.EventNotif) error {
+	return pushEventNotifications(notif, nil)
+}
+
+// Notify users for the events of a bulk operation.
+// Each recipient gets one email for the whole batch.
+func PushBulkEventNotifications(bulk model.BulkEventNotif) error {
+	batch := make(emailBatch)
+	for _, notif := range bulk.Notifs {
+		notif.Uctx = bulk.Uctx
+		if err := pushEventNotifications(notif, batch); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range batch {
+		if err := email.SendBulkEventNotificationEmail(b.ui, bulk.Uctx, b.notifs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pushEventNotifications pushes the event history and the user notifications.
+// If batch is not nil, the emails are collected in it instead of being sent.
+func pushEventNotifications(notif model.EventNotif, batch emailBatch) error {
 	// Push event in tension event history
 	err := PushHistory(&notif)
 	if err != nil {
@@ -204,6 +251,16 @@ func PushEventNotifications(notif model.EventNotif) error {
 		return fmt.Errorf("tension %s not found.", notif.Tid)
 	}
 
+	// Confidential tensions are only notified to their readers.
+	if err = FilterConfidentialUsers(notif.Tid, users); err != nil {
+		return err
+	}
+
+	// Post in the Matrix room of the circle
+	if err := PushMatrixEvent(notif); err != nil {
+		log.Printf("Matrix bridge error for tension %s: %v", notif.Tid, err)
+	}
+
 	// Special notifications
 	// --
 	// User has been kick-out from an organisation
@@ -254,9 +311,16 @@ func PushEventNotifications(notif model.EventNotif) error {
 			continue
 		}
 
+		// User preferences
+		inApp, byEmail, err := getNotifPolicy(u, notif.Rootnameid, ui.Reason, notif.EventGroup(),
+			notif.IsNotifiable(ui), notif.IsEmailable(ui))
+		if err != nil {
+			return err
+		}
+
 		// User Event
 		var eid string
-		if notif.IsNotifiable(ui) {
+		if inApp {
 			eid, err = db.GetDB().Add(db.GetDB().GetRootUctx(), "userEvent", &model.AddUserEventInput{
 				User:      &model.UserRef{Username: &u},
 				IsRead:    false,
@@ -269,13 +333,26 @@ func PushEventNotifications(notif model.EventNotif) error {
 		}
 
 		// Email
-		if notif.Uctx.Rights.HasEmailNotifications && ui.User.NotifyByEmail && notif.IsEmailable(ui) {
+		if notif.Uctx.Rights.HasEmailNotifications && ui.User.NotifyByEmail && byEmail {
 			if eid == "" {
 				// @deprected warning: unnecessary/noisy
 				//log.Printf("Notification Error: an event is emailable but not notifiable !")
 				return nil
 			}
 			ui.Eid = eid
+			if ui.User.EmailDelivery != nil && *ui.User.EmailDelivery != model.EmailDeliveryImmediate {
+				if err = QueueEmailDigest(ui, notif); err != nil {
+					return err
+				}
+				continue
+			}
+			if batch != nil {
+				if _, ex := batch[u]; !ex {
+					batch[u] = &userNotifs{ui: ui}
+				}
+				batch[u].notifs = append(batch[u].notifs, notif)
+				continue
+			}
 			err = email.SendEventNotificationEmail(ui, notif)
 			if err != nil {
 				return err
@@ -366,6 +443,26 @@ func PushContractNotifications(notif model.ContractNotif) error {
 	} else {
 		return fmt.Errorf("contract %s not found.", notif.Tid)
 	}
+	// -
+	// Only the readers of a confidential tension are notified, besides the candidates.
+	candidates := make(map[string]model.UserNotifInfo)
+	for _, c := range notif.Contract.Candidates {
+		candidates[c.Username] = users[c.Username]
+	}
+	for _, c := range notif.Contract.PendingCandidates {
+		candidates[c.Email] = users[c.Email]
+	}
+	if err = FilterConfidentialUsers(notif.Tid, users); err != nil {
+		return err
+	}
+	for k, v := range candidates {
+		users[k] = v
+	}
+
+	// Post in the Matrix room of the circle
+	if err := PushMatrixContract(notif); err != nil {
+		log.Printf("Matrix bridge error for contract %s: %v", notif.Contract.ID, err)
+	}
 
 	// Push user event notification
 	for u, ui := range users {
@@ -374,6 +471,15 @@ func PushContractNotifications(notif model.ContractNotif) error {
 			continue
 		}
 
+		// User preferences
+		inApp, byEmail := true, notif.IsEmailable(ui)
+		if !ui.IsPending {
+			inApp, byEmail, err = getNotifPolicy(u, notif.Rootnameid, ui.Reason, model.NotifEventGroupContract, inApp, byEmail)
+			if err != nil {
+				return err
+			}
+		}
+
 		// User Event
 		var eid string
 		if ui.IsPending {
@@ -390,7 +496,7 @@ func PushContractNotifications(notif model.ContractNotif) error {
 			if err != nil {
 				return err
 			}
-		} else {
+		} else if inApp {
 			switch notif.ContractEvent {
 			case model.NewContract:
 				// Push user event
@@ -419,7 +525,7 @@ func PushContractNotifications(notif model.ContractNotif) error {
 		}
 
 		// Email
-		if notif.Uctx.Rights.HasEmailNotifications && ui.User.NotifyByEmail && notif.IsEmailable(ui) {
+		if notif.Uctx.Rights.HasEmailNotifications && ui.User.NotifyByEmail && byEmail {
 			ui.Eid = eid
 			err = email.SendContractNotificationEmail(ui, notif)
 			if err != nil {
@@ -468,8 +574,15 @@ func PushNotifNotifications(notif model.NotifNotif, selfNotify bool) error {
 	var createdAt string = Now()
 	var tensionRef *model.TensionRef
 	var contractRef *model.ContractRef
+	var rootnameid string
 	if notif.Tid != nil {
 		tensionRef = &model.TensionRef{ID: notif.Tid}
+		// Organisation of the notification, for the user preferences.
+		if x, err := db.GetDB().GetSubFieldById(*notif.Tid, "Tension.receiver", "Node.rootnameid"); err != nil {
+			return err
+		} else if x != nil {
+			rootnameid = x.(string)
+		}
 	}
 	if notif.Cid != nil {
 		contractRef = &model.ContractRef{ID: notif.Cid}
@@ -491,6 +604,13 @@ func PushNotifNotifications(notif model.NotifNotif, selfNotify bool) error {
 			continue
 		}
 
+		// User preferences
+		if inApp, _, err := getNotifPolicy(u, rootnameid, model.ReasonUnknown, model.NotifEventGroupNotice, true, false); err != nil {
+			return err
+		} else if !inApp {
+			continue
+		}
+
 		// User Event
 		_, err := db.GetDB().Add(db.GetDB().GetRootUctx(), "userEvent", &model.AddUserEventInput{
 			User:      &model.UserRef{Username: &u},
@@ -509,6 +629,80 @@ func PushNotifNotifications(notif model.NotifNotif, selfNotify bool) error {
 	return nil
 }
 
+// Notify the assignees and subscribers of the open tensions due within the
+// given lead time, and again once they are overdue. A reminder is sent once
+// per stage and due date (overdue reminders are repeated every month).
+func PushDueDateReminders(lead time.Duration) error {
+	now := time.Now().UTC()
+	tensions, err := db.GetDB().GetDueTensions(now.Add(lead).Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+
+	// A failed reminder doesn't stop the others, it is retried on the next run.
+	var failed error
+	for _, t := range tensions {
+		if t.DueDate == nil || t.CreatedBy == nil {
+			continue
+		}
+		dueDate, err := time.Parse(time.RFC3339, *t.DueDate)
+		if err != nil {
+			continue
+		}
+
+		stage := "soon"
+		msg := fmt.Sprintf("Due on %s: %s", dueDate.Format("2006-01-02"), t.Title)
+		if dueDate.Before(now) {
+			stage = "overdue"
+			msg = fmt.Sprintf("Overdue since %s: %s", dueDate.Format("2006-01-02"), t.Title)
+		}
+
+		// Don't send the same reminder twice.
+		key := fmt.Sprintf("reminder:%s:%s:%s", t.ID, stage, *t.DueDate)
+		if isNew, err := cache.SetNX(ctx, key, 1, 30*24*time.Hour).Result(); err != nil {
+			return err
+		} else if !isNew {
+			continue
+		}
+
+		var to []string
+		users := make(map[string]model.UserNotifInfo)
+		for _, u := range append(t.Assignees, t.Subscribers...) {
+			users[u.Username] = model.UserNotifInfo{User: *u}
+		}
+		if err = FilterConfidentialUsers(t.ID, users); err != nil {
+			log.Printf("due date reminder error for tension %s: %v", t.ID, err)
+			cache.Del(ctx, key)
+			failed = err
+			continue
+		}
+		for _, u := range append(t.Assignees, t.Subscribers...) {
+			if _, ok := users[u.Username]; ok {
+				delete(users, u.Username)
+				to = append(to, u.Username)
+			}
+		}
+		if len(to) == 0 {
+			continue
+		}
+
+		tid := t.ID
+		err = PushNotifNotifications(model.NotifNotif{
+			Uctx: &model.UserCtx{Username: t.CreatedBy.Username},
+			Tid:  &tid,
+			Msg:  msg,
+			To:   to,
+		}, true)
+		if err != nil {
+			log.Printf("due date reminder error for tension %s: %v", t.ID, err)
+			cache.Del(ctx, key)
+			failed = err
+		}
+	}
+
+	return failed
+}
+
 //
 // User helpers
 //
@@ -616,6 +810,49 @@ func GetUsersToNotify(tid string, withAssignees, withSubscribers, withPeers bool
 	return users, nil
 }
 
+// getNotifPolicy returns whether the user should be notified in-app and by email,
+// according to their notification preferences, or the given default policy.
+func getNotifPolicy(username, rootnameid string, reason model.NotifReason, group model.NotifEventGroup, inApp, byEmail bool) (bool, bool, error) {
+	prefs, err := db.GetDB().GetNotifPreferences(username)
+	if err != nil {
+		return false, false, err
+	}
+	if channel := model.GetNotifChannel(prefs, rootnameid, reason, group); channel != nil {
+		switch *channel {
+		case model.NotifChannelNone:
+			return false, false, nil
+		case model.NotifChannelApp:
+			return true, false, nil
+		case model.NotifChannelEmail:
+			return true, true, nil
+		}
+	}
+	return inApp, byEmail, nil
+}
+
+// FilterConfidentialUsers removes from the users map the users who cannot
+// read the given tension if it is confidential. Only the author, assignees,
+// participants and the coordinators of the receiver circle are kept.
+func FilterConfidentialUsers(tid string, users map[string]model.UserNotifInfo) error {
+	readers, err := db.GetDB().GetConfidentialReaders(tid)
+	if err != nil || len(readers) == 0 {
+		return err
+	}
+	coordos, err := auth.GetCoordosFromTid(tid)
+	if err != nil {
+		return err
+	}
+	for _, u := range coordos {
+		readers = append(readers, u.Username)
+	}
+	for u := range users {
+		if IndexOf(readers, u) < 0 {
+			delete(users, u)
+		}
+	}
+	return nil
+}
+
 // Update the users map with notified users.
 // Note: only add user that are member of the given rootnameid
 // @FIX: user inside block code will be notified here...
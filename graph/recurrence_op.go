@@ -0,0 +1,215 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+// ScheduleRecurrence sets the next occurrence of the given recurrence, from now on.
+func ScheduleRecurrence(id string) error {
+	r, err := db.GetDB().GetRecurrence(id)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return fmt.Errorf("recurrence not found: %s", id)
+	}
+	return scheduleNext(r, time.Now())
+}
+
+// scheduleNext sets the first occurrence after the given time,
+// or clears it if the recurrence is over.
+func scheduleNext(r *model.Recurrence, after time.Time) error {
+	rule, err := ParseRRule(r.Rrule)
+	if err != nil {
+		return err
+	}
+	dtstart, err := time.Parse(time.RFC3339, r.Dtstart)
+	if err != nil {
+		return err
+	}
+	next, ok := rule.Next(dtstart, after)
+	if !ok {
+		return db.GetDB().ClearFieldById(r.ID, "Recurrence.next_at")
+	}
+	return db.GetDB().SetFieldById(r.ID, "Recurrence.next_at", next.UTC().Format(time.RFC3339))
+}
+
+// PushRecurringTensions creates the tensions of the recurrences that are due,
+// and schedules their next occurrence. Missed occurrences are not caught up.
+func PushRecurringTensions() error {
+	now := time.Now().UTC()
+	recurrences, err := db.GetDB().GetDueRecurrences(now.Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+
+	for i, r := range recurrences {
+		if r.NextAt == nil || r.CreatedBy == nil {
+			continue
+		}
+
+		// Don't create the same occurrence twice.
+		key := fmt.Sprintf("recurrence:%s:%s", r.ID, *r.NextAt)
+		if isNew, err := cache.SetNX(ctx, key, 1, 7*24*time.Hour).Result(); err != nil {
+			return err
+		} else if !isNew {
+			continue
+		}
+
+		if _, err := NewRecurringTension(&recurrences[i]); err != nil {
+			// Release the occurrence so that it is retried at the next run.
+			log.Printf("Recurrence %s: tension creation failed: %v", r.ID, err)
+			cache.Del(ctx, key)
+			continue
+		}
+
+		count := 1
+		if r.Count != nil {
+			count += *r.Count
+		}
+		if err := db.GetDB().SetFieldById(r.ID, "Recurrence.count", strconv.Itoa(count)); err != nil {
+			return err
+		}
+		if err := scheduleNext(&recurrences[i], now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewRecurringTension creates a new occurrence of the recurrence, on the behalf
+// of its author, as a copy of the source tension or from the template.
+// It goes through the same processing as addTension (events, template, notifications).
+func NewRecurringTension(r *model.Recurrence) (string, error) {
+	uctx, err := db.GetDB().GetUctx("username", r.CreatedBy.Username)
+	if err != nil {
+		return "", err
+	}
+	rootnameid, err := codec.Nid2rootid(r.Receiverid)
+	if err != nil {
+		return "", err
+	}
+
+	now := Now()
+	createdBy := model.UserRef{Username: &uctx.Username}
+	emitterid := r.Receiverid
+	var title string
+	var message *string
+	type_ := model.TensionTypeOperational
+	evtCreated := model.TensionEventCreated
+	history := []*model.EventRef{
+		&model.EventRef{CreatedAt: &now, CreatedBy: &createdBy, EventType: &evtCreated},
+	}
+	newEvent := func(eventType model.TensionEvent, new string) *model.EventRef {
+		return &model.EventRef{CreatedAt: &now, CreatedBy: &createdBy, EventType: &eventType, New: &new}
+	}
+
+	input := model.AddTensionInput{
+		CreatedAt:   now,
+		CreatedBy:   &createdBy,
+		Status:      model.TensionStatusOpen,
+		Receiverid:  r.Receiverid,
+		Subscribers: []*model.UserRef{&createdBy},
+	}
+
+	// Copy the source tension
+	if t := r.Tension; t != nil {
+		title = t.Title
+		if t.Type != "" {
+			type_ = t.Type
+		}
+		if t.Emitterid != "" {
+			emitterid = t.Emitterid
+		}
+		if len(t.Comments) > 0 && t.Comments[0].Message != "" {
+			message = &t.Comments[0].Message
+		}
+		for _, l := range t.Labels {
+			id := l.ID
+			input.Labels = append(input.Labels, &model.LabelRef{ID: &id})
+			color := ""
+			if l.Color != nil {
+				color = *l.Color
+			}
+			history = append(history, newEvent(model.TensionEventLabelAdded, l.Name+"§"+color))
+		}
+		for _, u := range t.Assignees {
+			// Assignees must still be member of the organisation
+			uctxFs, err := db.GetDB().GetUctx("username", u.Username)
+			if err != nil {
+				return "", err
+			}
+			if auth.UserIsMember(uctxFs, rootnameid) < 0 {
+				continue
+			}
+			username := u.Username
+			input.Assignees = append(input.Assignees, &model.UserRef{Username: &username})
+			history = append(history, newEvent(model.TensionEventAssigneeAdded, username))
+		}
+	}
+
+	// The template is applied after the creation
+	if r.Template != nil {
+		id := r.Template.ID
+		input.Template = &model.TensionTemplateRef{ID: &id}
+		if r.Tension == nil && r.Template.Type != nil {
+			type_ = *r.Template.Type
+		}
+	}
+
+	if r.Title != nil && *r.Title != "" {
+		title = *r.Title
+	}
+
+	input.Title = title
+	input.Type = type_
+	input.Emitterid = emitterid
+	input.Emitter = &model.NodeRef{Nameid: &emitterid}
+	input.Receiver = &model.NodeRef{Nameid: &r.Receiverid}
+	input.Comments = []*model.CommentRef{&model.CommentRef{CreatedAt: &now, CreatedBy: &createdBy, Message: message}}
+
+	// Add the tension with the author rights
+	tid, err := db.GetDB().Add(*uctx, "tension", input)
+	if err != nil {
+		return "", err
+	}
+
+	ok, err := ProcessNewTension(uctx, tid, &input, history)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("%s is not allowed to create tensions in %s.", uctx.Username, r.Receiverid)
+	}
+	return tid, nil
+}
@@ -0,0 +1,216 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"time"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+////////////////////////////////////////////////
+// Recurrence Resolver
+////////////////////////////////////////////////
+
+// Add Recurrence - Must be coordo of the receiver
+func addRecurrenceHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+	if !PayloadContains(ctx, "id") {
+		return nil, LogErr("field missing", fmt.Errorf("id field is required in recurrence payload"))
+	}
+
+	// Validate input
+	var inputs []model.AddRecurrenceInput
+	ExtractInputs(ctx, &inputs)
+	for _, input := range inputs {
+		if input.CreatedBy == nil || input.CreatedBy.Username == nil || *input.CreatedBy.Username != uctx.Username {
+			return nil, LogErr("Access denied", fmt.Errorf("createdBy must be the current user."))
+		}
+		if input.NextAt != nil || input.Count != nil {
+			return nil, LogErr("Access denied", fmt.Errorf("next_at and count are set by the scheduler."))
+		}
+		rootnameid, err := codec.Nid2rootid(input.Receiverid)
+		if err != nil {
+			return nil, LogErr("Value error", err)
+		}
+		if rootnameid != input.Rootnameid {
+			return nil, LogErr("Access denied", fmt.Errorf("rootnameid and receiverid does not match."))
+		}
+		if (input.Title == nil || *input.Title == "") && input.Template == nil && input.Tension == nil {
+			return nil, LogErr("Value error", fmt.Errorf("A title, a template or a source tension is required."))
+		}
+		err = checkRecurrence(uctx, input.Receiverid, &input.Rrule, &input.Dtstart, input.Template, input.Tension)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	data, err := next(ctx)
+	if err != nil || data.(*model.AddRecurrencePayload) == nil {
+		return data, err
+	}
+
+	// Schedule the first occurrence
+	for _, r := range data.(*model.AddRecurrencePayload).Recurrence {
+		if err = ScheduleRecurrence(r.ID); err != nil {
+			return data, LogErr("Internal error", err)
+		}
+	}
+	return data, err
+}
+
+// Update Recurrence - Must be coordo of the receiver
+func updateRecurrenceHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var input model.UpdateRecurrenceInput
+	ExtractInput(ctx, &input)
+	if input.Filter == nil || len(input.Filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	set := input.Set
+	if set == nil {
+		set = &model.RecurrencePatch{}
+	}
+	for _, id := range input.Filter.ID {
+		receiverid, err := db.GetDB().GetFieldById(id, "Recurrence.receiverid")
+		if err != nil || receiverid == nil {
+			return nil, LogErr("Access denied", fmt.Errorf("recurrence not found."))
+		}
+		err = checkRecurrence(uctx, receiverid.(string), set.Rrule, set.Dtstart, set.Template, set.Tension)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	data, err := next(ctx)
+	if err != nil {
+		return data, err
+	}
+
+	// Reschedule, as the rule may have changed or the recurrence been resumed.
+	for _, id := range input.Filter.ID {
+		if err = ScheduleRecurrence(id); err != nil {
+			return data, LogErr("Internal error", err)
+		}
+	}
+	return data, err
+}
+
+// Delete Recurrence - Must be coordo of the receiver
+func deleteRecurrenceHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var filter model.RecurrenceFilter
+	ExtractFilter(ctx, &filter)
+	if len(filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	for _, id := range filter.ID {
+		receiverid, err := db.GetDB().GetFieldById(id, "Recurrence.receiverid")
+		if err != nil || receiverid == nil {
+			return nil, LogErr("Access denied", fmt.Errorf("recurrence not found."))
+		}
+		if err = checkRecurrence(uctx, receiverid.(string), nil, nil, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// checkRecurrence checks that the user can manage the recurrences of the receiver
+// and that the given values are valid.
+func checkRecurrence(uctx *model.UserCtx, receiverid string, rrule, dtstart *string, template *model.TensionTemplateRef, tension *model.TensionRef) error {
+	ok, err := auth.HasCoordoAuth(uctx, receiverid, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return LogErr("Access denied", fmt.Errorf("Only the coordinators of the receiver circle can manage its recurrences."))
+	}
+
+	if rrule != nil {
+		if _, err := ParseRRule(*rrule); err != nil {
+			return LogErr("Value error", err)
+		}
+	}
+	if dtstart != nil {
+		if _, err := time.Parse(time.RFC3339, *dtstart); err != nil {
+			return LogErr("Value error", fmt.Errorf("dtstart must be a RFC3339 date."))
+		}
+	}
+
+	// The template must be available in the receiver circle.
+	if template != nil && template.ID != nil {
+		templates, err := db.GetDB().GetTopTemplates("nameid", receiverid, true)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, t := range templates {
+			if t.ID == *template.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return LogErr("Access denied", fmt.Errorf("This template is not available in this circle."))
+		}
+	}
+
+	// The source tension must belong to the receiver circle.
+	if tension != nil && tension.ID != nil {
+		x, err := db.GetDB().GetFieldById(*tension.ID, "Tension.receiverid")
+		if err != nil {
+			return err
+		}
+		if x == nil || x.(string) != receiverid {
+			return LogErr("Access denied", fmt.Errorf("The source tension must be received by the same circle."))
+		}
+	}
+
+	return nil
+}
@@ -121,6 +121,36 @@ func Init() gen.Config {
 	c.Directives.Hook_addLabel = addNodeArtefactHook
 	c.Directives.Hook_updateLabel = updateNodeArtefactHook
 	c.Directives.Hook_deleteLabel = nothing
+	//TensionTemplate
+	c.Directives.Hook_getTensionTemplateInput = nothing
+	c.Directives.Hook_queryTensionTemplateInput = nothing
+	c.Directives.Hook_addTensionTemplateInput = nothing
+	c.Directives.Hook_updateTensionTemplateInput = setContextWithID // used by the @unique
+	c.Directives.Hook_deleteTensionTemplateInput = nothing
+	// --
+	c.Directives.Hook_addTensionTemplate = addNodeArtefactHook
+	c.Directives.Hook_updateTensionTemplate = updateNodeArtefactHook
+	c.Directives.Hook_deleteTensionTemplate = deleteNodeArtefactHook
+	//Recurrence
+	c.Directives.Hook_getRecurrenceInput = nothing
+	c.Directives.Hook_queryRecurrenceInput = nothing
+	c.Directives.Hook_addRecurrenceInput = nothing
+	c.Directives.Hook_updateRecurrenceInput = nothing
+	c.Directives.Hook_deleteRecurrenceInput = nothing
+	// --
+	c.Directives.Hook_addRecurrence = addRecurrenceHook
+	c.Directives.Hook_updateRecurrence = updateRecurrenceHook
+	c.Directives.Hook_deleteRecurrence = deleteRecurrenceHook
+	//TensionView
+	c.Directives.Hook_getTensionViewInput = nothing
+	c.Directives.Hook_queryTensionViewInput = nothing
+	c.Directives.Hook_addTensionViewInput = nothing
+	c.Directives.Hook_updateTensionViewInput = nothing
+	c.Directives.Hook_deleteTensionViewInput = nothing
+	// --
+	c.Directives.Hook_addTensionView = addTensionViewHook
+	c.Directives.Hook_updateTensionView = updateTensionViewHook
+	c.Directives.Hook_deleteTensionView = deleteTensionViewHook
 	//Project
 	c.Directives.Hook_getProjectInput = nothing
 	c.Directives.Hook_queryProjectInput = nothing
@@ -195,6 +225,46 @@ func Init() gen.Config {
 	c.Directives.Hook_addReaction = nothing
 	c.Directives.Hook_updateReaction = nothing
 	c.Directives.Hook_deleteReaction = nothing
+	//NotifPreference
+	c.Directives.Hook_getNotifPreferenceInput = nothing
+	c.Directives.Hook_queryNotifPreferenceInput = nothing
+	c.Directives.Hook_addNotifPreferenceInput = addNotifPreferenceInputHook
+	c.Directives.Hook_updateNotifPreferenceInput = nothing
+	c.Directives.Hook_deleteNotifPreferenceInput = nothing
+	// --
+	c.Directives.Hook_addNotifPreference = nothing
+	c.Directives.Hook_updateNotifPreference = nothing
+	c.Directives.Hook_deleteNotifPreference = nothing
+	//MailingSetting
+	c.Directives.Hook_getMailingSettingInput = nothing
+	c.Directives.Hook_queryMailingSettingInput = nothing
+	c.Directives.Hook_addMailingSettingInput = nothing
+	c.Directives.Hook_updateMailingSettingInput = nothing
+	c.Directives.Hook_deleteMailingSettingInput = nothing
+
+	c.Directives.Hook_addMailingSetting = addMailingSettingHook
+	c.Directives.Hook_updateMailingSetting = updateMailingSettingHook
+	c.Directives.Hook_deleteMailingSetting = deleteMailingSettingHook
+	//MatrixRoom
+	c.Directives.Hook_getMatrixRoomInput = nothing
+	c.Directives.Hook_queryMatrixRoomInput = nothing
+	c.Directives.Hook_addMatrixRoomInput = nothing
+	c.Directives.Hook_updateMatrixRoomInput = nothing
+	c.Directives.Hook_deleteMatrixRoomInput = nothing
+
+	c.Directives.Hook_addMatrixRoom = addMatrixRoomHook
+	c.Directives.Hook_updateMatrixRoom = updateMatrixRoomHook
+	c.Directives.Hook_deleteMatrixRoom = deleteMatrixRoomHook
+	//Attachment
+	c.Directives.Hook_getAttachmentInput = nothing
+	c.Directives.Hook_queryAttachmentInput = nothing
+	c.Directives.Hook_addAttachmentInput = nothing
+	c.Directives.Hook_updateAttachmentInput = nothing
+	c.Directives.Hook_deleteAttachmentInput = nothing
+	// --
+	c.Directives.Hook_addAttachment = nothing
+	c.Directives.Hook_updateAttachment = nothing
+	c.Directives.Hook_deleteAttachment = deleteAttachmentHook
 	//Contract
 	c.Directives.Hook_getContractInput = nothing
 	c.Directives.Hook_queryContractInput = nothing
@@ -90,6 +90,60 @@ func (r *mutationResolver) DeleteRoleExt(ctx context.Context, filter model.RoleE
 	panic(fmt.Errorf("not implemented"))
 }
 
+// AddTensionTemplate is the resolver for the addTensionTemplate field.
+func (r *mutationResolver) AddTensionTemplate(ctx context.Context, input []*model.AddTensionTemplateInput) (data *model.AddTensionTemplatePayload, errors error) {
+	errors = r.DgraphAddBridge(ctx, input, nil, &data)
+	return data, errors
+}
+
+// UpdateTensionTemplate is the resolver for the updateTensionTemplate field.
+func (r *mutationResolver) UpdateTensionTemplate(ctx context.Context, input model.UpdateTensionTemplateInput) (data *model.UpdateTensionTemplatePayload, errors error) {
+	errors = r.DgraphUpdateBridge(ctx, input, &data)
+	return data, errors
+}
+
+// DeleteTensionTemplate is the resolver for the deleteTensionTemplate field.
+func (r *mutationResolver) DeleteTensionTemplate(ctx context.Context, filter model.TensionTemplateFilter) (data *model.DeleteTensionTemplatePayload, errors error) {
+	errors = r.DgraphDeleteBridge(ctx, filter, &data)
+	return data, errors
+}
+
+// AddRecurrence is the resolver for the addRecurrence field.
+func (r *mutationResolver) AddRecurrence(ctx context.Context, input []*model.AddRecurrenceInput) (data *model.AddRecurrencePayload, errors error) {
+	errors = r.DgraphAddBridge(ctx, input, nil, &data)
+	return data, errors
+}
+
+// UpdateRecurrence is the resolver for the updateRecurrence field.
+func (r *mutationResolver) UpdateRecurrence(ctx context.Context, input model.UpdateRecurrenceInput) (data *model.UpdateRecurrencePayload, errors error) {
+	errors = r.DgraphUpdateBridge(ctx, input, &data)
+	return data, errors
+}
+
+// DeleteRecurrence is the resolver for the deleteRecurrence field.
+func (r *mutationResolver) DeleteRecurrence(ctx context.Context, filter model.RecurrenceFilter) (data *model.DeleteRecurrencePayload, errors error) {
+	errors = r.DgraphDeleteBridge(ctx, filter, &data)
+	return data, errors
+}
+
+// AddTensionView is the resolver for the addTensionView field.
+func (r *mutationResolver) AddTensionView(ctx context.Context, input []*model.AddTensionViewInput) (data *model.AddTensionViewPayload, errors error) {
+	errors = r.DgraphAddBridge(ctx, input, nil, &data)
+	return data, errors
+}
+
+// UpdateTensionView is the resolver for the updateTensionView field.
+func (r *mutationResolver) UpdateTensionView(ctx context.Context, input model.UpdateTensionViewInput) (data *model.UpdateTensionViewPayload, errors error) {
+	errors = r.DgraphUpdateBridge(ctx, input, &data)
+	return data, errors
+}
+
+// DeleteTensionView is the resolver for the deleteTensionView field.
+func (r *mutationResolver) DeleteTensionView(ctx context.Context, filter model.TensionViewFilter) (data *model.DeleteTensionViewPayload, errors error) {
+	errors = r.DgraphDeleteBridge(ctx, filter, &data)
+	return data, errors
+}
+
 // AddProject is the resolver for the addProject field.
 func (r *mutationResolver) AddProject(ctx context.Context, input []*model.AddProjectInput) (data *model.AddProjectPayload, errors error) {
 	errors = r.DgraphAddBridge(ctx, input, nil, &data)
@@ -249,6 +303,78 @@ func (r *mutationResolver) DeleteReaction(ctx context.Context, filter model.Reac
 	return data, errors
 }
 
+// AddNotifPreference is the resolver for the addNotifPreference field.
+func (r *mutationResolver) AddNotifPreference(ctx context.Context, input []*model.AddNotifPreferenceInput, upsert *bool) (data *model.AddNotifPreferencePayload, errors error) {
+	errors = r.DgraphAddBridge(ctx, input, upsert, &data)
+	return data, errors
+}
+
+// UpdateNotifPreference is the resolver for the updateNotifPreference field.
+func (r *mutationResolver) UpdateNotifPreference(ctx context.Context, input model.UpdateNotifPreferenceInput) (data *model.UpdateNotifPreferencePayload, errors error) {
+	errors = r.DgraphUpdateBridge(ctx, input, &data)
+	return data, errors
+}
+
+// DeleteNotifPreference is the resolver for the deleteNotifPreference field.
+func (r *mutationResolver) DeleteNotifPreference(ctx context.Context, filter model.NotifPreferenceFilter) (data *model.DeleteNotifPreferencePayload, errors error) {
+	errors = r.DgraphDeleteBridge(ctx, filter, &data)
+	return data, errors
+}
+
+// AddMailingSetting is the resolver for the addMailingSetting field.
+func (r *mutationResolver) AddMailingSetting(ctx context.Context, input []*model.AddMailingSettingInput, upsert *bool) (data *model.AddMailingSettingPayload, errors error) {
+	errors = r.DgraphAddBridge(ctx, input, upsert, &data)
+	return data, errors
+}
+
+// UpdateMailingSetting is the resolver for the updateMailingSetting field.
+func (r *mutationResolver) UpdateMailingSetting(ctx context.Context, input model.UpdateMailingSettingInput) (data *model.UpdateMailingSettingPayload, errors error) {
+	errors = r.DgraphUpdateBridge(ctx, input, &data)
+	return data, errors
+}
+
+// DeleteMailingSetting is the resolver for the deleteMailingSetting field.
+func (r *mutationResolver) DeleteMailingSetting(ctx context.Context, filter model.MailingSettingFilter) (data *model.DeleteMailingSettingPayload, errors error) {
+	errors = r.DgraphDeleteBridge(ctx, filter, &data)
+	return data, errors
+}
+
+// AddMatrixRoom is the resolver for the addMatrixRoom field.
+func (r *mutationResolver) AddMatrixRoom(ctx context.Context, input []*model.AddMatrixRoomInput, upsert *bool) (data *model.AddMatrixRoomPayload, errors error) {
+	errors = r.DgraphAddBridge(ctx, input, upsert, &data)
+	return data, errors
+}
+
+// UpdateMatrixRoom is the resolver for the updateMatrixRoom field.
+func (r *mutationResolver) UpdateMatrixRoom(ctx context.Context, input model.UpdateMatrixRoomInput) (data *model.UpdateMatrixRoomPayload, errors error) {
+	errors = r.DgraphUpdateBridge(ctx, input, &data)
+	return data, errors
+}
+
+// DeleteMatrixRoom is the resolver for the deleteMatrixRoom field.
+func (r *mutationResolver) DeleteMatrixRoom(ctx context.Context, filter model.MatrixRoomFilter) (data *model.DeleteMatrixRoomPayload, errors error) {
+	errors = r.DgraphDeleteBridge(ctx, filter, &data)
+	return data, errors
+}
+
+// AddAttachment is the resolver for the addAttachment field.
+func (r *mutationResolver) AddAttachment(ctx context.Context, input []*model.AddAttachmentInput) (data *model.AddAttachmentPayload, errors error) {
+	errors = r.DgraphAddBridge(ctx, input, nil, &data)
+	return data, errors
+}
+
+// UpdateAttachment is the resolver for the updateAttachment field.
+func (r *mutationResolver) UpdateAttachment(ctx context.Context, input model.UpdateAttachmentInput) (data *model.UpdateAttachmentPayload, errors error) {
+	errors = r.DgraphUpdateBridge(ctx, input, &data)
+	return data, errors
+}
+
+// DeleteAttachment is the resolver for the deleteAttachment field.
+func (r *mutationResolver) DeleteAttachment(ctx context.Context, filter model.AttachmentFilter) (data *model.DeleteAttachmentPayload, errors error) {
+	errors = r.DgraphDeleteBridge(ctx, filter, &data)
+	return data, errors
+}
+
 // AddBlob is the resolver for the addBlob field.
 func (r *mutationResolver) AddBlob(ctx context.Context, input []*model.AddBlobInput) (data *model.AddBlobPayload, errors error) {
 	panic(fmt.Errorf("not implemented"))
@@ -498,6 +624,57 @@ func (r *queryResolver) AggregateRoleExt(ctx context.Context, filter *model.Role
 	panic(fmt.Errorf("not implemented"))
 }
 
+// GetTensionTemplate is the resolver for the getTensionTemplate field.
+func (r *queryResolver) GetTensionTemplate(ctx context.Context, id string) (data *model.TensionTemplate, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// QueryTensionTemplate is the resolver for the queryTensionTemplate field.
+func (r *queryResolver) QueryTensionTemplate(ctx context.Context, filter *model.TensionTemplateFilter, order *model.TensionTemplateOrder, first *int, offset *int) (data []*model.TensionTemplate, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// AggregateTensionTemplate is the resolver for the aggregateTensionTemplate field.
+func (r *queryResolver) AggregateTensionTemplate(ctx context.Context, filter *model.TensionTemplateFilter) (data *model.TensionTemplateAggregateResult, errors error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
+// GetRecurrence is the resolver for the getRecurrence field.
+func (r *queryResolver) GetRecurrence(ctx context.Context, id string) (data *model.Recurrence, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// QueryRecurrence is the resolver for the queryRecurrence field.
+func (r *queryResolver) QueryRecurrence(ctx context.Context, filter *model.RecurrenceFilter, order *model.RecurrenceOrder, first *int, offset *int) (data []*model.Recurrence, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// AggregateRecurrence is the resolver for the aggregateRecurrence field.
+func (r *queryResolver) AggregateRecurrence(ctx context.Context, filter *model.RecurrenceFilter) (data *model.RecurrenceAggregateResult, errors error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
+// GetTensionView is the resolver for the getTensionView field.
+func (r *queryResolver) GetTensionView(ctx context.Context, id string) (data *model.TensionView, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// QueryTensionView is the resolver for the queryTensionView field.
+func (r *queryResolver) QueryTensionView(ctx context.Context, filter *model.TensionViewFilter, order *model.TensionViewOrder, first *int, offset *int) (data []*model.TensionView, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// AggregateTensionView is the resolver for the aggregateTensionView field.
+func (r *queryResolver) AggregateTensionView(ctx context.Context, filter *model.TensionViewFilter) (data *model.TensionViewAggregateResult, errors error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // GetProject is the resolver for the getProject field.
 func (r *queryResolver) GetProject(ctx context.Context, id string) (data *model.Project, errors error) {
 	errors = r.DgraphBridgeRaw(ctx, &data)
@@ -643,6 +820,74 @@ func (r *queryResolver) AggregateReaction(ctx context.Context, filter *model.Rea
 	panic(fmt.Errorf("not implemented: AggregateReaction - aggregateReaction"))
 }
 
+// GetNotifPreference is the resolver for the getNotifPreference field.
+func (r *queryResolver) GetNotifPreference(ctx context.Context, id *string, preferenceid *string) (data *model.NotifPreference, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// QueryNotifPreference is the resolver for the queryNotifPreference field.
+func (r *queryResolver) QueryNotifPreference(ctx context.Context, filter *model.NotifPreferenceFilter, order *model.NotifPreferenceOrder, first *int, offset *int) (data []*model.NotifPreference, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// AggregateNotifPreference is the resolver for the aggregateNotifPreference field.
+func (r *queryResolver) AggregateNotifPreference(ctx context.Context, filter *model.NotifPreferenceFilter) (data *model.NotifPreferenceAggregateResult, errors error) {
+	panic(fmt.Errorf("not implemented: AggregateNotifPreference - aggregateNotifPreference"))
+}
+
+// GetMailingSetting is the resolver for the getMailingSetting field.
+func (r *queryResolver) GetMailingSetting(ctx context.Context, id *string, nameid *string) (data *model.MailingSetting, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// QueryMailingSetting is the resolver for the queryMailingSetting field.
+func (r *queryResolver) QueryMailingSetting(ctx context.Context, filter *model.MailingSettingFilter, order *model.MailingSettingOrder, first *int, offset *int) (data []*model.MailingSetting, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// AggregateMailingSetting is the resolver for the aggregateMailingSetting field.
+func (r *queryResolver) AggregateMailingSetting(ctx context.Context, filter *model.MailingSettingFilter) (data *model.MailingSettingAggregateResult, errors error) {
+	panic(fmt.Errorf("not implemented: AggregateMailingSetting - aggregateMailingSetting"))
+}
+
+// GetMatrixRoom is the resolver for the getMatrixRoom field.
+func (r *queryResolver) GetMatrixRoom(ctx context.Context, id *string, nameid *string) (data *model.MatrixRoom, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// QueryMatrixRoom is the resolver for the queryMatrixRoom field.
+func (r *queryResolver) QueryMatrixRoom(ctx context.Context, filter *model.MatrixRoomFilter, order *model.MatrixRoomOrder, first *int, offset *int) (data []*model.MatrixRoom, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// AggregateMatrixRoom is the resolver for the aggregateMatrixRoom field.
+func (r *queryResolver) AggregateMatrixRoom(ctx context.Context, filter *model.MatrixRoomFilter) (data *model.MatrixRoomAggregateResult, errors error) {
+	panic(fmt.Errorf("not implemented: AggregateMatrixRoom - aggregateMatrixRoom"))
+}
+
+// GetAttachment is the resolver for the getAttachment field.
+func (r *queryResolver) GetAttachment(ctx context.Context, id string) (data *model.Attachment, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// QueryAttachment is the resolver for the queryAttachment field.
+func (r *queryResolver) QueryAttachment(ctx context.Context, filter *model.AttachmentFilter, order *model.AttachmentOrder, first *int, offset *int) (data []*model.Attachment, errors error) {
+	errors = r.DgraphBridgeRaw(ctx, &data)
+	return data, errors
+}
+
+// AggregateAttachment is the resolver for the aggregateAttachment field.
+func (r *queryResolver) AggregateAttachment(ctx context.Context, filter *model.AttachmentFilter) (data *model.AttachmentAggregateResult, errors error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // GetBlob is the resolver for the getBlob field.
 func (r *queryResolver) GetBlob(ctx context.Context, id string) (data *model.Blob, errors error) {
 	panic(fmt.Errorf("not implemented"))
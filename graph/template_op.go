@@ -0,0 +1,164 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+// ApplyTensionTemplate applies the template referenced by a new tension:
+// the title pattern, the message skeleton (if no message is given),
+// the type (if the default one is given), and the labels and assignees
+// of the template.
+// It returns the events to push in the tension history.
+func ApplyTensionTemplate(uctx *model.UserCtx, tid string, input *model.AddTensionInput) ([]*model.EventRef, error) {
+	var events []*model.EventRef
+	if input.Template == nil || input.Template.ID == nil {
+		return events, nil
+	}
+
+	// The template must be available in the receiver circle (inherited from the parents).
+	templates, err := db.GetDB().GetTopTemplates("nameid", input.Receiverid, true)
+	if err != nil {
+		return events, err
+	}
+	var template *model.TensionTemplate
+	for i, t := range templates {
+		if t.ID == *input.Template.ID {
+			template = &templates[i]
+			break
+		}
+	}
+	if template == nil {
+		return events, LogErr("Access denied", fmt.Errorf("This template is not available in this circle."))
+	}
+
+	var patch model.TensionPatch
+	var changed bool
+	now := Now()
+	newEvent := func(eventType model.TensionEvent, new string) *model.EventRef {
+		return &model.EventRef{
+			CreatedAt: &now,
+			CreatedBy: &model.UserRef{Username: &uctx.Username},
+			EventType: &eventType,
+			New:       &new,
+		}
+	}
+
+	// Title pattern
+	if template.Title != nil && *template.Title != "" {
+		title := input.Title
+		if strings.Contains(*template.Title, "{title}") {
+			title = strings.ReplaceAll(*template.Title, "{title}", strings.TrimSpace(input.Title))
+		} else if strings.TrimSpace(input.Title) == "" {
+			title = *template.Title
+		}
+		if title != input.Title {
+			patch.Title = &title
+			changed = true
+		}
+	}
+
+	// Message skeleton
+	if (input.Message == nil || *input.Message == "") && template.Message != nil && *template.Message != "" {
+		patch.Message = template.Message
+		changed = true
+	}
+
+	// Type (tensions with a blob keep their type)
+	if template.Type != nil && *template.Type != input.Type && len(input.Blobs) == 0 &&
+		(input.Type == "" || input.Type == model.TensionTypeOperational) {
+		patch.Type = template.Type
+		e := newEvent(model.TensionEventTypeUpdated, string(*template.Type))
+		old := string(input.Type)
+		e.Old = &old
+		events = append(events, e)
+		changed = true
+	}
+
+	// Labels
+	for _, l := range template.Labels {
+		exists := false
+		for _, x := range input.Labels {
+			if x.ID != nil && *x.ID == l.ID {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			continue
+		}
+		id := l.ID
+		patch.Labels = append(patch.Labels, &model.LabelRef{ID: &id})
+		color := ""
+		if l.Color != nil {
+			color = *l.Color
+		}
+		events = append(events, newEvent(model.TensionEventLabelAdded, l.Name+"§"+color))
+		changed = true
+	}
+
+	// Assignees (must be member of the organisation)
+	rootnameid, err := codec.Nid2rootid(input.Receiverid)
+	if err != nil {
+		return events, err
+	}
+	for _, u := range template.Assignees {
+		exists := false
+		for _, x := range input.Assignees {
+			if x.Username != nil && *x.Username == u.Username {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			continue
+		}
+		uctxFs, err := db.GetDB().GetUctx("username", u.Username)
+		if err != nil {
+			return events, err
+		}
+		if auth.UserIsMember(uctxFs, rootnameid) < 0 {
+			continue
+		}
+		username := u.Username
+		patch.Assignees = append(patch.Assignees, &model.UserRef{Username: &username})
+		events = append(events, newEvent(model.TensionEventAssigneeAdded, username))
+		changed = true
+	}
+
+	if !changed {
+		return events, nil
+	}
+
+	err = db.GetDB().Update(db.GetDB().GetRootUctx(), "tension", model.UpdateTensionInput{
+		Filter: &model.TensionFilter{ID: []string{tid}},
+		Set:    &patch,
+	})
+	return events, err
+}
@@ -52,6 +52,8 @@ type EventMap struct {
 	Propagate string
 	// Action defined the fonction that should be executed if the user has been authorized.
 	Action func(*model.UserCtx, *model.Tension, *model.EventRef, *model.BlobRef) (bool, error)
+	// NeedContract defined if the event can only be processed through a contract.
+	NeedContract bool
 }
 type EventsMap = map[model.TensionEvent]EventMap
 
@@ -75,7 +77,7 @@ type AuthHookValue int
 const (
 	PassingHook AuthHookValue = 1 // for public event
 	// Graph Role based
-	OwnerHook        AuthHookValue = 1 << 1 // @DEBUG: Not used for now as the owner is implemented in CheckUserAuth
+	OwnerHook        AuthHookValue = 1 << 1 // Owner of the organisation (note that owner also pass the coordo hooks, see CheckUserAuth)
 	MemberHook       AuthHookValue = 1 << 2
 	MemberStrictHook AuthHookValue = 1 << 3
 	MemberActiveHook AuthHookValue = 1 << 4
@@ -132,6 +134,8 @@ func init() {
 		model.TensionEventTitleUpdated:  TitleUpdating,
 		model.TensionEventTypeUpdated:   TypeUpdating,
 		model.TensionEventCommentPushed: CommentPushing,
+		// Due date changes need the same rights as title changes.
+		model.TensionEventDueDateUpdated: TitleUpdating,
 	}
 }
 
@@ -154,6 +158,13 @@ func (em EventMap) Check(uctx *model.UserCtx, tension *model.Tension, event *mod
 		return ok, contract, err
 	}
 
+	// Confidential tension are restricted to their readers
+	// --
+	ok, err = checkConfidentialAccess(uctx, tension)
+	if !ok || err != nil {
+		return ok, contract, err
+	}
+
 	// Exception Hook Authorization (EventMap:Auth)
 	// --
 	if hookEnabled {
@@ -216,6 +227,45 @@ func (em EventMap) checkTensionRestriction(uctx *model.UserCtx, tension *model.T
 	return ok, err
 }
 
+// checkConfidentialAccess checks that the user can read the tension if it is confidential,
+// that is, if the user is the author, an assignee, a participant or a coordinator of the receiver.
+func checkConfidentialAccess(uctx *model.UserCtx, tension *model.Tension) (bool, error) {
+	if !isConfidential(tension) {
+		return true, nil
+	}
+	if uctx.Rights.Type == model.UserTypeRoot {
+		return true, nil
+	}
+	if tension.CreatedBy != nil && uctx.Username == tension.CreatedBy.Username {
+		return true, nil
+	}
+	if ok, err := auth.HasCoordoAuth(uctx, tension.Receiver.Nameid, &tension.Receiver.Mode); ok || err != nil {
+		return ok, err
+	}
+	readers, err := db.GetDB().GetConfidentialReaders(tension.ID)
+	if err != nil {
+		return false, err
+	}
+	return IndexOf(readers, uctx.Username) >= 0, nil
+}
+
+func isConfidential(tension *model.Tension) bool {
+	return tension.Confidential != nil && *tension.Confidential
+}
+
+// checkTensionVisibility checks that the user can read the tension,
+// that is, its receiver circle is visible and it is not confidential to the user.
+func checkTensionVisibility(uctx *model.UserCtx, tension *model.Tension) (bool, error) {
+	nameids, _, err := auth.VisibleNameids(*uctx, []string{tension.Receiver.Nameid})
+	if err != nil {
+		return false, err
+	}
+	if len(nameids) == 0 {
+		return false, nil
+	}
+	return checkConfidentialAccess(uctx, tension)
+}
+
 // checkTensionAuth checks the tension can be processed based on graph based properties of the user asking.
 func (em EventMap) checkTensionAuth(uctx *model.UserCtx, tension *model.Tension, event *model.EventRef, contract *model.Contract) (bool, error) {
 	var err error
@@ -247,6 +297,12 @@ func (em EventMap) checkTensionAuth(uctx *model.UserCtx, tension *model.Tension,
 		}
 	}
 
+	if OwnerHook&em.Auth > 0 {
+		if auth.UserIsOwner(uctx, tension.Receiver.Nameid) >= 0 {
+			return true, err
+		}
+	}
+
 	if MemberHook&em.Auth > 0 {
 		if auth.UserIsMember(uctx, tension.Receiver.Nameid) >= 0 {
 			return true, err
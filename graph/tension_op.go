@@ -22,6 +22,7 @@ package graph
 
 import (
 	"fmt"
+	"time"
 
 	"fractale/fractal6.go/db"
 	"fractale/fractal6.go/graph/codec"
@@ -55,6 +56,44 @@ func init() {
 			Auth:      SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
 			Propagate: "type_",
 		},
+		model.TensionEventDueDateUpdated: EventMap{
+			Auth:   SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
+			Action: UpdateDueDate,
+		},
+		model.TensionEventBlockAdded: EventMap{
+			Auth:   SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
+			Action: ChangeRelation,
+		},
+		model.TensionEventBlockRemoved: EventMap{
+			Auth:   SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
+			Action: ChangeRelation,
+		},
+		model.TensionEventDuplicateUpdated: EventMap{
+			Auth:   SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
+			Action: ChangeRelation,
+		},
+		model.TensionEventParentUpdated: EventMap{
+			Auth:   SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
+			Action: ChangeRelation,
+		},
+		model.TensionEventRelatedAdded: EventMap{
+			Auth:   SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
+			Action: ChangeRelation,
+		},
+		model.TensionEventRelatedRemoved: EventMap{
+			Auth:   SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
+			Action: ChangeRelation,
+		},
+		model.TensionEventProjectAdded: EventMap{
+			// The project rights are checked by the caller (see bulkAddToProject).
+			Auth:   PassingHook,
+			Action: CheckProjectLink,
+		},
+		model.TensionEventMerged: EventMap{
+			// The target receiver is checked in the action (see AnyCoordoDual).
+			Auth:   TargetCoordoHook,
+			Action: MergeTension,
+		},
 		model.TensionEventReopened: EventMap{
 			Auth:      SourceCoordoHook | TargetCoordoHook | AuthorHook | AssigneeHook,
 			Propagate: "status",
@@ -78,6 +117,18 @@ func init() {
 		model.TensionEventAssigneeRemoved: EventMap{
 			Auth: TargetCoordoHook,
 		},
+		model.TensionEventConfidentialUpdated: EventMap{
+			Auth: TargetCoordoHook | AuthorHook,
+		},
+		model.TensionEventParticipantAdded: EventMap{
+			Auth: TargetCoordoHook | AuthorHook,
+			Restrict: []RestrictValue{
+				UserNewIsMemberRestrict,
+			},
+		},
+		model.TensionEventParticipantRemoved: EventMap{
+			Auth: TargetCoordoHook | AuthorHook,
+		},
 		model.TensionEventPinned: EventMap{
 			Auth:   TargetCoordoHook,
 			Action: PinTension,
@@ -137,6 +188,32 @@ func init() {
 			Auth:   PassingHook,
 			Action: UserLeave,
 		},
+		model.TensionEventOwnerAdded: EventMap{
+			// The candidate must accept the ownership.
+			Validation:   model.ContractTypeAnyCandidates,
+			Auth:         OwnerHook | CandidateHook,
+			Action:       ChangeOwner,
+			NeedContract: true,
+			Restrict: []RestrictValue{
+				UserNewIsMemberRestrict,
+			},
+		},
+		model.TensionEventOwnerTransferred: EventMap{
+			// The candidate must accept the ownership.
+			Validation:   model.ContractTypeAnyCandidates,
+			Auth:         OwnerHook | CandidateHook,
+			Action:       ChangeOwner,
+			NeedContract: true,
+			Restrict: []RestrictValue{
+				UserNewIsMemberRestrict,
+			},
+		},
+		model.TensionEventOwnerRemoved: EventMap{
+			// Owners can only remove themselves: removing another owner
+			// requires its consent, which is done by a transfer.
+			Auth:   OwnerHook,
+			Action: ChangeOwner,
+		},
 	}
 
 	SubscribingEvents = map[model.TensionEvent]bool{
@@ -198,6 +275,31 @@ func TensionEventHook(uctx *model.UserCtx, tid string, events []*model.EventRef,
 	return ok, contract, err
 }
 
+// ProcessNewTension validates and processes the events of a tension just added,
+// then applies its template and publishes the history. The tension is deleted
+// if the events are not authorized.
+func ProcessNewTension(uctx *model.UserCtx, tid string, input *model.AddTensionInput, history []*model.EventRef) (bool, error) {
+	ok, _, err := TensionEventHook(uctx, tid, history, nil)
+	if !ok || err != nil {
+		// Delete the tension just added
+		e := db.GetDB().DeepDelete("tension", tid)
+		if e != nil {
+			panic(e)
+		}
+		return ok, err
+	}
+
+	// Apply the tension template, if any
+	events, err := ApplyTensionTemplate(uctx, tid, input)
+	if err != nil {
+		return ok, err
+	}
+	history = append(history, events...)
+
+	PublishTensionEvent(model.EventNotif{Uctx: uctx, Tid: tid, History: history})
+	return ok, err
+}
+
 func ProcessEvent(uctx *model.UserCtx, tension *model.Tension, event *model.EventRef, blob *model.BlobRef, contract *model.Contract,
 	doCheck, doProcess bool) (bool, *model.Contract, error) {
 	var ok bool
@@ -211,6 +313,9 @@ func ProcessEvent(uctx *model.UserCtx, tension *model.Tension, event *model.Even
 	if !hasEvent { // Minimum level of authorization
 		return false, nil, LogErr("Access denied", fmt.Errorf("Event not implemented."))
 	}
+	if em.NeedContract && contract == nil && doProcess {
+		return false, nil, LogErr("Access denied", fmt.Errorf("This event requires a contract."))
+	}
 
 	// Check Authorization (optionally generate a contract)
 	if doCheck {
@@ -623,13 +728,66 @@ func UserLeave(uctx *model.UserCtx, tension *model.Tension, event *model.EventRe
 		role_type == model.RoleTypePending {
 		return false, fmt.Errorf("You cannot leave this role like this.")
 	} else if role_type == model.RoleTypeOwner {
-		return false, fmt.Errorf("Owner cannot leave organisation. Please contact us if you need to transfer ownership.")
+		return false, fmt.Errorf("Owner cannot leave organisation. Please transfer your ownership first.")
 	}
 
 	ok, err := LeaveRole(uctx, tension, node, unsafe)
 	return ok, err
 }
 
+func ChangeOwner(uctx *model.UserCtx, tension *model.Tension, event *model.EventRef, b *model.BlobRef) (bool, error) {
+	// ChangeOwner
+	// * Owner are attached to the root circle only
+	// * Upgrade/downgrade the membership node of the users
+	// * Refresh the users claims
+	var err error
+	rootnameid := tension.Receiver.Nameid
+	if !codec.IsRoot(rootnameid) {
+		return false, LogErr("Value error", fmt.Errorf("Ownership can only be changed in the root circle."))
+	}
+
+	switch *event.EventType {
+	case model.TensionEventOwnerAdded:
+		if event.New == nil {
+			return false, fmt.Errorf("new event data must be defined.")
+		}
+		err = AddOwner(rootnameid, *event.New)
+	case model.TensionEventOwnerRemoved:
+		if event.Old == nil {
+			return false, fmt.Errorf("old event data must be defined.")
+		}
+		if *event.Old != uctx.Username {
+			return false, LogErr("Access denied", fmt.Errorf("Owners can only remove themselves."))
+		}
+		err = RemoveOwner(rootnameid, *event.Old)
+	case model.TensionEventOwnerTransferred:
+		if event.Old == nil || event.New == nil {
+			return false, fmt.Errorf("old and new event data must be defined.")
+		}
+		// One can only transfer its own ownership (the contract author).
+		if *event.Old != uctx.Username &&
+			(event.CreatedBy == nil || event.CreatedBy.Username == nil || *event.CreatedBy.Username != *event.Old) {
+			return false, LogErr("Access denied", fmt.Errorf("You can only transfer your own ownership."))
+		}
+		// Add first, as an organisation cannot be left without owner.
+		err = AddOwner(rootnameid, *event.New)
+		if err != nil {
+			return false, err
+		}
+		err = RemoveOwner(rootnameid, *event.Old)
+	default:
+		err = fmt.Errorf("bad tension event '%s'.", string(*event.EventType))
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	// Let the user context synchronisation know that ownership has changed.
+	err = db.GetDB().SetFieldByEq("Node.nameid", rootnameid, "Node.updatedAt", Now())
+	return true, err
+}
+
 func PinTension(uctx *model.UserCtx, tension *model.Tension, event *model.EventRef, b *model.BlobRef) (bool, error) {
 	tid := tension.ID
 	nameid := tension.Receiver.Nameid
@@ -660,6 +818,296 @@ func UnpinTension(uctx *model.UserCtx, tension *model.Tension, event *model.Even
 	return true, err
 }
 
+// UpdateDueDate sets the due date of the tension, or removes it if the new value is empty.
+func UpdateDueDate(uctx *model.UserCtx, tension *model.Tension, event *model.EventRef, b *model.BlobRef) (bool, error) {
+	if event.New == nil || *event.New == "" {
+		err := db.GetDB().ClearFieldById(tension.ID, "Tension.dueDate")
+		return true, err
+	}
+
+	dueDate, err := ParseDueDate(*event.New)
+	if err != nil {
+		return false, LogErr("Value error", err)
+	}
+	err = db.GetDB().UpdateValue(*uctx, "tension", tension.ID, "dueDate", dueDate.Format(time.RFC3339))
+	return true, err
+}
+
+// ChangeRelation adds or removes a typed relation between the tension and the
+// tension given in the event new value. For the Updated events, an empty value
+// removes the relation.
+func ChangeRelation(uctx *model.UserCtx, tension *model.Tension, event *model.EventRef, b *model.BlobRef) (bool, error) {
+	tid := tension.ID
+	var target string
+	if event.New != nil {
+		target = *event.New
+	}
+	if target == tid {
+		return false, LogErr("Value error", fmt.Errorf("A tension cannot be related to itself."))
+	}
+
+	// Only the Updated events can unset a relation.
+	if target == "" && *event.EventType != model.TensionEventDuplicateUpdated && *event.EventType != model.TensionEventParentUpdated {
+		return false, LogErr("Value error", fmt.Errorf("Event new field must be given."))
+	}
+
+	// The related tension must be readable by the user
+	// and belong to the same organisation.
+	var targetTension *model.Tension
+	if target != "" {
+		if !IsUid(target) {
+			return false, LogErr("Value error", fmt.Errorf("Invalid tension id: %s", target))
+		}
+		var err error
+		targetTension, err = db.GetDB().GetTensionHook(target, false, nil)
+		if err != nil {
+			return false, err
+		}
+		if targetTension == nil {
+			return false, LogErr("Value error", fmt.Errorf("tension not found."))
+		}
+		if ok, err := checkTensionVisibility(uctx, targetTension); err != nil {
+			return false, err
+		} else if !ok {
+			return false, LogErr("Access denied", fmt.Errorf("tension not found."))
+		}
+		rootnameid, _ := codec.Nid2rootid(tension.Receiver.Nameid)
+		targetRootnameid, _ := codec.Nid2rootid(targetTension.Receiver.Nameid)
+		if rootnameid != targetRootnameid {
+			return false, LogErr("Access denied", fmt.Errorf("Related tensions must belong to the same organisation."))
+		}
+	}
+
+	ref := []*model.TensionRef{&model.TensionRef{ID: &target}}
+	input := model.UpdateTensionInput{Filter: &model.TensionFilter{ID: []string{tid}}}
+	switch *event.EventType {
+	case model.TensionEventBlockAdded:
+		// The blocking graph must stay acyclic.
+		if cycle, err := db.GetDB().IsTensionReachable(target, tid, "Tension.blocks"); err != nil {
+			return false, err
+		} else if cycle {
+			return false, LogErr("Value error", fmt.Errorf("This tension is already blocked by the given tension."))
+		}
+		input.Set = &model.TensionPatch{Blocks: ref}
+	case model.TensionEventBlockRemoved:
+		input.Remove = &model.TensionPatch{Blocks: ref}
+	case model.TensionEventDuplicateUpdated:
+		if target == "" {
+			old := getTensionEdge(tid, "Tension.duplicate_of")
+			if old == nil {
+				return true, nil
+			}
+			input.Remove = &model.TensionPatch{DuplicateOf: old}
+		} else {
+			input.Set = &model.TensionPatch{DuplicateOf: ref[0]}
+		}
+	case model.TensionEventParentUpdated:
+		if target == "" {
+			old := getTensionEdge(tid, "Tension.parent")
+			if old == nil {
+				return true, nil
+			}
+			input.Remove = &model.TensionPatch{Parent: old}
+		} else {
+			// A tension cannot be a subtask of its own subtasks.
+			if cycle, err := db.GetDB().IsTensionReachable(target, tid, "Tension.parent"); err != nil {
+				return false, err
+			} else if cycle {
+				return false, LogErr("Value error", fmt.Errorf("The given tension is a subtask of this tension."))
+			}
+			input.Set = &model.TensionPatch{Parent: ref[0]}
+		}
+	case model.TensionEventRelatedAdded, model.TensionEventRelatedRemoved:
+		// Relates-to is symmetric, so the user must have the same rights on the other tension.
+		if ok, err := EMAP[*event.EventType].checkTensionAuth(uctx, targetTension, event, nil); err != nil {
+			return false, err
+		} else if !ok {
+			return false, LogErr("Access denied", fmt.Errorf("You are not authorized to update the related tension."))
+		}
+		other := model.UpdateTensionInput{Filter: &model.TensionFilter{ID: []string{target}}}
+		back := &model.TensionPatch{Related: []*model.TensionRef{&model.TensionRef{ID: &tid}}}
+		if *event.EventType == model.TensionEventRelatedAdded {
+			input.Set = &model.TensionPatch{Related: ref}
+			other.Set = back
+		} else {
+			input.Remove = &model.TensionPatch{Related: ref}
+			other.Remove = back
+		}
+		if err := db.GetDB().Update(db.GetDB().GetRootUctx(), "tension", other); err != nil {
+			return false, err
+		}
+	default:
+		return false, fmt.Errorf("unknown relation event: %s", *event.EventType)
+	}
+
+	err := db.GetDB().Update(db.GetDB().GetRootUctx(), "tension", input)
+	return true, err
+}
+
+// CheckProjectLink checks that the tension can be added to the project given in
+// the event new value: the tension must be readable by the user and belong to the
+// organisation of the project. The project card itself is added by the caller.
+func CheckProjectLink(uctx *model.UserCtx, tension *model.Tension, event *model.EventRef, b *model.BlobRef) (bool, error) {
+	if event.New == nil || !IsUid(*event.New) {
+		return false, LogErr("Value error", fmt.Errorf("A project id must be given."))
+	}
+	if ok, err := checkTensionVisibility(uctx, tension); err != nil {
+		return false, err
+	} else if !ok {
+		return false, LogErr("Access denied", fmt.Errorf("tension not found."))
+	}
+	rootnameid, err := db.GetDB().GetFieldById(*event.New, "Project.rootnameid")
+	if err != nil {
+		return false, err
+	}
+	if rootnameid == nil {
+		return false, LogErr("Value error", fmt.Errorf("project not found."))
+	}
+	if rid, _ := codec.Nid2rootid(tension.Receiver.Nameid); rid != rootnameid.(string) {
+		return false, LogErr("Access denied", fmt.Errorf("The tension and the project must belong to the same organisation."))
+	}
+	return true, nil
+}
+
+// MergeTension merges the tension (event old value) into the tension given in
+// the event new value. Comments, subscribers, labels, assignees and mentions are
+// moved to the target, and the tension is closed as a duplicate of it.
+func MergeTension(uctx *model.UserCtx, tension *model.Tension, event *model.EventRef, b *model.BlobRef) (bool, error) {
+	if event.Old == nil || event.New == nil {
+		return false, fmt.Errorf("old and new event data must be defined.")
+	}
+	if *event.Old != tension.ID {
+		return false, fmt.Errorf("event source (%s) and actual tension (%s) differ.", *event.Old, tension.ID)
+	}
+	tid := tension.ID
+	targetid := *event.New
+	if targetid == tid {
+		return false, LogErr("Value error", fmt.Errorf("A tension cannot be merged into itself."))
+	}
+	if !IsUid(targetid) {
+		return false, LogErr("Value error", fmt.Errorf("Invalid tension id: %s", targetid))
+	}
+
+	// Fetch the target tension
+	target, err := db.GetDB().GetTensionHook(targetid, false, nil)
+	if err != nil {
+		return false, err
+	}
+	if target == nil {
+		return false, LogErr("Value error", fmt.Errorf("target tension not found."))
+	}
+	rootnameid, _ := codec.Nid2rootid(tension.Receiver.Nameid)
+	targetRootnameid, _ := codec.Nid2rootid(target.Receiver.Nameid)
+	if rootnameid != targetRootnameid {
+		return false, LogErr("Access denied", fmt.Errorf("Merged tensions must belong to the same organisation."))
+	}
+	// The content would be disclosed to other readers otherwise.
+	if isConfidential(tension) != isConfidential(target) {
+		return false, LogErr("Access denied", fmt.Errorf("Merged tensions must have the same confidentiality."))
+	}
+	if status, err := db.GetDB().GetFieldById(targetid, "Tension.status"); err != nil {
+		return false, err
+	} else if status != nil && status.(string) == string(model.TensionStatusClosed) {
+		return false, LogErr("Value error", fmt.Errorf("The target tension is closed."))
+	}
+
+	// The user must also be coordinator of the target receiver.
+	if ok, err := EMAP[model.TensionEventMerged].checkTensionAuth(uctx, target, event, nil); err != nil {
+		return false, err
+	} else if !ok {
+		return false, LogErr("Access denied", fmt.Errorf("You need to be coordinator of the target tension receiver."))
+	}
+
+	// Move the tension content into the target
+	content, err := db.GetDB().GetTensionContent(tid)
+	if err != nil {
+		return false, err
+	}
+	if content == nil {
+		return false, LogErr("Value error", fmt.Errorf("tension not found."))
+	}
+	var set model.TensionPatch
+	for _, c := range content.Comments {
+		id := c.ID
+		set.Comments = append(set.Comments, &model.CommentRef{ID: &id})
+	}
+	for _, u := range content.Subscribers {
+		username := u.Username
+		set.Subscribers = append(set.Subscribers, &model.UserRef{Username: &username})
+	}
+	for _, l := range content.Labels {
+		id := l.ID
+		set.Labels = append(set.Labels, &model.LabelRef{ID: &id})
+	}
+	for _, u := range content.Assignees {
+		username := u.Username
+		set.Assignees = append(set.Assignees, &model.UserRef{Username: &username})
+	}
+	for _, e := range content.Mentions {
+		id := e.ID
+		set.Mentions = append(set.Mentions, &model.EventRef{ID: &id})
+	}
+	err = db.GetDB().Update(db.GetDB().GetRootUctx(), "tension", model.UpdateTensionInput{
+		Filter: &model.TensionFilter{ID: []string{targetid}},
+		Set:    &set,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// Link the tension back to the target
+	input := model.UpdateTensionInput{
+		Filter: &model.TensionFilter{ID: []string{tid}},
+		Set:    &model.TensionPatch{DuplicateOf: &model.TensionRef{ID: &targetid}},
+	}
+	if len(set.Comments) > 0 {
+		input.Remove = &model.TensionPatch{Comments: set.Comments}
+	}
+	err = db.GetDB().Update(db.GetDB().GetRootUctx(), "tension", input)
+	if err != nil {
+		return false, err
+	}
+
+	// Close the tension through the Closed event (history and notifications)
+	now := Now()
+	createdBy := model.UserRef{Username: &uctx.Username}
+	if status, err := db.GetDB().GetFieldById(tid, "Tension.status"); err != nil {
+		return false, err
+	} else if status == nil || status.(string) != string(model.TensionStatusClosed) {
+		eventType := model.TensionEventClosed
+		old := string(model.TensionStatusOpen)
+		new := string(model.TensionStatusClosed)
+		closeEvent := &model.EventRef{CreatedAt: &now, CreatedBy: &createdBy, EventType: &eventType, Old: &old, New: &new}
+		if ok, _, err := ProcessEvent(uctx, tension, closeEvent, nil, nil, true, true); !ok || err != nil {
+			if err == nil {
+				err = LogErr("Access denied", fmt.Errorf("You are not authorized to close this tension."))
+			}
+			return false, err
+		}
+		PublishTensionEvent(model.EventNotif{Uctx: uctx, Tid: tid, History: []*model.EventRef{closeEvent}})
+	}
+
+	// Record the merge in the target history too
+	PublishTensionEvent(model.EventNotif{Uctx: uctx, Tid: targetid, History: []*model.EventRef{
+		&model.EventRef{CreatedAt: &now, CreatedBy: &createdBy, EventType: event.EventType, Old: event.Old, New: event.New},
+	}})
+
+	return true, err
+}
+
+// getTensionEdge returns a reference to the tension linked by the given predicate, if any.
+func getTensionEdge(tid, predicate string) *model.TensionRef {
+	x, err := db.GetDB().GetSubFieldById(tid, predicate, "uid")
+	if err != nil || x == nil {
+		return nil
+	}
+	id, ok := x.(string)
+	if !ok {
+		return nil
+	}
+	return &model.TensionRef{ID: &id}
+}
+
 //
 // Utilities
 //
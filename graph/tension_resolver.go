@@ -25,7 +25,6 @@ import (
 	"fmt"
 	"github.com/99designs/gqlgen/graphql"
 
-	"fractale/fractal6.go/db"
 	"fractale/fractal6.go/graph/model"
 	. "fractale/fractal6.go/tools"
 	"fractale/fractal6.go/web/auth"
@@ -108,6 +107,13 @@ func addTensionHook(ctx context.Context, obj interface{}, next graphql.Resolver)
 		return nil, LogErr("field missing", fmt.Errorf("id field is required in tension payload"))
 	}
 	input := inputs[0]
+	if len(input.Blocks) > 0 || len(input.BlockedBy) > 0 || input.DuplicateOf != nil || len(input.Duplicates) > 0 ||
+		input.Parent != nil || len(input.Subtasks) > 0 || len(input.Related) > 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Relations must be set with the relation events."))
+	}
+	if len(input.Attachments) > 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Attachments must be uploaded with the uploadAttachment mutation."))
+	}
 
 	// History and notification Logics --
 	// In order to notify user on the given event, we need to know their ids to pass and link them
@@ -128,19 +134,11 @@ func addTensionHook(ctx context.Context, obj interface{}, next graphql.Resolver)
 	id := tension.ID
 
 	// Validate and process Blob Event
-	ok, _, err := TensionEventHook(uctx, id, history, nil)
-	if !ok || err != nil {
-		// Delete the tension just added
-		e := db.GetDB().DeepDelete("tension", id)
-		if e != nil {
-			panic(e)
-		}
-	}
+	ok, err := ProcessNewTension(uctx, id, input, history)
 	if err != nil {
 		return data, err
 	}
 	if ok {
-		PublishTensionEvent(model.EventNotif{Uctx: uctx, Tid: id, History: history})
 		return data, err
 	}
 	return nil, LogErr("Access denied", fmt.Errorf("Contact a coordinator to access this ressource."))
@@ -169,6 +169,84 @@ func maybeUpdateMembership(rootnameid string, username string, rt model.RoleType
 	return fmt.Errorf("role upgrade not implemented: %s", rt)
 }
 
+// AddOwner upgrades the membership node of a member to the Owner role.
+func AddOwner(rootnameid string, username string) error {
+	uctxFs, err := db.GetDB().GetUctx("username", username)
+	if err != nil {
+		return err
+	}
+
+	if auth.UserIsOwner(uctxFs, rootnameid) >= 0 {
+		return fmt.Errorf("User '%s' is already owner of this organisation.", username)
+	}
+	if auth.UserIsMember(uctxFs, rootnameid) < 0 {
+		return fmt.Errorf("User '%s' must be a member of this organisation to become owner.", username)
+	}
+
+	nid := codec.MemberIdCodec(rootnameid, username)
+	err = db.GetDB().UpgradeMember(nid, model.RoleTypeOwner)
+	if err != nil {
+		return err
+	}
+	err = db.GetDB().AddUserRole(username, nid)
+	if err != nil {
+		return err
+	}
+
+	// Refresh the user claims (OWNIDS)
+	return auth.ClearRolesCache(username)
+}
+
+// RemoveOwner downgrades an owner to a Member (or Guest if he has no other roles).
+// An organisation always keeps at least one owner.
+func RemoveOwner(rootnameid string, username string) error {
+	owners, err := db.GetDB().Meta("getOwners", map[string]string{"rootnameid": rootnameid, "user_payload": "User.username"})
+	if err != nil {
+		return err
+	}
+	isOwner := false
+	for _, o := range owners {
+		if o["username"] == username {
+			isOwner = true
+			break
+		}
+	}
+	if !isOwner {
+		return fmt.Errorf("User '%s' is not owner of this organisation.", username)
+	}
+	if len(owners) < 2 {
+		return fmt.Errorf("An organisation needs at least one owner. Please add or transfer ownership first.")
+	}
+
+	uctxFs, err := db.GetDB().GetUctx("username", username)
+	if err != nil {
+		return err
+	}
+
+	// The owner node is also the membership node: unlink the owner role,
+	// and relink the membership node with its new role type.
+	nid := codec.MemberIdCodec(rootnameid, username)
+	rt := model.RoleTypeGuest
+	if len(auth.GetRoles(uctxFs, rootnameid)) > 1 {
+		rt = model.RoleTypeMember
+	}
+	err = db.GetDB().RemoveUserRole(username, nid)
+	if err != nil {
+		return err
+	}
+	err = db.GetDB().UpgradeMember(nid, rt)
+	if err != nil {
+		return err
+	}
+	err = db.GetDB().AddUserRole(username, nid)
+	if err != nil {
+		return err
+	}
+
+	// Refresh the user claims (OWNIDS)
+	return auth.ClearRolesCache(username)
+}
+
 // Pending user operations
 
 func MaybeSetPendingUserToken(email string) error {
@@ -0,0 +1,125 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/model"
+	"fractale/fractal6.go/web/auth"
+)
+
+// Maximum number of new tensions notified per view and per check.
+var viewWatchMax int = 20
+
+// PushTensionViewWatches notifies the watchers of the saved views of the
+// new tensions matching the view since the last check.
+func PushTensionViewWatches() error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	views, err := db.GetDB().GetWatchedTensionViews()
+	if err != nil {
+		return err
+	}
+
+	for _, v := range views {
+		since := v.CreatedAt
+		if v.CheckedAt != nil {
+			since = *v.CheckedAt
+		}
+
+		for _, w := range v.Watchers {
+			if err := pushTensionViewWatch(v, w.Username, since); err != nil {
+				log.Printf("View %s: watch failed for %s: %v", v.ID, w.Username, err)
+			}
+		}
+
+		if err := db.GetDB().SetFieldById(v.ID, "TensionView.checked_at", now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pushTensionViewWatch notifies the given user of the new tensions matching the view,
+// with the user rights.
+func pushTensionViewWatch(v model.TensionView, username, since string) error {
+	uctx, err := db.GetDB().GetUctx("username", username)
+	if err != nil {
+		return err
+	}
+	if v.Nameid == nil && (v.CreatedBy == nil || v.CreatedBy.Username != username) {
+		return nil
+	} else if v.Nameid != nil && auth.UserIsMember(uctx, v.Rootnameid) < 0 {
+		return nil
+	}
+
+	var q db.TensionQuery
+	if err = json.Unmarshal([]byte(v.Query), &q); err != nil {
+		return err
+	}
+	sort := "newest"
+	q.Sort = &sort
+	q.CreatedAfter = &since
+	q.First = viewWatchMax
+	q.Offset = 0
+	q.Cursor = nil
+	if err = auth.QueryAuthFilter(*uctx, &q); err != nil {
+		return err
+	}
+	tensions, err := db.GetDB().GetTensions(q, "light")
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tensions {
+		if t.ID == nil {
+			continue
+		}
+		// Don't notify the same tension twice.
+		key := fmt.Sprintf("view:%s:%s:%s", v.ID, username, *t.ID)
+		if isNew, err := cache.SetNX(ctx, key, 1, 7*24*time.Hour).Result(); err != nil {
+			return err
+		} else if !isNew {
+			continue
+		}
+
+		msg := fmt.Sprintf("New tension matching your view \"%s\"", v.Name)
+		if t.Title != nil {
+			msg = fmt.Sprintf("%s: %s", msg, *t.Title)
+		}
+		err = PushNotifNotifications(model.NotifNotif{
+			Uctx: uctx,
+			Tid:  t.ID,
+			Msg:  msg,
+			To:   []string{username},
+		}, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/codec"
+	"fractale/fractal6.go/graph/model"
+	. "fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+////////////////////////////////////////////////
+// TensionView Resolver
+////////////////////////////////////////////////
+
+// Add TensionView - Must be coordo of the circle for shared views
+func addTensionViewHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var inputs []model.AddTensionViewInput
+	ExtractInputs(ctx, &inputs)
+	for _, input := range inputs {
+		if input.CreatedBy == nil || input.CreatedBy.Username == nil || *input.CreatedBy.Username != uctx.Username {
+			return nil, LogErr("Access denied", fmt.Errorf("createdBy must be the current user."))
+		}
+		if input.CheckedAt != nil {
+			return nil, LogErr("Access denied", fmt.Errorf("checked_at is set by the notifier."))
+		}
+		for _, w := range input.Watchers {
+			if w.Username == nil || *w.Username != uctx.Username {
+				return nil, LogErr("Access denied", fmt.Errorf("Users can only subscribe themselves to a view."))
+			}
+		}
+		if err = checkTensionViewQuery(input.Query); err != nil {
+			return nil, err
+		}
+		if input.Nameid != nil {
+			if err = checkTensionViewCircle(uctx, input.Rootnameid, *input.Nameid); err != nil {
+				return nil, err
+			}
+		} else if auth.UserIsMember(uctx, input.Rootnameid) < 0 {
+			return nil, LogErr("Access denied", fmt.Errorf("You are not a member of this organisation."))
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// Update TensionView - Must be the author (personal views) or coordo of the circle (shared views).
+// Other members can only subscribe or unsubscribe themselves.
+func updateTensionViewHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var input model.UpdateTensionViewInput
+	ExtractInput(ctx, &input)
+	if input.Filter == nil || len(input.Filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	onlyWatch := isWatchPatch(uctx, input.Set) && isWatchPatch(uctx, input.Remove)
+	for _, id := range input.Filter.ID {
+		if !IsUid(id) {
+			return nil, LogErr("Value error", fmt.Errorf("invalid view id: %s", id))
+		}
+		view, err := db.GetDB().GetTensionView(id)
+		if err != nil || view == nil {
+			return nil, LogErr("Access denied", fmt.Errorf("view not found."))
+		}
+		if onlyWatch && (view.Nameid == nil || auth.UserIsMember(uctx, view.Rootnameid) >= 0) {
+			continue
+		}
+		if err = checkTensionViewEdit(uctx, view); err != nil {
+			return nil, err
+		}
+		if input.Set == nil {
+			continue
+		}
+		if input.Set.CreatedBy != nil || input.Set.CreatedAt != nil || input.Set.Rootnameid != nil || input.Set.CheckedAt != nil {
+			return nil, LogErr("Access denied", fmt.Errorf("createdBy, createdAt, rootnameid and checked_at cannot be changed."))
+		}
+		if input.Set.Query != nil {
+			if err = checkTensionViewQuery(*input.Set.Query); err != nil {
+				return nil, err
+			}
+		}
+		if input.Set.Nameid != nil {
+			if err = checkTensionViewCircle(uctx, view.Rootnameid, *input.Set.Nameid); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// Delete TensionView - Must be the author (personal views) or coordo of the circle (shared views)
+func deleteTensionViewHook(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	// Get User context
+	ctx, uctx, err := auth.GetUserContext(ctx)
+	if err != nil {
+		return nil, LogErr("Access denied", err)
+	}
+
+	// Validate input
+	var filter model.TensionViewFilter
+	ExtractFilter(ctx, &filter)
+	if len(filter.ID) == 0 {
+		return nil, LogErr("Access denied", fmt.Errorf("Query requires id filters."))
+	}
+	for _, id := range filter.ID {
+		if !IsUid(id) {
+			return nil, LogErr("Value error", fmt.Errorf("invalid view id: %s", id))
+		}
+		view, err := db.GetDB().GetTensionView(id)
+		if err != nil || view == nil {
+			return nil, LogErr("Access denied", fmt.Errorf("view not found."))
+		}
+		if err = checkTensionViewEdit(uctx, view); err != nil {
+			return nil, err
+		}
+	}
+
+	// Forward Query
+	return next(ctx)
+}
+
+// checkTensionViewEdit checks that the user can edit the given view.
+func checkTensionViewEdit(uctx *model.UserCtx, view *model.TensionView) error {
+	if view.Nameid == nil {
+		if view.CreatedBy == nil || view.CreatedBy.Username != uctx.Username {
+			return LogErr("Access denied", fmt.Errorf("Only the author can edit a personal view."))
+		}
+		return nil
+	}
+	ok, err := auth.HasCoordoAuth(uctx, *view.Nameid, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return LogErr("Access denied", fmt.Errorf("Only the coordinators of the circle can edit a shared view."))
+	}
+	return nil
+}
+
+// checkTensionViewCircle checks that a view can be shared in the given circle.
+func checkTensionViewCircle(uctx *model.UserCtx, rootnameid, nameid string) error {
+	rid, err := codec.Nid2rootid(nameid)
+	if err != nil {
+		return LogErr("Value error", err)
+	}
+	if rid != rootnameid {
+		return LogErr("Access denied", fmt.Errorf("rootnameid and nameid does not match."))
+	}
+	ok, err := auth.HasCoordoAuth(uctx, nameid, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return LogErr("Access denied", fmt.Errorf("Only the coordinators of the circle can share a view."))
+	}
+	return nil
+}
+
+// checkTensionViewQuery checks that the stored query is a valid tension query.
+func checkTensionViewQuery(query string) error {
+	var q db.TensionQuery
+	if err := json.Unmarshal([]byte(query), &q); err != nil {
+		return LogErr("Value error", fmt.Errorf("query is not a valid tension query: %s", err.Error()))
+	}
+	if q.Viewid != nil {
+		return LogErr("Value error", fmt.Errorf("A view cannot reference another view."))
+	}
+	return nil
+}
+
+// isWatchPatch returns true if the patch only (un)subscribes the user to the view.
+func isWatchPatch(uctx *model.UserCtx, patch *model.TensionViewPatch) bool {
+	if patch == nil {
+		return true
+	}
+	if patch.CreatedBy != nil || patch.CreatedAt != nil || patch.Rootnameid != nil || patch.Nameid != nil ||
+		patch.Name != nil || patch.Query != nil || patch.Display != nil || patch.CheckedAt != nil {
+		return false
+	}
+	for _, w := range patch.Watchers {
+		if w.Username == nil || *w.Username != uctx.Username {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tools
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Header line introducing the quoted message in a reply (english and french),
+// e.g. "On Mon, 1 Jan 2024, Alice <alice@example.com> wrote:".
+var replyHeaderReg = regexp.MustCompile(`^(On|Le)\s.*(wrote|écrit)\s?:$`)
+
+// StripEmailReply returns the new content of an email reply, without the
+// quoted message and the signature.
+func StripEmailReply(body string) string {
+	lines := strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+	var out []string
+	for i, line := range lines {
+		l := strings.TrimSpace(line)
+		// The reply header may be wrapped on two lines.
+		next := l
+		if i+1 < len(lines) {
+			next = l + " " + strings.TrimSpace(lines[i+1])
+		}
+		if line == "-- " || l == "--" || // signature delimiter
+			l == "-----Original Message-----" ||
+			strings.HasPrefix(l, "________________________________") ||
+			strings.HasPrefix(l, "Sent from my ") ||
+			replyHeaderReg.MatchString(l) || replyHeaderReg.MatchString(next) {
+			break
+		}
+		out = append(out, line)
+	}
+
+	// Remove the trailing quoted lines
+	for len(out) > 0 {
+		l := strings.TrimSpace(out[len(out)-1])
+		if l != "" && !strings.HasPrefix(l, ">") {
+			break
+		}
+		out = out[:len(out)-1]
+	}
+
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+// Commands recognised in an email reply to a contract notification,
+// and the vote value they stand for.
+var voteCommands = map[string]int{
+	"+1":     1,
+	"accept": 1,
+	"-1":     0,
+	"reject": 0,
+}
+
+// ParseVoteCommand looks for a vote command ("+1", "-1", "accept" or "reject")
+// standing on its own line in the given reply. It returns the vote value, whether
+// a command was found, and the remaining text. Only the first command counts.
+func ParseVoteCommand(body string) (int, bool, string) {
+	var vote int
+	var found bool
+	var out []string
+	for _, line := range strings.Split(body, "\n") {
+		if v, ok := voteCommands[strings.ToLower(strings.TrimSpace(line))]; ok && !found {
+			vote = v
+			found = true
+			continue
+		}
+		out = append(out, line)
+	}
+	if !found {
+		return 0, false, body
+	}
+	return vote, true, strings.TrimSpace(strings.Join(out, "\n"))
+}
@@ -0,0 +1,73 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tools
+
+import (
+	"testing"
+)
+
+func TestStripEmailReply(t *testing.T) {
+	testcases := []struct {
+		input string
+		want  string
+	}{
+		{"Hello", "Hello"},
+		{"Hello\r\n\r\nworld\r\n", "Hello\n\nworld"},
+		{"Hello\n\nOn Mon, 1 Jan 2024, Alice <alice@example.com> wrote:\n> previous\n> message", "Hello"},
+		{"Hello\n\nOn Mon, 1 Jan 2024 at 10:00, Alice <\nalice@example.com> wrote:\n> previous", "Hello"},
+		{"Bonjour\n\nLe lun. 1 janv. 2024, Alice a écrit :\n> précédent", "Bonjour"},
+		{"Hello\n-- \nAlice\nCEO", "Hello"},
+		{"Hello\n\n-----Original Message-----\nFrom: Alice", "Hello"},
+		{"Hello\n\nSent from my phone", "Hello"},
+		{"> quoted\nanswer\n> quoted\n>", "> quoted\nanswer"},
+		{"a --- b\n---\nc", "a --- b\n---\nc"},
+	}
+
+	for _, test := range testcases {
+		if got := StripEmailReply(test.input); got != test.want {
+			t.Errorf("StripEmailReply(%q) = %q, want %q", test.input, got, test.want)
+		}
+	}
+}
+
+func TestParseVoteCommand(t *testing.T) {
+	testcases := []struct {
+		input string
+		vote  int
+		found bool
+		rest  string
+	}{
+		{"+1", 1, true, ""},
+		{"Accept\n\nLooks good to me.", 1, true, "Looks good to me."},
+		{"I disagree.\n -1 \n", 0, true, "I disagree."},
+		{"reject\naccept", 0, true, "accept"},
+		{"I accept the role.", 0, false, "I accept the role."},
+		{"+1 for this", 0, false, "+1 for this"},
+	}
+
+	for _, test := range testcases {
+		vote, found, rest := ParseVoteCommand(test.input)
+		if vote != test.vote || found != test.found || rest != test.rest {
+			t.Errorf("ParseVoteCommand(%q) = (%d, %t, %q), want (%d, %t, %q)",
+				test.input, vote, found, rest, test.vote, test.found, test.rest)
+		}
+	}
+}
@@ -22,9 +22,15 @@ package tools
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 	//"io/ioutil"
 	"github.com/spf13/viper"
@@ -86,3 +92,163 @@ func MatrixJsonSend(body, roomid, access_token string) error {
 
 	return nil
 }
+
+//
+// Matrix client
+//
+
+// Room ids (!opaque:server, the server part is omitted since room v12)
+// and user ids (@localpart:server).
+var matrixRoomReg = regexp.MustCompile(`^![\w.=+/-]+(:[\w.-]+(:\d+)?)?$`)
+var matrixUserReg = regexp.MustCompile(`^@[a-z0-9._=/+-]+:[\w.-]+(:\d+)?$`)
+
+func IsMatrixRoomId(s string) bool { return len(s) <= 255 && matrixRoomReg.MatchString(s) }
+func IsMatrixUserId(s string) bool { return len(s) <= 255 && matrixUserReg.MatchString(s) }
+
+// MatrixClient is a minimal client of the Matrix client-server API,
+// authenticated as a bot user.
+type MatrixClient struct {
+	// Base URL of the homeserver (e.g. https://matrix.org)
+	Homeserver  string
+	AccessToken string
+	Client      *http.Client
+}
+
+// MatrixMessage is the content of a m.room.message event.
+type MatrixMessage struct {
+	Msgtype       string          `json:"msgtype"`
+	Body          string          `json:"body"`
+	Format        string          `json:"format,omitempty"`
+	FormattedBody string          `json:"formatted_body,omitempty"`
+	RelatesTo     *MatrixRelation `json:"m.relates_to,omitempty"`
+}
+
+// MatrixRelation relates a message to another event (e.g. a thread root).
+type MatrixRelation struct {
+	RelType string `json:"rel_type,omitempty"`
+	EventId string `json:"event_id,omitempty"`
+}
+
+type MatrixEvent struct {
+	Type    string        `json:"type"`
+	EventId string        `json:"event_id"`
+	Sender  string        `json:"sender"`
+	Content MatrixMessage `json:"content"`
+}
+
+// MatrixSync is the part of the /sync response used by the bridge.
+type MatrixSync struct {
+	NextBatch string `json:"next_batch"`
+	Rooms     struct {
+		Join map[string]struct {
+			Timeline struct {
+				Events []MatrixEvent `json:"events"`
+			} `json:"timeline"`
+		} `json:"join"`
+		Invite map[string]json.RawMessage `json:"invite"`
+	} `json:"rooms"`
+}
+
+// Only the room messages are synced.
+const matrixSyncFilter = `{"presence":{"types":[]},"account_data":{"types":[]},` +
+	`"room":{"state":{"types":[]},"ephemeral":{"types":[]},"account_data":{"types":[]},` +
+	`"timeline":{"types":["m.room.message"]}}}`
+
+var matrixTxn uint64
+
+func NewMatrixClient(homeserver, accessToken string) *MatrixClient {
+	return &MatrixClient{
+		Homeserver:  strings.TrimRight(homeserver, "/"),
+		AccessToken: accessToken,
+		Client:      &http.Client{Timeout: 90 * time.Second},
+	}
+}
+
+// NewMatrixText returns a text message, with an optional HTML formatted body,
+// posted in the thread of the given root event if any.
+func NewMatrixText(body, html, root string) MatrixMessage {
+	msg := MatrixMessage{Msgtype: "m.text", Body: body}
+	if html != "" {
+		msg.Format = "org.matrix.custom.html"
+		msg.FormattedBody = html
+	}
+	if root != "" {
+		msg.RelatesTo = &MatrixRelation{RelType: "m.thread", EventId: root}
+	}
+	return msg
+}
+
+// ThreadRoot returns the root event of the thread the event belongs to, if any.
+func (e MatrixEvent) ThreadRoot() string {
+	if e.Content.RelatesTo != nil && e.Content.RelatesTo.RelType == "m.thread" {
+		return e.Content.RelatesTo.EventId
+	}
+	return ""
+}
+
+// SendMessage posts a message in the room and returns its event id.
+func (c *MatrixClient) SendMessage(roomid string, msg MatrixMessage) (string, error) {
+	txnId := fmt.Sprintf("%d.%d", time.Now().UnixNano(), atomic.AddUint64(&matrixTxn, 1))
+	path := fmt.Sprintf("/rooms/%s/send/m.room.message/%s", url.PathEscape(roomid), txnId)
+	var res struct {
+		EventId string `json:"event_id"`
+	}
+	err := c.do("PUT", path, nil, msg, &res)
+	return res.EventId, err
+}
+
+// JoinRoom accepts an invitation to the room.
+func (c *MatrixClient) JoinRoom(roomid string) error {
+	return c.do("POST", fmt.Sprintf("/rooms/%s/join", url.PathEscape(roomid)), nil, struct{}{}, nil)
+}
+
+// Sync returns the room messages and invitations received since the given
+// batch token (all if empty), waiting for them at most timeout.
+func (c *MatrixClient) Sync(since string, timeout time.Duration) (*MatrixSync, error) {
+	query := url.Values{}
+	query.Set("filter", matrixSyncFilter)
+	query.Set("timeout", strconv.FormatInt(timeout.Milliseconds(), 10))
+	if since != "" {
+		query.Set("since", since)
+	}
+	var res MatrixSync
+	err := c.do("GET", "/sync", query, nil, &res)
+	return &res, err
+}
+
+func (c *MatrixClient) do(method, path string, query url.Values, body, res interface{}) error {
+	u := c.Homeserver + "/_matrix/client/v3" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var data io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		data = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, u, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.AccessToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		var e MatrixError
+		json.NewDecoder(resp.Body).Decode(&e)
+		return fmt.Errorf("http matrix error: %s %s (code %s)", e.Errcode, e.Error, resp.Status)
+	}
+	if res == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
@@ -21,9 +21,14 @@
 package tools
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/spf13/viper"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 var matrixPostalRoom string
@@ -44,3 +49,106 @@ func TestMatrixJsonSend(t *testing.T) {
 		t.Errorf("MatrixJsonSend failed: %s", err.Error())
 	}
 }
+
+// fakeHomeserver serves the client-server API endpoints used by MatrixClient.
+func fakeHomeserver(t *testing.T, sent *[]MatrixMessage) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(401)
+			w.Write([]byte(`{"errcode":"M_UNKNOWN_TOKEN","error":"Invalid access token"}`))
+			return
+		}
+		switch {
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.EscapedPath(), "/_matrix/client/v3/rooms/%21room:example.org/send/m.room.message/"):
+			var msg MatrixMessage
+			if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+				t.Errorf("invalid message: %v", err)
+			}
+			*sent = append(*sent, msg)
+			fmt.Fprintf(w, `{"event_id":"$e%d"}`, len(*sent))
+		case r.Method == "POST" && r.URL.EscapedPath() == "/_matrix/client/v3/rooms/%21room:example.org/join":
+			w.Write([]byte(`{"room_id":"!room:example.org"}`))
+		case r.Method == "GET" && r.URL.Path == "/_matrix/client/v3/sync":
+			if r.URL.Query().Get("since") != "s1" || r.URL.Query().Get("timeout") != "1000" {
+				t.Errorf("unexpected sync query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"next_batch":"s2","rooms":{
+				"join":{"!room:example.org":{"timeline":{"events":[
+					{"type":"m.room.message","event_id":"$r1","sender":"@alice:example.org",
+					 "content":{"msgtype":"m.text","body":"hello","m.relates_to":{"rel_type":"m.thread","event_id":"$e1"}}}
+				]}}},
+				"invite":{"!other:example.org":{}}}}`))
+		default:
+			w.WriteHeader(404)
+			w.Write([]byte(`{"errcode":"M_UNRECOGNIZED","error":"Unrecognized request"}`))
+		}
+	}))
+}
+
+func TestMatrixClient(t *testing.T) {
+	var sent []MatrixMessage
+	ts := fakeHomeserver(t, &sent)
+	defer ts.Close()
+	c := NewMatrixClient(ts.URL+"/", "secret")
+
+	// Send a thread root, then a message in the thread
+	root, err := c.SendMessage("!room:example.org", NewMatrixText("New tension", "<b>New tension</b>", ""))
+	if err != nil || root != "$e1" {
+		t.Fatalf("SendMessage: got %q, %v", root, err)
+	}
+	if _, err = c.SendMessage("!room:example.org", NewMatrixText("A comment", "", root)); err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if len(sent) != 2 || sent[0].RelatesTo != nil || sent[0].Format != "org.matrix.custom.html" ||
+		sent[1].RelatesTo == nil || sent[1].RelatesTo.RelType != "m.thread" || sent[1].RelatesTo.EventId != "$e1" {
+		t.Errorf("unexpected messages sent: %+v", sent)
+	}
+
+	if err = c.JoinRoom("!room:example.org"); err != nil {
+		t.Errorf("JoinRoom: %v", err)
+	}
+
+	res, err := c.Sync("s1", time.Second)
+	if err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+	events := res.Rooms.Join["!room:example.org"].Timeline.Events
+	if res.NextBatch != "s2" || len(events) != 1 || len(res.Rooms.Invite) != 1 {
+		t.Fatalf("unexpected sync response: %+v", res)
+	}
+	if e := events[0]; e.Sender != "@alice:example.org" || e.Content.Body != "hello" || e.ThreadRoot() != "$e1" {
+		t.Errorf("unexpected event: %+v", e)
+	}
+
+	// Errors
+	if err = c.JoinRoom("!unknown:example.org"); err == nil || !strings.Contains(err.Error(), "M_UNRECOGNIZED") {
+		t.Errorf("JoinRoom: expected M_UNRECOGNIZED error, got %v", err)
+	}
+	c.AccessToken = "wrong"
+	if _, err = c.SendMessage("!room:example.org", NewMatrixText("x", "", "")); err == nil {
+		t.Errorf("SendMessage: expected an error with a wrong token")
+	}
+}
+
+func TestIsMatrixId(t *testing.T) {
+	for _, s := range []string{"!abc:matrix.org", "!Abc_D-e:example.org:8448", "!31hneApxJ_1o-63DmFrpeqnkFfWppnzWso1JvH3ogLM"} {
+		if !IsMatrixRoomId(s) {
+			t.Errorf("%s should be a valid room id", s)
+		}
+	}
+	for _, s := range []string{"", "abc:matrix.org", "!abc:matrix.org/x", "!a b:matrix.org", "@alice:matrix.org"} {
+		if IsMatrixRoomId(s) {
+			t.Errorf("%s should not be a valid room id", s)
+		}
+	}
+	for _, s := range []string{"@alice:matrix.org", "@a.b_c=d/e-f:example.org:8448"} {
+		if !IsMatrixUserId(s) {
+			t.Errorf("%s should be a valid user id", s)
+		}
+	}
+	for _, s := range []string{"", "@alice", "@Alice:matrix.org", "alice:matrix.org", "@alice:matrix.org\n"} {
+		if IsMatrixUserId(s) {
+			t.Errorf("%s should not be a valid user id", s)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tools
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RRule is a subset of the iCalendar recurrence rule (RFC 5545).
+// Supported parts are FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL,
+// BYDAY (weekly rules), BYMONTHDAY (monthly rules), COUNT and UNTIL.
+// Weeks start on Monday.
+type RRule struct {
+	Freq       string
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+// Limit the number of periods explored to find the next occurrence.
+const rruleMaxPeriods = 100000
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ParseRRule parses a recurrence rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH".
+func ParseRRule(s string) (*RRule, error) {
+	r := &RRule{Interval: 1}
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid rrule part '%s'", part)
+		}
+		k, v := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		switch k {
+		case "FREQ":
+			switch v {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+				r.Freq = v
+			default:
+				return nil, fmt.Errorf("unsupported rrule frequency '%s'", v)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid rrule interval '%s'", v)
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid rrule count '%s'", v)
+			}
+			r.Count = n
+		case "UNTIL":
+			t, err := parseRRuleDate(v)
+			if err != nil {
+				return nil, err
+			}
+			r.Until = &t
+		case "BYDAY":
+			for _, d := range strings.Split(v, ",") {
+				wd, ok := rruleWeekdays[d]
+				if !ok {
+					return nil, fmt.Errorf("unsupported rrule day '%s'", d)
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(v, ",") {
+				n, err := strconv.Atoi(d)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid rrule month day '%s'", d)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rrule part '%s'", k)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, fmt.Errorf("rrule FREQ is required")
+	}
+	if len(r.ByDay) > 0 && r.Freq != "WEEKLY" {
+		return nil, fmt.Errorf("rrule BYDAY is only supported with FREQ=WEEKLY")
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq != "MONTHLY" {
+		return nil, fmt.Errorf("rrule BYMONTHDAY is only supported with FREQ=MONTHLY")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return nil, fmt.Errorf("rrule COUNT and UNTIL cannot be used together")
+	}
+	return r, nil
+}
+
+// Next returns the first occurrence strictly after the given time, for a series
+// starting at dtstart. It returns false if the series is over.
+func (r RRule) Next(dtstart, after time.Time) (time.Time, bool) {
+	n := 0
+	for period := 0; period < rruleMaxPeriods; period++ {
+		for _, t := range r.occurrences(dtstart, period) {
+			if t.Before(dtstart) {
+				continue
+			}
+			n++
+			if r.Count > 0 && n > r.Count {
+				return time.Time{}, false
+			}
+			if r.Until != nil && t.After(*r.Until) {
+				return time.Time{}, false
+			}
+			if t.After(after) {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// occurrences returns the sorted occurrences of the k-th period of the series.
+func (r RRule) occurrences(dtstart time.Time, k int) []time.Time {
+	var ts []time.Time
+	switch r.Freq {
+	case "DAILY":
+		ts = append(ts, dtstart.AddDate(0, 0, k*r.Interval))
+	case "WEEKLY":
+		start := dtstart.AddDate(0, 0, 7*k*r.Interval)
+		if len(r.ByDay) == 0 {
+			return append(ts, start)
+		}
+		monday := start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		for _, wd := range r.ByDay {
+			ts = append(ts, monday.AddDate(0, 0, (int(wd)+6)%7))
+		}
+	case "MONTHLY":
+		y, m, _ := dtstart.Date()
+		hh, mm, ss := dtstart.Clock()
+		first := time.Date(y, m+time.Month(k*r.Interval), 1, hh, mm, ss, 0, dtstart.Location())
+		last := first.AddDate(0, 1, -1).Day()
+		days := r.ByMonthDay
+		if len(days) == 0 {
+			days = []int{dtstart.Day()}
+		}
+		check := make(map[int]bool)
+		for _, d := range days {
+			if d < 0 {
+				d = last + d + 1
+			}
+			// Invalid days are skipped (e.g. the 31th in a 30 days month).
+			if d < 1 || d > last || check[d] {
+				continue
+			}
+			check[d] = true
+			ts = append(ts, first.AddDate(0, 0, d-1))
+		}
+	case "YEARLY":
+		y, m, d := dtstart.Date()
+		hh, mm, ss := dtstart.Clock()
+		t := time.Date(y+k*r.Interval, m, d, hh, mm, ss, 0, dtstart.Location())
+		// Skip the 29th of February on non-leap years.
+		if t.Day() == d {
+			ts = append(ts, t)
+		}
+	}
+
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Before(ts[j]) })
+	return ts
+}
+
+// parseRRuleDate parses an UNTIL value, in the iCalendar format or as a due date.
+func parseRRuleDate(v string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", v); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102", v); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	if t, err := ParseDueDate(v); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid rrule until date '%s'", v)
+}
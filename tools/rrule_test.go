@@ -0,0 +1,84 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRRuleNext(t *testing.T) {
+	dtstart := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC) // Wednesday
+	for _, c := range []struct {
+		rule, after string
+		want        []string
+		ends        bool
+	}{
+		{"FREQ=DAILY;INTERVAL=2;COUNT=3", "2024-01-01T00:00:00Z",
+			[]string{"2024-01-31T09:00:00Z", "2024-02-02T09:00:00Z", "2024-02-04T09:00:00Z"}, true},
+		{"FREQ=WEEKLY;BYDAY=MO,WE", "2024-01-31T09:00:00Z",
+			[]string{"2024-02-05T09:00:00Z", "2024-02-07T09:00:00Z", "2024-02-12T09:00:00Z"}, false},
+		{"FREQ=MONTHLY", "2024-01-31T09:00:00Z",
+			[]string{"2024-03-31T09:00:00Z", "2024-05-31T09:00:00Z"}, false},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20240430", "2024-01-31T09:00:00Z",
+			[]string{"2024-02-29T09:00:00Z", "2024-03-31T09:00:00Z", "2024-04-30T09:00:00Z"}, true},
+		{"FREQ=YEARLY", "2024-01-31T09:00:00Z",
+			[]string{"2025-01-31T09:00:00Z"}, false},
+	} {
+		r, err := ParseRRule(c.rule)
+		if err != nil {
+			t.Fatalf("ParseRRule(%q) error: %v", c.rule, err)
+		}
+		after, _ := time.Parse(time.RFC3339, c.after)
+		var got []string
+		for range c.want {
+			next, ok := r.Next(dtstart, after)
+			if !ok {
+				break
+			}
+			got = append(got, next.Format(time.RFC3339))
+			after = next
+		}
+		if strings.Join(got, " ") != strings.Join(c.want, " ") {
+			t.Errorf("%s, want: %v, got: %v", c.rule, c.want, got)
+		}
+		if _, ok := r.Next(dtstart, after); ok == c.ends {
+			t.Errorf("%s, series should end: %v", c.rule, c.ends)
+		}
+	}
+}
+
+func TestParseRRuleErrors(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;COUNT=2;UNTIL=20240101",
+	} {
+		if _, err := ParseRRule(rule); err == nil {
+			t.Errorf("ParseRRule(%q) should fail", rule)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tools
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestServeGracefulDrain(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := make(chan struct{})
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("done"))
+	})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- ServeGraceful(ctx, srv, ln, 2*time.Second) }()
+
+	// Start an in-flight request, then ask for shutdown.
+	body := make(chan string, 1)
+	go func() {
+		resp, err := http.Get("http://" + ln.Addr().String())
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		body <- string(b)
+	}()
+	<-started
+	cancel()
+
+	if got := <-body; got != "done" {
+		t.Errorf("in-flight request not drained, got: %q", got)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("ServeGraceful error: %v", err)
+	}
+
+	// New connections are refused after shutdown.
+	if _, err := http.Get("http://" + ln.Addr().String()); err == nil {
+		t.Errorf("server still accepting connections after shutdown")
+	}
+}
+
+func TestServeGracefulTimeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- ServeGraceful(ctx, srv, ln, 50*time.Millisecond) }()
+	go http.Get("http://" + ln.Addr().String())
+	<-started
+	cancel()
+
+	if err := <-served; err != context.DeadlineExceeded {
+		t.Errorf("want: %v, got: %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		wg.Done()
+	}()
+	if !WaitTimeout(&wg, time.Second) {
+		t.Errorf("WaitTimeout returned before the workers completed")
+	}
+
+	wg.Add(1)
+	if WaitTimeout(&wg, 20*time.Millisecond) {
+		t.Errorf("WaitTimeout should have timed out")
+	}
+	wg.Done()
+}
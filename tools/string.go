@@ -38,6 +38,13 @@ func IsDigit(s byte) bool {
 	return unicode.IsDigit(rune(s))
 }
 
+var uidRe = regexp.MustCompile(`^0x[0-9a-f]+$`)
+
+// IsUid returns true if s is a valid Dgraph uid.
+func IsUid(s string) bool {
+	return uidRe.MatchString(s)
+}
+
 func CleanString(data string, quote bool) string {
 	var d string = data
 	d = strings.Replace(d, `\n`, "", -1)
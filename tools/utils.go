@@ -22,6 +22,7 @@ package tools
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 	"reflect"
@@ -55,6 +56,32 @@ func TimeDelta(d1, d2 string) time.Duration {
 	return date1.Sub(date2)
 }
 
+// ParseDueDate parses a due date given either in RFC3339 or as a day (YYYY-MM-DD).
+// A day is due at the end of that day (UTC).
+func ParseDueDate(d string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, d); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.Parse("2006-01-02", d)
+	if err != nil {
+		return t, fmt.Errorf("invalid date '%s' (expected YYYY-MM-DD or RFC3339)", d)
+	}
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+// ParseDate parses a date given either in RFC3339 or as a day (YYYY-MM-DD).
+// A day is at the start of the day (UTC).
+func ParseDate(d string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, d); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.Parse("2006-01-02", d)
+	if err != nil {
+		return t, fmt.Errorf("invalid date '%s' (expected YYYY-MM-DD or RFC3339)", d)
+	}
+	return t, nil
+}
+
 // InitViper Read the config file
 func InitViper() {
 	viper.AddConfigPath("./")
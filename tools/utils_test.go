@@ -23,6 +23,7 @@ package tools
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"fractale/fractal6.go/graph/model"
 )
@@ -53,3 +54,38 @@ func TestStructMap(t *testing.T) {
 		t.Errorf("StructMap error, want: %v, got: %v", want, nodeInput)
 	}
 }
+
+func TestParseDueDate(t *testing.T) {
+	for _, c := range []struct{ in, want string }{
+		{"2024-03-01", "2024-03-01T23:59:59Z"},
+		{"2024-03-01T10:00:00Z", "2024-03-01T10:00:00Z"},
+		{"2024-03-01T10:00:00+02:00", "2024-03-01T08:00:00Z"},
+	} {
+		d, err := ParseDueDate(c.in)
+		if err != nil {
+			t.Fatalf("ParseDueDate(%q) error: %v", c.in, err)
+		}
+		if got := d.Format(time.RFC3339); got != c.want {
+			t.Errorf("ParseDueDate(%q), want: %s, got: %s", c.in, c.want, got)
+		}
+	}
+
+	if _, err := ParseDueDate("tomorrow"); err == nil {
+		t.Errorf("ParseDueDate should fail on invalid date")
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	for _, c := range []struct{ in, want string }{
+		{"2024-03-01", "2024-03-01T00:00:00Z"},
+		{"2024-03-01T10:00:00+02:00", "2024-03-01T08:00:00Z"},
+	} {
+		d, err := ParseDate(c.in)
+		if err != nil {
+			t.Fatalf("ParseDate(%q) error: %v", c.in, err)
+		}
+		if got := d.Format(time.RFC3339); got != c.want {
+			t.Errorf("ParseDate(%q), want: %s, got: %s", c.in, c.want, got)
+		}
+	}
+}
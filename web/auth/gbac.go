@@ -21,6 +21,7 @@
 package auth
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"fractale/fractal6.go/db"
@@ -36,7 +37,7 @@ import (
  *
  */
 
-var UserSelection string = "User.username User.email User.name User.notifyByEmail"
+var UserSelection string = "User.username User.email User.name User.lang User.notifyByEmail User.emailDelivery"
 
 // Inherits node properties
 func InheritNodeCharacDefault(node *model.NodeFragment, parent *model.Node) {
@@ -297,15 +298,49 @@ func GetPeersFromTid(tid string) ([]model.User, error) {
 // Sanitize TensionQuery
 //
 
-// NameidsProtected and Username information into the query.
-func QueryAuthFilter(uctx model.UserCtx, q *db.TensionQuery) error {
-	if q == nil {
-		return fmt.Errorf("Empty query")
+// ApplyTensionView replaces the query with the one of the saved view
+// referenced by q.Viewid, if any. Pagination fields are kept.
+func ApplyTensionView(uctx model.UserCtx, q *db.TensionQuery) error {
+	if q == nil || q.Viewid == nil {
+		return nil
+	}
+	if uctx.Username == "" {
+		return fmt.Errorf("Access denied")
+	}
+	if !IsUid(*q.Viewid) {
+		return fmt.Errorf("invalid viewid: %s", *q.Viewid)
 	}
 
-	res, err := db.GetDB().Query(uctx, "node", "nameid", q.Nameids, "nameid visibility")
+	view, err := db.GetDB().GetTensionView(*q.Viewid)
 	if err != nil {
 		return err
+	} else if view == nil {
+		return fmt.Errorf("view not found")
+	}
+	if view.Nameid == nil && (view.CreatedBy == nil || view.CreatedBy.Username != uctx.Username) {
+		return fmt.Errorf("Access denied")
+	} else if view.Nameid != nil && UserIsMember(&uctx, view.Rootnameid) < 0 {
+		return fmt.Errorf("Access denied")
+	}
+
+	var vq db.TensionQuery
+	if err = json.Unmarshal([]byte(view.Query), &vq); err != nil {
+		return err
+	}
+	vq.First = q.First
+	vq.Offset = q.Offset
+	vq.Cursor = q.Cursor
+	vq.Viewid = nil
+	*q = vq
+	return nil
+}
+
+// VisibleNameids splits the given nodes into the ones readable by the user
+// and the ones with a restricted visibility (private or secret).
+func VisibleNameids(uctx model.UserCtx, nids []string) ([]string, []string, error) {
+	res, err := db.GetDB().Query(uctx, "node", "nameid", nids, "nameid visibility")
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// For circle with visibility right
@@ -320,7 +355,7 @@ func QueryAuthFilter(uctx model.UserCtx, q *db.TensionQuery) error {
 		// Get the nearest circle
 		nid, err := codec.Nid2pid(r["nameid"])
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
 		if visibility == string(model.NodeVisibilityPrivate) && UserIsMember(&uctx, nid) < 0 {
@@ -335,9 +370,50 @@ func QueryAuthFilter(uctx model.UserCtx, q *db.TensionQuery) error {
 		}
 	}
 
+	return nameids, nameidsProtected, nil
+}
+
+// QueryAuthFilter set the visible Nameids, the restricted
+// NameidsProtected and Username information into the query.
+func QueryAuthFilter(uctx model.UserCtx, q *db.TensionQuery) error {
+	if q == nil {
+		return fmt.Errorf("Empty query")
+	}
+
+	nameids, nameidsProtected, err := VisibleNameids(uctx, q.Nameids)
+	if err != nil {
+		return err
+	}
+
 	q.Nameids = nameids
 	q.NameidsProtected = nameidsProtected
 	q.Username = uctx.Username
+	// Confidential and pending tensions are readable by the owners and by the
+	// coordinators of the receiver circle (see FormatTensionIntExtMap).
+	q.NameidsConfidential = nil
+	q.ConfidentialAll = uctx.Rights.Type == model.UserTypeRoot
+	if !q.ConfidentialAll && len(nameids) > 0 {
+		// Owners only read the confidential tensions of their organisations.
+		q.ConfidentialAll = true
+		for _, nameid := range nameids {
+			if UserIsOwner(&uctx, nameid) >= 0 {
+				q.NameidsConfidential = append(q.NameidsConfidential, nameid)
+			} else {
+				q.ConfidentialAll = false
+			}
+		}
+	}
+	for _, r := range uctx.Roles {
+		if q.ConfidentialAll {
+			break
+		}
+		if *r.RoleType != model.RoleTypeCoordinator {
+			continue
+		}
+		if pid, err := codec.Nid2pid(r.Nameid); err == nil {
+			q.NameidsConfidential = append(q.NameidsConfidential, pid)
+		}
+	}
 	// add NameidsProtected attribute in TensionQuery
 	if len(nameids)+len(nameidsProtected) == 0 {
 		return fmt.Errorf("error: no node name given (nameid empty)")
@@ -369,3 +369,18 @@ func MaybeRefresh(uctx *model.UserCtx) (*model.UserCtx, error) {
 	uctx.Hit++
 	return uctx, err
 }
+
+// ClearRolesCache drops the cached roles of the given user. The next MaybeRefresh
+// will fetch the roles from the database, and thus refresh the Dgraph claims
+// (ROOTIDS, OWNIDS) build from them.
+func ClearRolesCache(username string) error {
+	return cache.Del(context.Background(), username+"roles").Err()
+}
+
+// CheckSecret checks that the JWT secret is set and the token master initialized.
+func CheckSecret() error {
+	if jwtSecret == "" || tkMaster == nil {
+		return fmt.Errorf("JWT secret not found")
+	}
+	return nil
+}
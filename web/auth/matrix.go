@@ -0,0 +1,67 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	. "fractale/fractal6.go/tools"
+)
+
+// Matrix link tokens let a user link its Matrix id to its account by posting
+// `!link <token>` in a bridged room: <username>.<mac>.
+// The token is bound to the Matrix id, so that it is useless to the other
+// members of the room.
+
+func signMatrixLink(username, matrixid string) string {
+	return base64.RawURLEncoding.EncodeToString(tokenMac("matrix-link", username+" "+matrixid)[:16])
+}
+
+// NewMatrixLinkToken returns the token linking the Matrix id to the user.
+func NewMatrixLinkToken(username, matrixid string) (string, error) {
+	if jwtSecret == "" {
+		return "", fmt.Errorf("JWT secret not found")
+	}
+	if username == "" || !IsMatrixUserId(matrixid) {
+		return "", fmt.Errorf("invalid matrix id")
+	}
+	return username + "." + signMatrixLink(username, matrixid), nil
+}
+
+// ParseMatrixLinkToken verifies the token posted by the given Matrix id and
+// returns its user.
+func ParseMatrixLinkToken(token, matrixid string) (string, error) {
+	if jwtSecret == "" {
+		return "", fmt.Errorf("JWT secret not found")
+	}
+	i := strings.LastIndex(token, ".")
+	if i < 1 {
+		return "", fmt.Errorf("invalid matrix link token")
+	}
+	username, mac := token[:i], token[i+1:]
+	if !hmac.Equal([]byte(signMatrixLink(username, matrixid)), []byte(mac)) {
+		return "", fmt.Errorf("invalid matrix link token")
+	}
+	return username, nil
+}
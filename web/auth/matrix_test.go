@@ -0,0 +1,54 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"testing"
+)
+
+func TestMatrixLinkToken(t *testing.T) {
+	jwtSecret = "secret"
+
+	token, err := NewMatrixLinkToken("alice.bob", "@alice:matrix.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	username, err := ParseMatrixLinkToken(token, "@alice:matrix.org")
+	if err != nil || username != "alice.bob" {
+		t.Errorf("ParseMatrixLinkToken: got %s, %v", username, err)
+	}
+
+	// Replayed by another Matrix user, or tampered
+	for _, x := range [][2]string{
+		{token, "@eve:matrix.org"},
+		{"eve" + token[len("alice.bob"):], "@alice:matrix.org"},
+		{"alice.bob", "@alice:matrix.org"},
+		{"", "@alice:matrix.org"},
+	} {
+		if _, err := ParseMatrixLinkToken(x[0], x[1]); err == nil {
+			t.Errorf("ParseMatrixLinkToken(%s, %s) should fail", x[0], x[1])
+		}
+	}
+
+	if _, err := NewMatrixLinkToken("alice", "alice"); err == nil {
+		t.Errorf("NewMatrixLinkToken should fail for an invalid matrix id")
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"crypto/hmac"
+	"encoding/base32"
+	"fmt"
+	"strings"
+
+	. "fractale/fractal6.go/tools"
+)
+
+// Reply addresses identify the user and the thread (tension or contract) an
+// email reply belongs to: reply+<t|c><id>-<username>-<mac>@domain.
+// The MAC is lower case as the local part may be case-folded by the MTAs.
+const replyPrefix = "reply+"
+
+var replyKinds = map[string]string{"tension": "t", "contract": "c"}
+
+var replyEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func signReply(payload string) string {
+	return strings.ToLower(replyEncoding.EncodeToString(tokenMac("reply", payload)[:10]))
+}
+
+// NewReplyAddress returns the reply address of the user for the given thread,
+// where kind is "tension" or "contract".
+func NewReplyAddress(username, kind, id, domain string) (string, error) {
+	if jwtSecret == "" {
+		return "", fmt.Errorf("JWT secret not found")
+	}
+	k, ok := replyKinds[kind]
+	if !ok || !IsUid(id) || username == "" {
+		return "", fmt.Errorf("invalid reply thread")
+	}
+	payload := k + id + "-" + username
+	return replyPrefix + payload + "-" + signReply(payload) + "@" + domain, nil
+}
+
+// IsReplyAddress returns true if the address looks like a reply address.
+// It must still be verified with ParseReplyAddress.
+func IsReplyAddress(address string) bool {
+	return strings.HasPrefix(strings.ToLower(address), replyPrefix)
+}
+
+// ParseReplyAddress verifies the reply address and returns its user and thread.
+func ParseReplyAddress(address string) (username, kind, id string, err error) {
+	if jwtSecret == "" {
+		return "", "", "", fmt.Errorf("JWT secret not found")
+	}
+	err = fmt.Errorf("invalid reply address")
+	local := strings.ToLower(strings.Split(address, "@")[0])
+	if !strings.HasPrefix(local, replyPrefix) {
+		return
+	}
+	local = strings.TrimPrefix(local, replyPrefix)
+	i := strings.Index(local, "-")
+	j := strings.LastIndex(local, "-")
+	if i < 1 || j <= i+1 {
+		return
+	}
+	payload, mac := local[:j], local[j+1:]
+	if !hmac.Equal([]byte(signReply(payload)), []byte(mac)) {
+		return
+	}
+	for kd, k := range replyKinds {
+		if strings.HasPrefix(local, k) {
+			kind = kd
+		}
+	}
+	id = local[1:i]
+	username = local[i+1 : j]
+	if kind == "" || !IsUid(id) {
+		return "", "", "", err
+	}
+	return username, kind, id, nil
+}
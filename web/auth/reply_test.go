@@ -0,0 +1,60 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReplyAddress(t *testing.T) {
+	jwtSecret = "secret"
+
+	address, err := NewReplyAddress("alice-bob", "tension", "0x1f", "fractale.co")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range []string{address, strings.ToUpper(address)} {
+		username, kind, id, err := ParseReplyAddress(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if username != "alice-bob" || kind != "tension" || id != "0x1f" {
+			t.Errorf("ParseReplyAddress(%s): got %s %s %s", a, username, kind, id)
+		}
+	}
+
+	// Tampered addresses
+	for _, a := range []string{
+		"notifications@fractale.co",
+		strings.Replace(address, "alice-bob", "alice", 1),
+		strings.Replace(address, "t0x1f", "t0x2f", 1),
+		strings.Replace(address, "t0x1f", "c0x1f", 1),
+	} {
+		if _, _, _, err := ParseReplyAddress(a); err == nil {
+			t.Errorf("ParseReplyAddress(%s) should fail", a)
+		}
+	}
+
+	if _, err := NewReplyAddress("alice", "node", "0x1f", "fractale.co"); err == nil {
+		t.Errorf("NewReplyAddress should fail for unknown kind")
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UnsubscribeKind is the kind of notifications an unsubscribe token stops.
+type UnsubscribeKind string
+
+const (
+	// Remove the user from Tension.subscribers (target: tension id)
+	UnsubscribeTension UnsubscribeKind = "tension"
+	// Remove the user from Node.watchers (target: node nameid)
+	UnsubscribeNode UnsubscribeKind = "node"
+	// Disable the emails for a notification reason (target: NotifPreferenceReason)
+	UnsubscribeReason UnsubscribeKind = "reason"
+	// Disable all the notification emails (User.notifyByEmail)
+	UnsubscribeEmail UnsubscribeKind = "email"
+)
+
+// UnsubscribeToken is the payload of the signed tokens embedded in the
+// notification emails. They are signed with a key derived from the JWT
+// secret and are not JWT tokens, so that they can't be used for anything
+// else than unsubscribing their user from their target.
+type UnsubscribeToken struct {
+	Username string          `json:"u"`
+	Kind     UnsubscribeKind `json:"k"`
+	Target   string          `json:"t,omitempty"`
+}
+
+// tokenMac returns the MAC of the payload with a key derived from the JWT
+// secret for the given usage, so that a token can't be used for another one.
+func tokenMac(usage, payload string) []byte {
+	key := hmac.New(sha256.New, []byte(jwtSecret))
+	key.Write([]byte(usage))
+	mac := hmac.New(sha256.New, key.Sum(nil))
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func signUnsubscribe(payload string) string {
+	return base64.RawURLEncoding.EncodeToString(tokenMac("unsubscribe", payload))
+}
+
+// NewUnsubscribeToken returns a signed token to unsubscribe the user from the given target.
+func NewUnsubscribeToken(username string, kind UnsubscribeKind, target string) (string, error) {
+	if jwtSecret == "" {
+		return "", fmt.Errorf("JWT secret not found")
+	}
+	data, err := json.Marshal(UnsubscribeToken{Username: username, Kind: kind, Target: target})
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + signUnsubscribe(payload), nil
+}
+
+// ParseUnsubscribeToken verifies the token signature and returns its payload.
+func ParseUnsubscribeToken(token string) (*UnsubscribeToken, error) {
+	if jwtSecret == "" {
+		return nil, fmt.Errorf("JWT secret not found")
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 || !hmac.Equal([]byte(signUnsubscribe(parts[0])), []byte(parts[1])) {
+		return nil, fmt.Errorf("invalid unsubscribe token")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	var t UnsubscribeToken
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	if t.Username == "" || (t.Target == "" && t.Kind != UnsubscribeEmail) {
+		return nil, fmt.Errorf("invalid unsubscribe token")
+	}
+	switch t.Kind {
+	case UnsubscribeTension, UnsubscribeNode, UnsubscribeReason, UnsubscribeEmail:
+	default:
+		return nil, fmt.Errorf("invalid unsubscribe token")
+	}
+	return &t, nil
+}
@@ -0,0 +1,60 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auth
+
+import (
+	"testing"
+)
+
+func TestUnsubscribeToken(t *testing.T) {
+	jwtSecret = "secret"
+
+	token, err := NewUnsubscribeToken("alice", UnsubscribeTension, "0x1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseUnsubscribeToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != (UnsubscribeToken{Username: "alice", Kind: UnsubscribeTension, Target: "0x1"}) {
+		t.Errorf("ParseUnsubscribeToken: got %v", got)
+	}
+
+	// Tampered tokens
+	other, _ := NewUnsubscribeToken("bob", UnsubscribeTension, "0x1")
+	for _, tk := range []string{
+		"",
+		token + "x",
+		other[:len(other)-43] + token[len(token)-43:],
+		token[:len(token)-43] + other[len(other)-43:],
+	} {
+		if _, err := ParseUnsubscribeToken(tk); err == nil {
+			t.Errorf("ParseUnsubscribeToken(%q) should fail", tk)
+		}
+	}
+
+	// Tokens are bound to the secret
+	jwtSecret = "other"
+	if _, err := ParseUnsubscribeToken(token); err == nil {
+		t.Errorf("ParseUnsubscribeToken should fail with another secret")
+	}
+}
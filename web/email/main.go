@@ -22,20 +22,20 @@ package email
 
 import (
 	"bytes"
-	"crypto/tls"
 	"fmt"
 	"github.com/microcosm-cc/bluemonday"
 	"github.com/spf13/viper"
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/renderer/html"
-	"net/http"
+	stdhtml "html"
+	htmltemplate "html/template"
 	"os"
 	"strings"
 
 	"fractale/fractal6.go/db"
 	"fractale/fractal6.go/graph/model"
-	"fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
 )
 
 var md goldmark.Markdown = goldmark.New(
@@ -63,6 +63,12 @@ func init() {
 		fmt.Println("EMAIL_API_URL/KEY not found. email notifications disabled.")
 	}
 
+	templatesPath = viper.GetString("mailer.templates_path")
+	templatesOverride = viper.GetString("mailer.templates_override")
+	if templatesPath == "" {
+		templatesPath = "templates/email"
+	}
+
 	DOMAIN = viper.GetString("server.domain")
 	maintainerEmail = viper.GetString("mailer.admin_email")
 }
@@ -73,148 +79,93 @@ func SendMaintainerEmail(subject, body string) error {
 		return nil
 	}
 
-	body = fmt.Sprintf(`{
-        "from": "%s <alert@`+DOMAIN+`>",
-        "to": ["%s"],
-        "subject": "%s",
-        "plain_body": "%s"
-    }`, "Fractal6 Alert", maintainerEmail, subject, tools.QuoteString(body))
-	// Other fields: http://apiv1.postalserver.io/controllers/send/message
-
-	req, err := http.NewRequest("POST", emailUrl, bytes.NewBuffer([]byte(body)))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Server-API-Key", emailSecret)
-
-	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	client := &http.Client{Transport: customTransport}
-	resp, err := client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return fmt.Errorf("http postal error, see body. (code %s)", resp.Status)
-	}
-
-	return nil
+	return send(message{
+		From:      "Fractal6 Alert <alert@" + DOMAIN + ">",
+		To:        []string{maintainerEmail},
+		Subject:   subject,
+		PlainBody: body,
+	})
 }
 
 // Send an verification email for signup
-func SendVerificationEmail(email, token string) error {
-	url_redirect := fmt.Sprintf("https://"+DOMAIN+"/verification?email_token=%s", token)
-
-	content := fmt.Sprintf(`<html>
-	<head>
-	<title>Activate your Fractale account</title>
-	<meta charset="utf-8">
-	</head>
-	<body>
-	<p>To activate your account at <b>`+DOMAIN+`</b>, click the link below (valid one hour):</p>
-	<a href="%s">%s</a>
-	<br><br>—<br>
-	<small>If you are not at the origin of this request, please ignore this mail.</small>
-	</body>
-    </html>`, url_redirect, url_redirect)
-
-	body := fmt.Sprintf(`{
-        "from": "Fractale <noreply@`+DOMAIN+`>",
-        "to": ["%s"],
-        "subject": "Activate your account at `+DOMAIN+`",
-        "html_body": "%s"
-    }`, email, tools.CleanString(content, true))
-
-	req, err := http.NewRequest("POST", emailUrl, bytes.NewBuffer([]byte(body)))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Server-API-Key", emailSecret)
-
-	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	client := &http.Client{Transport: customTransport}
-	resp, err := client.Do(req)
-	if err != nil {
-		return err
+func SendVerificationEmail(email, token string, lang model.Lang) error {
+	data := struct {
+		Domain string
+		Url    string
+	}{
+		Domain: DOMAIN,
+		Url:    fmt.Sprintf("https://"+DOMAIN+"/verification?email_token=%s", token),
 	}
-	defer resp.Body.Close()
 
-	return nil
+	return sendTemplate(lang, "verification", data, message{
+		From: "Fractale <noreply@" + DOMAIN + ">",
+		To:   []string{email},
+	})
 }
 
 // Send an email to reset a user password
 func SendResetEmail(email, token string) error {
-	url_redirect := fmt.Sprintf("https://"+DOMAIN+"/password-reset?x=%s", token)
-
-	content := fmt.Sprintf(`<html>
-	<head>
-	<title>Reset your Fractale Password</title>
-	<meta charset="utf-8">
-	</head>
-	<body>
-	<h2>Forgot your password?</h2>
-	<p>To reset your password at <b>`+DOMAIN+`</b>, click the link below (valid one hour):</p>
-	<a href="%s">%s</a>
-	<br><br>—<br>
-	<small>If you are not at the origin of this request, please ignore this mail.</small>
-	</body>
-    </html>`, url_redirect, url_redirect)
-
-	body := fmt.Sprintf(`{
-        "from": "Fractale <noreply@`+DOMAIN+`>",
-        "to": ["%s"],
-        "subject": "Reset your password at `+DOMAIN+`",
-        "html_body": "%s"
-    }`, email, tools.CleanString(content, true))
-
-	req, err := http.NewRequest("POST", emailUrl, bytes.NewBuffer([]byte(body)))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Server-API-Key", emailSecret)
-
-	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	client := &http.Client{Transport: customTransport}
-	resp, err := client.Do(req)
-	if err != nil {
-		return err
+	data := struct {
+		Domain string
+		Url    string
+	}{
+		Domain: DOMAIN,
+		Url:    fmt.Sprintf("https://"+DOMAIN+"/password-reset?x=%s", token),
+	}
+
+	// Recipient language
+	var lang model.Lang
+	if x, _ := db.GetDB().GetFieldByEq("User.email", email, "User.lang"); x != nil {
+		lang = model.Lang(x.(string))
 	}
-	defer resp.Body.Close()
 
-	return nil
+	return sendTemplate(lang, "reset", data, message{
+		From: "Fractale <noreply@" + DOMAIN + ">",
+		To:   []string{email},
+	})
+}
+
+// eventData is the data passed to the event email templates.
+type eventData struct {
+	Domain   string
+	Author   string
+	Receiver string
+	Title    string
+	Tid      string
+	Url      string
+	IsNew    bool
+	// Automatic message key: closed, reopened, mandate, joined, left_orga,
+	// left_role, linked, linked_you, removed, removed_you, unlinked, unlinked_you.
+	Action string
+	// The user concerned by the action
+	User        string
+	Message     string
+	MessageHtml htmltemplate.HTML
+	// Notification reason key (see NotifReason.ToKey)
+	Reason         string
+	UnsubscribeUrl string
+	LeaveUrl       string
 }
 
 func SendEventNotificationEmail(ui model.UserNotifInfo, notif model.EventNotif) error {
-	// Get inputs
-	var err error
-	var url_redirect string
-	var subject string
-	var body string
-	var author string
-	var payload string
-	var recv string = strings.Replace(notif.Receiverid, "#", "/", -1)
-	var title string = notif.Title
-	var message string = notif.Msg
 	// Recipient email
-	var email string = ui.User.Email
-	if email == "" {
-		if x, err := db.GetDB().GetFieldByEq("User.username", ui.User.Username, "User.email"); err != nil {
-			return err
-		} else {
-			email = x.(string)
-		}
-	}
-	// Author
-	if notif.Uctx.Name != nil {
-		author = fmt.Sprintf("%s (@%s)", *notif.Uctx.Name, notif.Uctx.Username)
-	} else {
-		author = "@" + notif.Uctx.Username
+	email, err := recipientEmail(ui.User)
+	if err != nil {
+		return err
 	}
 
-	var type_hint string
-	if ui.Reason == model.ReasonIsAlert {
-		type_hint = ""
+	data := eventData{
+		Domain:   DOMAIN,
+		Author:   displayName(notif.Uctx.Name, notif.Uctx.Username),
+		Receiver: strings.Replace(notif.Receiverid, "#", "/", -1),
+		Title:    notif.Title,
+		Tid:      notif.Tid,
+		IsNew:    notif.HasEvent(model.TensionEventCreated),
+		Reason:   ui.Reason.ToKey(),
 	}
 
 	// Redirect Url
-	url_redirect = fmt.Sprintf("https://"+DOMAIN+"/tension/%s/%s", notif.Rootnameid, notif.Tid)
+	data.Url = fmt.Sprintf("https://"+DOMAIN+"/tension/%s/%s", notif.Rootnameid, notif.Tid)
 	vars := []string{}
 	if ui.Eid != "" {
 		// Eid var is used to mark the event as read from the client.
@@ -224,211 +175,311 @@ func SendEventNotificationEmail(ui model.UserNotifInfo, notif model.EventNotif)
 		vars = append(vars, fmt.Sprintf("goto=%s", createdAt))
 	}
 	if len(vars) > 0 {
-		url_redirect += "?" + strings.Join(vars, "&")
+		data.Url += "?" + strings.Join(vars, "&")
 	}
 
-	// Build body
-	if notif.HasEvent(model.TensionEventCreated) { // Tension added
-		subject = fmt.Sprintf("[%s]%s %s", recv, type_hint, title)
-
-		// Add eventual comment
-		if message == "" {
-			payload = "<i>No message provided.</i><br><br>"
-		} else {
-			// Convert markdown to Html
-			var buf bytes.Buffer
-			if err = md.Convert([]byte(message), &buf); err != nil {
-				return err
-			}
-			payload = bluemonday.UGCPolicy().Sanitize(buf.String())
-		}
-
-	} else { // Tension updated
-		subject = fmt.Sprintf("Re: [%s]%s %s", recv, type_hint, title)
-		auto_msg := ""
-		comment := ""
-
+	if !data.IsNew { // Tension updated
 		// Add automatic message
 		if notif.HasEvent(model.TensionEventClosed) {
-			auto_msg = fmt.Sprintf(`Closed <a href="%s">%s</a>.<br>`, url_redirect, notif.Tid)
+			data.Action = "closed"
 		} else if notif.HasEvent(model.TensionEventReopened) {
-			auto_msg = fmt.Sprintf(`Reopened <a href="%s">%s</a>.<br>`, url_redirect, notif.Tid)
+			data.Action = "reopened"
 		} else if notif.HasEvent(model.TensionEventBlobPushed) {
-			auto_msg = fmt.Sprintf(`Mandate updated <a href="%s">%s</a>.<br>`, url_redirect, notif.Tid)
+			data.Action = "mandate"
 		} else if notif.HasEvent(model.TensionEventUserJoined) {
 			u := notif.GetNewUser()
-			itsYou := u == ui.User.Username
-			if x, _ := db.GetDB().GetFieldByEq("User.username", u, "User.name"); x != nil {
-				u = fmt.Sprintf("%s (@%s)", x.(string), u)
-			}
-			if itsYou {
+			if u == ui.User.Username {
 				// Notification happens in contract_op.VoteEventHook function since we never go here
 				// (except if the user has subscrided to the anchor tensionn which is unlikelly).
 				return nil
-			} else {
-				auto_msg = fmt.Sprintf(`%s joined this organisation in <a href="%s">%s</a>.<br>`, u, url_redirect, notif.Tid)
 			}
-
+			data.Action = "joined"
+			data.User = userName(u)
 		} else if notif.HasEvent(model.TensionEventUserLeft) {
-			u := notif.GetExUser()
-			if x, _ := db.GetDB().GetFieldByEq("User.username", u, "User.name"); x != nil {
-				u = fmt.Sprintf("%s (@%s)", x.(string), u)
-			}
-			anchorTid, _ := db.GetDB().GetSubSubFieldByEq("Node.nameid", notif.Receiverid, "Node.source", "Blob.tension", "uid")
-			if anchorTid != nil && anchorTid.(string) == notif.Tid {
-				auto_msg = fmt.Sprintf(`%s left this organisation in <a href="%s">%s</a>.<br>`, u, url_redirect, notif.Tid)
+			data.User = userName(notif.GetExUser())
+			if isAnchorTension(notif) {
+				data.Action = "left_orga"
 			} else {
-				auto_msg = fmt.Sprintf(`%s left his role in <a href="%s">%s</a>.<br>`, u, url_redirect, notif.Tid)
+				data.Action = "left_role"
 			}
 		} else if notif.HasEvent(model.TensionEventMemberLinked) {
 			u := notif.GetNewUser()
-			itsYou := u == ui.User.Username
-			if x, _ := db.GetDB().GetFieldByEq("User.username", u, "User.name"); x != nil {
-				u = fmt.Sprintf("%s (@%s)", x.(string), u)
-			}
-			if itsYou {
-				auto_msg = fmt.Sprintf(`Hi %s,<br><br>Congratulation, your application has been accepted in <a href="%s">%s</a>.<br>`, u, url_redirect, notif.Tid)
-			} else {
-				auto_msg = fmt.Sprintf(`%s is lead link in <a href="%s">%s</a>.<br>`, u, url_redirect, notif.Tid)
+			data.Action = "linked"
+			if u == ui.User.Username {
+				data.Action = "linked_you"
 			}
+			data.User = userName(u)
 		} else if notif.HasEvent(model.TensionEventMemberUnlinked) {
 			u := notif.GetExUser()
-			itsYou := u == ui.User.Username
-			if x, _ := db.GetDB().GetFieldByEq("User.username", u, "User.name"); x != nil {
-				u = fmt.Sprintf("%s (@%s)", x.(string), u)
-			}
-			anchorTid, _ := db.GetDB().GetSubSubFieldByEq("Node.nameid", notif.Receiverid, "Node.source", "Blob.tension", "uid")
-			if anchorTid != nil && anchorTid.(string) == notif.Tid {
-				if itsYou {
-					auto_msg = fmt.Sprintf(`You have been removed from this organisation in <a href="%s">%s</a>.<br>`, url_redirect, notif.Tid)
-				} else {
-					auto_msg = fmt.Sprintf(`%s has been removed from this organisation in <a href="%s">%s</a>.<br>`, u, url_redirect, notif.Tid)
-				}
+			if isAnchorTension(notif) {
+				data.Action = "removed"
 			} else {
-				if itsYou {
-					auto_msg = fmt.Sprintf(`You have has been unlinked from this role in <a href="%s">%s</a>.<br>`, url_redirect, notif.Tid)
-				} else {
-					auto_msg = fmt.Sprintf(`%s has been unlinked from this role in <a href="%s">%s</a>.<br>`, u, url_redirect, notif.Tid)
-				}
+				data.Action = "unlinked"
 			}
-		}
-
-		// Add eventual comment
-		if notif.HasEvent(model.TensionEventCommentPushed) && message != "" {
-			// Convert markdown to Html
-			var buf bytes.Buffer
-			if err = md.Convert([]byte(message), &buf); err != nil {
-				return err
+			if u == ui.User.Username {
+				data.Action += "_you"
 			}
-			comment = bluemonday.UGCPolicy().Sanitize(buf.String())
-		}
-
-		if comment != "" {
-			payload += comment
+			data.User = userName(u)
 		}
+	}
 
-		if auto_msg != "" {
-			if payload != "" {
-				payload += "—<br>"
-			}
-			payload += auto_msg + "<br>"
+	// Add eventual comment
+	if data.IsNew || notif.HasEvent(model.TensionEventCommentPushed) {
+		data.Message = notif.Msg
+		if data.MessageHtml, err = markdown(notif.Msg); err != nil {
+			return err
 		}
 	}
 
-	// Add footer
-	var url_unsubscribe string
-	var url_leave string
-	payload += fmt.Sprintf(`—
-    <div style="color:#666;font-size:small">You are receiving this because %s.<br>
-    <a href="%s">View it on Fractale</a>`, ui.Reason.ToText(), url_redirect)
+	// Footer links
 	if ui.Reason == model.ReasonIsSubscriber {
-		url_unsubscribe = fmt.Sprintf("https://"+DOMAIN+"/tension/%s/%s?unsubscribe=email", notif.Rootnameid, notif.Tid)
-		payload += fmt.Sprintf(`, reply to this email directly, or <a href="%s">unsubscribe</a>.</div>`, url_unsubscribe)
+		data.UnsubscribeUrl = unsubscribeUrl(ui.User.Username, auth.UnsubscribeTension, notif.Tid)
 	} else if ui.Reason == model.ReasonIsAnnouncement {
-		url_unsubscribe = fmt.Sprintf("https://"+DOMAIN+"/tension/%s/%s?unwatch=email", notif.Rootnameid, notif.Tid)
-		payload += fmt.Sprintf(`, or <a href="%s">unsubscribe</a> from all announcements for this organisation.</div>`, url_unsubscribe)
-	} else if ui.Reason == model.ReasonIsAlert {
-		url_leave = fmt.Sprintf("https://"+DOMAIN+"/m/%s", notif.Rootnameid)
-		payload += fmt.Sprintf(`, reply to this email directly or <a href="%s">leave this organisation</a> to stop receiving these alerts.</div>`, url_leave)
+		data.UnsubscribeUrl = unsubscribeUrl(ui.User.Username, auth.UnsubscribeNode, notif.Receiverid)
 	} else {
-		payload += " or reply to this email directly.</div>"
-	}
-
-	// Buid email
-	content := fmt.Sprintf(`<html>
-    <head> <meta charset="utf-8"> </head>
-    <body> %s </body>
-    </html>`, payload)
-
-	body = fmt.Sprintf(`{
-        "from": "%s <notifications@`+DOMAIN+`>",
-        "to": ["%s"],
-        "subject": "%s",
-        "html_body": "%s",
-        "headers": {
-            "In-Reply-To": "<tension/%s@`+DOMAIN+`>",
-            "References": "<tension/%s@`+DOMAIN+`>"
-        }
-    }`, author, email, tools.CleanString(subject, true), tools.CleanString(content, true), notif.Tid, notif.Tid)
-	// @TODO; "List-Unsubscribe": "<%s>"
-	// see https://github.com/postalserver/postal/issues/2788
-	// Other fields: http://apiv1.postalserver.io/controllers/send/message
-
-	req, err := http.NewRequest("POST", emailUrl, bytes.NewBuffer([]byte(body)))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Server-API-Key", emailSecret)
-
-	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	client := &http.Client{Transport: customTransport}
-	resp, err := client.Do(req)
+		data.UnsubscribeUrl = reasonUnsubscribeUrl(ui.User.Username, ui.Reason)
+	}
+	if ui.Reason == model.ReasonIsAlert {
+		data.LeaveUrl = fmt.Sprintf("https://"+DOMAIN+"/m/%s", notif.Rootnameid)
+	}
+
+	return sendTemplate(ui.User.Lang, "event", data, message{
+		From:    data.Author + " <notifications@" + DOMAIN + ">",
+		To:      []string{email},
+		ReplyTo: replyAddress(ui.User.Username, "tension", notif.Tid),
+		Headers: map[string]string{
+			"In-Reply-To": "<tension/" + notif.Tid + "@" + DOMAIN + ">",
+			"References":  "<tension/" + notif.Tid + "@" + DOMAIN + ">",
+		},
+		UnsubscribeUrl: data.UnsubscribeUrl,
+	})
+}
+
+// SendBulkEventNotificationEmail sends one email listing the tensions
+// updated by a bulk operation.
+func SendBulkEventNotificationEmail(ui model.UserNotifInfo, uctx *model.UserCtx, notifs []model.EventNotif) error {
+	if len(notifs) == 0 {
+		return nil
+	}
+	// Single notification
+	if len(notifs) == 1 {
+		return SendEventNotificationEmail(ui, notifs[0])
+	}
+
+	// Recipient email
+	email, err := recipientEmail(ui.User)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return fmt.Errorf("http postal error, see body. (code %s)", resp.Status)
+
+	type bulkTension struct {
+		Url    string
+		Title  string
+		Action string // closed, reopened or empty
+	}
+	data := struct {
+		Domain         string
+		Author         string
+		Receiver       string
+		Count          int
+		Tensions       []bulkTension
+		Reason         string
+		UnsubscribeUrl string
+	}{
+		Domain:   DOMAIN,
+		Author:   displayName(uctx.Name, uctx.Username),
+		Receiver: strings.Replace(notifs[0].Receiverid, "#", "/", -1),
+		Count:    len(notifs),
+		Reason:   ui.Reason.ToKey(),
+	}
+	if ui.Reason == model.ReasonIsAnnouncement {
+		data.UnsubscribeUrl = unsubscribeUrl(ui.User.Username, auth.UnsubscribeNode, notifs[0].Receiverid)
+	} else {
+		data.UnsubscribeUrl = reasonUnsubscribeUrl(ui.User.Username, ui.Reason)
+	}
+	for _, notif := range notifs {
+		t := bulkTension{
+			Url:   fmt.Sprintf("https://"+DOMAIN+"/tension/%s/%s", notif.Rootnameid, notif.Tid),
+			Title: notif.Title,
+		}
+		if notif.HasEvent(model.TensionEventClosed) {
+			t.Action = "closed"
+		} else if notif.HasEvent(model.TensionEventReopened) {
+			t.Action = "reopened"
+		}
+		data.Tensions = append(data.Tensions, t)
 	}
 
-	return nil
+	return sendTemplate(ui.User.Lang, "bulk_event", data, message{
+		From:           data.Author + " <notifications@" + DOMAIN + ">",
+		To:             []string{email},
+		UnsubscribeUrl: data.UnsubscribeUrl,
+	})
 }
 
-func SendContractNotificationEmail(ui model.UserNotifInfo, notif model.ContractNotif) error {
-	// Get inputs
-	var err error
-	var url_redirect string
-	var subject string
-	var body string
-	var rcpt_name string
-	var author string
-	var payload string
-	var recv string = strings.Replace(notif.Receiverid, "#", "/", -1)
+// SendDigestEmail sends one email grouping the given event notifications
+// by organisation and tension (see User.emailDelivery).
+func SendDigestEmail(user model.User, delivery model.EmailDelivery, notifs []model.DigestNotif) error {
+	if len(notifs) == 0 {
+		return nil
+	}
+
 	// Recipient email
-	var email string = ui.User.Email
-	if email == "" {
-		if x, err := db.GetDB().GetFieldByEq("User.username", ui.User.Username, "User.email"); err != nil {
-			return err
-		} else {
-			email = x.(string)
+	email, err := recipientEmail(user)
+	if err != nil {
+		return err
+	}
+
+	type digestEvent struct {
+		Author  string
+		Url     string
+		Action  string // see digestAction
+		Comment string
+	}
+	type digestTension struct {
+		Receiver string
+		Title    string
+		Events   []digestEvent
+	}
+	type digestOrga struct {
+		Rootnameid string
+		Url        string
+		Tensions   []*digestTension
+	}
+	data := struct {
+		Domain         string
+		Period         string // hourly, daily or weekly
+		Count          int
+		TensionCount   int
+		Orgas          []*digestOrga
+		SettingsUrl    string
+		UnsubscribeUrl string
+	}{
+		Domain:         DOMAIN,
+		Period:         strings.ToLower(string(delivery)),
+		Count:          len(notifs),
+		SettingsUrl:    fmt.Sprintf("https://"+DOMAIN+"/user/%s/settings?m=email", user.Username),
+		UnsubscribeUrl: unsubscribeUrl(user.Username, auth.UnsubscribeEmail, ""),
+	}
+
+	// Group by organisation and tension, in order of arrival.
+	orgas := make(map[string]*digestOrga)
+	tensions := make(map[string]*digestTension)
+	for _, n := range notifs {
+		o, ex := orgas[n.Notif.Rootnameid]
+		if !ex {
+			o = &digestOrga{
+				Rootnameid: n.Notif.Rootnameid,
+				Url:        fmt.Sprintf("https://"+DOMAIN+"/o/%s", n.Notif.Rootnameid),
+			}
+			orgas[n.Notif.Rootnameid] = o
+			data.Orgas = append(data.Orgas, o)
+		}
+		t, ex := tensions[n.Notif.Tid]
+		if !ex {
+			t = &digestTension{
+				Receiver: strings.Replace(n.Notif.Receiverid, "#", "/", -1),
+				Title:    n.Notif.Title,
+			}
+			tensions[n.Notif.Tid] = t
+			o.Tensions = append(o.Tensions, t)
+		}
+
+		// Eid var is used to mark the event as read from the client.
+		e := digestEvent{
+			Author: displayName(n.Notif.Uctx.Name, n.Notif.Uctx.Username),
+			Url:    fmt.Sprintf("https://"+DOMAIN+"/tension/%s/%s?eid=%s", n.Notif.Rootnameid, n.Notif.Tid, n.Eid),
+			Action: digestAction(n.Notif),
+		}
+		if createdAt := n.Notif.GetCreatedAt(); createdAt != "" {
+			e.Url += fmt.Sprintf("&goto=%s", createdAt)
 		}
+		if msg := []rune(bluemonday.StrictPolicy().Sanitize(n.Notif.Msg)); n.Notif.HasEvent(model.TensionEventCommentPushed) && len(msg) > 0 {
+			if len(msg) > 200 {
+				msg = append(msg[:200], []rune("…")...)
+			}
+			e.Comment = stdhtml.UnescapeString(string(msg))
+		}
+		t.Events = append(t.Events, e)
 	}
-	// Recipient name
-	if ui.User.Name != nil {
-		rcpt_name = fmt.Sprintf(" %s (@%s)", *ui.User.Name, ui.User.Username)
-	} else if ui.User.Username != "" {
-		rcpt_name = " @" + ui.User.Username
-	} else {
-		rcpt_name = ""
+	data.TensionCount = len(tensions)
+
+	return sendTemplate(user.Lang, "digest", data, message{
+		From:           "Fractale <notifications@" + DOMAIN + ">",
+		To:             []string{email},
+		UnsubscribeUrl: data.UnsubscribeUrl,
+	})
+}
+
+// digestAction returns the key of the action described by the event notification.
+func digestAction(notif model.EventNotif) string {
+	switch {
+	case notif.HasEvent(model.TensionEventCreated):
+		return "created"
+	case notif.HasEvent(model.TensionEventClosed):
+		return "closed"
+	case notif.HasEvent(model.TensionEventReopened):
+		return "reopened"
+	case notif.HasEvent(model.TensionEventBlobPushed):
+		return "mandate"
+	case notif.HasEvent(model.TensionEventUserJoined):
+		return "joined"
+	case notif.HasEvent(model.TensionEventUserLeft):
+		return "left"
+	case notif.HasEvent(model.TensionEventMemberLinked), notif.HasEvent(model.TensionEventMemberUnlinked):
+		return "role"
+	case notif.HasEvent(model.TensionEventCommentPushed):
+		return "commented"
+	default:
+		return "updated"
 	}
-	// Author
-	if notif.Uctx.Name != nil {
-		author = fmt.Sprintf("%s (@%s)", *notif.Uctx.Name, notif.Uctx.Username)
-	} else {
-		author = "@" + notif.Uctx.Username
+}
+
+// contractData is the data passed to the contract email templates.
+type contractData struct {
+	Domain    string
+	Recipient string
+	Author    string
+	Receiver  string
+	OrgaName  string
+	OrgaUrl   string
+	Tid       string
+	Url       string
+	// The contract event type (e.g. UserJoined)
+	Event string
+	// Email kind: invited, link_candidate, vote, canceled, invitation_accepted,
+	// accepted or comment.
+	Kind           string
+	Message        string
+	MessageHtml    htmltemplate.HTML
+	Reason         string
+	IsPending      bool
+	UnsubscribeUrl string
+}
+
+func SendContractNotificationEmail(ui model.UserNotifInfo, notif model.ContractNotif) error {
+	// Recipient email
+	email, err := recipientEmail(ui.User)
+	if err != nil {
+		return err
+	}
+
+	data := contractData{
+		Domain:         DOMAIN,
+		Author:         displayName(notif.Uctx.Name, notif.Uctx.Username),
+		Receiver:       strings.Replace(notif.Receiverid, "#", "/", -1),
+		Tid:            notif.Tid,
+		Event:          string(notif.Contract.Event.EventType),
+		Reason:         ui.Reason.ToKey(),
+		IsPending:      ui.IsPending,
+		UnsubscribeUrl: reasonUnsubscribeUrl(ui.User.Username, ui.Reason),
+	}
+	// Recipient name
+	if ui.User.Username != "" {
+		data.Recipient = displayName(ui.User.Name, ui.User.Username)
 	}
 
-	url_unsubscribe := fmt.Sprintf("https://"+DOMAIN+"/user/%s/settings?m=email", ui.User.Username)
-	url_redirect = fmt.Sprintf("https://"+DOMAIN+"/tension/%s/%s/contract/%s", notif.Rootnameid, notif.Tid, notif.Contract.ID)
+	data.Url = fmt.Sprintf("https://"+DOMAIN+"/tension/%s/%s/contract/%s", notif.Rootnameid, notif.Tid, notif.Contract.ID)
 	vars := []string{}
 	if ui.IsPending {
 		// Puid var is used to identify the pending users from client.
@@ -439,40 +490,31 @@ func SendContractNotificationEmail(ui model.UserNotifInfo, notif model.ContractN
 		vars = append(vars, fmt.Sprintf("puid=%s", token))
 	}
 	if len(vars) > 0 {
-		url_redirect += "?" + strings.Join(vars, "&")
+		data.Url += "?" + strings.Join(vars, "&")
 	}
 
 	// Build body
-	e := notif.Contract.Event.EventType
 	switch notif.ContractEvent {
 	case model.NewContract:
 		switch notif.Contract.Status {
 		case model.ContractStatusOpen:
 			if ui.Reason == model.ReasonIsInvited {
-				orga_name := recv
+				data.Kind = "invited"
+				data.OrgaUrl = fmt.Sprintf("https://"+DOMAIN+"/o/%s", data.Receiver)
 				if x, err := db.GetDB().GetFieldByEq("Node.nameid", notif.Receiverid, "Node.name"); err != nil {
 					return err
 				} else {
-					orga_name = x.(string)
+					data.OrgaName = x.(string)
 				}
-				subject = fmt.Sprintf("[%s] You are invited to this organisation", recv)
-				payload = fmt.Sprintf(`Hi%s,<br><br> You are kindly invited by %s to join the organisation <a style="color:#002e62;font-weight: 600;" href="https://`+DOMAIN+`/o/%s">%s</a>.<br><br>
-                You can see this invitation and accept or reject it by clicking on the following link:<br><a href="%s">%s</a>`, rcpt_name, author, recv, orga_name, url_redirect, url_redirect)
 			} else if ui.Reason == model.ReasonIsLinkCandidate {
-				subject = fmt.Sprintf("[%s] You have a new role invitation", recv)
-				payload = fmt.Sprintf(`Hi%s,<br><br> You are kindly invited to take a new role by %s.<br><br>
-                You can see this invitation and accept or reject it by clicking on the following link:<br><a href="%s">%s</a>`, rcpt_name, author, url_redirect, url_redirect)
+				data.Kind = "link_candidate"
 			} else {
-				subject = fmt.Sprintf("[%s][%s] A pending contract needs your attention", recv, e.ToContractText())
-				payload = fmt.Sprintf(`Hi%s,<br><br>
-                A vote is needed to process the following contract:<br><a href="%s">%s</a>`, rcpt_name, url_redirect, url_redirect)
+				data.Kind = "vote"
 			}
 		case model.ContractStatusCanceled:
 			// notify only participant
 			if ui.Reason == model.ReasonIsParticipant {
-				subject = fmt.Sprintf("[%s][%s] Contract canceled", recv, e.ToContractText())
-				payload = fmt.Sprintf(`Hi%s,<br><br>
-                The following contract has been canceled:<br><a href="%s">%s</a>`, rcpt_name, url_redirect, url_redirect)
+				data.Kind = "canceled"
 			} else {
 				return nil
 			}
@@ -480,80 +522,123 @@ func SendContractNotificationEmail(ui model.UserNotifInfo, notif model.ContractN
 			// no notification
 			return nil
 		}
-		if notif.Msg != "" {
-			payload += "<br><br>—<br>"
-		}
 	case model.CloseContract:
 		// -- notify only the if event has no email notification
 		// -- Or invited user exception (because can only be notified if subscribed to the anchor tension...)
 		if ui.Reason == model.ReasonIsInvited {
-			subject = fmt.Sprintf("[%s] Invitation accepted", recv)
-			payload = fmt.Sprintf(`Hi%s,<br><br>Congratulation, your invitation has been accepted in <a href="%s">%s</a>.<br>`, rcpt_name, url_redirect, notif.Tid)
+			data.Kind = "invitation_accepted"
 		} else if !notif.IsEventEmailable(ui) {
-			subject = fmt.Sprintf("[%s][%s] Contract accepted", recv, e.ToContractText())
-			payload = fmt.Sprintf(`Hi%s,<br><br>
-            The following contract has been accepted:<br><a href="%s">%s</a>`, rcpt_name, url_redirect, url_redirect)
+			data.Kind = "accepted"
 		} else {
 			return nil
 		}
 		// dont repeat a already read message
 		notif.Msg = ""
 	case model.NewComment:
-		subject = fmt.Sprintf("[%s][%s] You have a new comment", recv, e.ToContractText())
+		data.Kind = "comment"
 	}
 
 	// Add eventual comment
-	if notif.Msg != "" {
-		// Convert markdown to Html
-		var buf bytes.Buffer
-		if err = md.Convert([]byte(notif.Msg), &buf); err != nil {
-			return err
-		}
-		payload += bluemonday.UGCPolicy().Sanitize(buf.String())
-	} else {
-		payload += "<br><br>"
-	}
-
-	payload += fmt.Sprintf(`—
-    <div style="color:#666;font-size:small">You are receiving this because %s.`, ui.Reason.ToText())
-	if !ui.IsPending {
-		payload += fmt.Sprintf(`<br>
-        <a href="%s">View it on Fractale</a>, reply to this email directly or <a href="%s">disable</a> email notifications.
-        </div>`, url_redirect, url_unsubscribe)
-	}
-
-	// Buid email
-	content := fmt.Sprintf(`<html>
-    <head> <meta charset="utf-8"> </head>
-    <body> %s </body>
-    </html>`, payload)
-
-	body = fmt.Sprintf(`{
-        "from": "%s <notifications@`+DOMAIN+`>",
-        "to": ["%s"],
-        "subject": "%s",
-        "html_body": "%s",
-        "headers": {
-            "In-Reply-To": "<contract/%s@`+DOMAIN+`>",
-            "References": "<contract/%s@`+DOMAIN+`>"
-        }
-    }`, author, email, subject, tools.CleanString(content, true), notif.Contract.ID, notif.Contract.ID)
-
-	req, err := http.NewRequest("POST", emailUrl, bytes.NewBuffer([]byte(body)))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Server-API-Key", emailSecret)
-
-	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	client := &http.Client{Transport: customTransport}
-	resp, err := client.Do(req)
-	if err != nil {
+	data.Message = notif.Msg
+	if data.MessageHtml, err = markdown(notif.Msg); err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return fmt.Errorf("http postal error, see body. (code %s)", resp.Status)
+
+	return sendTemplate(ui.User.Lang, "contract", data, message{
+		From:    data.Author + " <notifications@" + DOMAIN + ">",
+		To:      []string{email},
+		ReplyTo: replyAddress(ui.User.Username, "contract", notif.Contract.ID),
+		Headers: map[string]string{
+			"In-Reply-To": "<contract/" + notif.Contract.ID + "@" + DOMAIN + ">",
+			"References":  "<contract/" + notif.Contract.ID + "@" + DOMAIN + ">",
+		},
+		UnsubscribeUrl: data.UnsubscribeUrl,
+	})
+}
+
+//
+// Helpers
+//
+
+// unsubscribeUrl returns the one-click unsubscribe url of the given target,
+// or an empty string if the unsubscribe tokens are disabled.
+func unsubscribeUrl(username string, kind auth.UnsubscribeKind, target string) string {
+	if username == "" {
+		// Pending users
+		return ""
+	}
+	token, err := auth.NewUnsubscribeToken(username, kind, target)
+	if err != nil {
+		return ""
 	}
+	return "https://" + DOMAIN + "/notifications/unsubscribe?token=" + token
+}
 
-	return nil
+// reasonUnsubscribeUrl returns the url to stop the emails sent for the given reason.
+func reasonUnsubscribeUrl(username string, reason model.NotifReason) string {
+	if r := reason.ToPreference(); r != nil {
+		return unsubscribeUrl(username, auth.UnsubscribeReason, string(*r))
+	}
+	return unsubscribeUrl(username, auth.UnsubscribeEmail, "")
+}
+
+// replyAddress returns the signed address to reply to the given thread,
+// or an empty string if the user can't reply by email.
+func replyAddress(username, kind, id string) string {
+	if username == "" {
+		// Pending users
+		return ""
+	}
+	address, err := auth.NewReplyAddress(username, kind, id, DOMAIN)
+	if err != nil {
+		return ""
+	}
+	return address
+}
+
+// recipientEmail returns the user email, fetching it if not present.
+func recipientEmail(user model.User) (string, error) {
+	if user.Email != "" {
+		return user.Email, nil
+	}
+	x, err := db.GetDB().GetFieldByEq("User.username", user.Username, "User.email")
+	if err != nil {
+		return "", err
+	}
+	return x.(string), nil
+}
+
+// displayName returns the name to display for the given user.
+func displayName(name *string, username string) string {
+	if name != nil {
+		return fmt.Sprintf("%s (@%s)", *name, username)
+	}
+	return "@" + username
+}
+
+// userName returns the display name of the given username.
+func userName(username string) string {
+	if x, _ := db.GetDB().GetFieldByEq("User.username", username, "User.name"); x != nil {
+		name := x.(string)
+		return displayName(&name, username)
+	}
+	return displayName(nil, username)
+}
+
+// isAnchorTension returns true if the event tension is the anchor tension of its receiver.
+func isAnchorTension(notif model.EventNotif) bool {
+	anchorTid, _ := db.GetDB().GetSubSubFieldByEq("Node.nameid", notif.Receiverid, "Node.source", "Blob.tension", "uid")
+	return anchorTid != nil && anchorTid.(string) == notif.Tid
+}
+
+// markdown converts the given markdown message to sanitized html.
+func markdown(message string) (htmltemplate.HTML, error) {
+	if message == "" {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(message), &buf); err != nil {
+		return "", err
+	}
+	return htmltemplate.HTML(bluemonday.UGCPolicy().Sanitize(buf.String())), nil
 }
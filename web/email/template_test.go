@@ -0,0 +1,65 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package email
+
+import (
+	"strings"
+	"testing"
+
+	"fractale/fractal6.go/graph/model"
+)
+
+func TestRender(t *testing.T) {
+	templatesPath = "../../templates/email"
+	msg, _ := markdown("a **comment** <script>alert(1)</script>")
+
+	testcases := []struct {
+		name string
+		data interface{}
+	}{
+		{"verification", struct{ Domain, Url string }{"fractale.co", "https://fractale.co/verification?email_token=x"}},
+		{"reset", struct{ Domain, Url string }{"fractale.co", "https://fractale.co/password-reset?x=x"}},
+		{"event", eventData{Receiver: "orga/circle", Title: "A <title>", Tid: "0x1", IsNew: true, Reason: "subscriber"}},
+		{"event", eventData{Receiver: "orga/circle", Title: "A title", Tid: "0x1", Action: "linked_you", User: "@alice", Message: "a comment", MessageHtml: msg, Reason: "alert"}},
+		{"contract", contractData{Receiver: "orga", Event: "UserJoined", Kind: "invited", OrgaName: "Orga", Reason: "invited", IsPending: true}},
+		{"contract", contractData{Receiver: "orga", Event: "MemberLinked", Kind: "comment", Message: "a comment", MessageHtml: msg, Reason: "candidate"}},
+	}
+
+	for _, lang := range model.AllLang {
+		for _, tc := range testcases {
+			subject, html, text, err := render(lang, tc.name, tc.data)
+			if err != nil {
+				t.Fatalf("%s/%s: %v", lang, tc.name, err)
+			}
+			if subject == "" || html == "" || text == "" {
+				t.Errorf("%s/%s: empty rendering", lang, tc.name)
+			}
+			if strings.Contains(html, "<script>") || strings.Contains(html, "<title>") {
+				t.Errorf("%s/%s: html not escaped: %s", lang, tc.name, html)
+			}
+		}
+	}
+
+	// Unknown language fallback to english
+	if subject, _, _, err := render("", "reset", struct{ Domain, Url string }{"fractale.co", ""}); err != nil || subject != "Reset your password at fractale.co" {
+		t.Errorf("english fallback failed: %q, %v", subject, err)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+	"fractale/fractal6.go/web/storage"
+)
+
+// Attachment serves the file of an attachment, if the user can read its tension.
+func Attachment(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if !tools.IsUid(id) {
+		http.Error(w, "invalid attachment id", 400)
+		return
+	}
+	s := storage.GetStorage()
+	if s == nil {
+		http.Error(w, "attachments are disabled", 404)
+		return
+	}
+
+	// Check the visibility according to the @auth directives
+	uctx := auth.GetUserContextOrEmpty(r.Context())
+	res, err := db.GetDB().Query(uctx, "attachment", "id", []string{id}, "id")
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	if len(res) == 0 {
+		http.Error(w, "attachment not found", 404)
+		return
+	}
+
+	a, err := db.GetDB().GetAttachment(id)
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	} else if a == nil {
+		http.Error(w, "attachment not found", 404)
+		return
+	}
+	f, err := s.Get(a.Key)
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	defer f.Close()
+
+	// Only display inline the types that can't run scripts in the browser.
+	disposition := "attachment"
+	if a.ContentType == "application/pdf" || strings.HasPrefix(a.ContentType, "image/") && a.ContentType != "image/svg+xml" {
+		disposition = "inline"
+	}
+	w.Header().Set("Content-Type", a.ContentType)
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", a.Size))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": a.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	io.Copy(w, f)
+}
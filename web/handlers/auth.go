@@ -111,7 +111,11 @@ func Signup(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Send verification email
-	err = email.SendVerificationEmail(creds.Email, email_token)
+	lang := model.LangEn
+	if creds.Lang != nil {
+		lang = model.Lang(*creds.Lang)
+	}
+	err = email.SendVerificationEmail(creds.Email, email_token, lang)
 	if err != nil {
 		panic(err)
 	}
@@ -654,3 +658,39 @@ func UpdatePassword(w http.ResponseWriter, r *http.Request) {
 
 	w.Write(data_out)
 }
+
+// MatrixLink returns the command the user has to post in a bridged Matrix room
+// to link the given Matrix id to its account (see auth.NewMatrixLinkToken).
+// An empty Matrix id unlinks the account.
+func MatrixLink(w http.ResponseWriter, r *http.Request) {
+	var data struct {
+		Matrixid string
+	}
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	_, uctx, err := auth.GetUserContext(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), 401)
+		return
+	}
+
+	// Unlink
+	if data.Matrixid == "" {
+		if err = db.GetDB().SetUserMatrixid(uctx.Username, ""); err != nil {
+			http.Error(w, err.Error(), 500)
+			return
+		}
+		w.Write([]byte(""))
+		return
+	}
+
+	token, err := auth.NewMatrixLinkToken(uctx.Username, data.Matrixid)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	w.Write([]byte("!link " + token))
+}
@@ -29,7 +29,9 @@ import (
 
 	"fractale/fractal6.go/graph"
 	gen "fractale/fractal6.go/graph/generated"
+	"fractale/fractal6.go/web/metrics"
 	"fractale/fractal6.go/web/middleware"
+	"fractale/fractal6.go/web/storage"
 )
 
 // Defining the Graphql handler
@@ -47,7 +49,11 @@ func GraphqlHandler(c map[string]interface{}) http.HandlerFunc {
 	//h.AddTransport(transport.Websocket{
 	//    KeepAlivePingInterval: 10 * time.Second,
 	//})
-	//h.AddTransport(transport.MultipartForm{})
+	// File uploads (attachments)
+	h.AddTransport(transport.MultipartForm{
+		MaxUploadSize: storage.MaxSize() + 1<<20, // file + operations
+		MaxMemory:     32 << 20,
+	})
 
 	// Limit query complexity
 	h.Use(extension.FixedComplexityLimit(complextityLimit))
@@ -57,6 +63,9 @@ func GraphqlHandler(c map[string]interface{}) http.HandlerFunc {
 		h.Use(extension.Introspection{})
 	}
 
+	// Record operations latency and errors
+	h.Use(metrics.GqlTracer{})
+
 	// Set the default behavior to handle non implemented query
 	h.SetRecoverFunc(middleware.GqlRecover)
 
@@ -0,0 +1,100 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/web/auth"
+	"fractale/fractal6.go/web/sessions"
+)
+
+// HealthCheck returns a non nil error if a dependency is not available.
+type HealthCheck func(ctx context.Context) error
+
+// Timeout for a single readiness check.
+var healthCheckTimeout = 3 * time.Second
+
+// ApiChecks are the readiness checks of the API server.
+var ApiChecks = map[string]HealthCheck{
+	"dgraph_grpc":    db.GetDB().PingDql,
+	"dgraph_graphql": db.GetDB().PingGql,
+	"redis":          sessions.Ping,
+	"dgraph_keys":    func(ctx context.Context) error { return db.CheckKeys() },
+	"jwt_secret":     func(ctx context.Context) error { return auth.CheckSecret() },
+}
+
+// Healthz is the liveness handler: the process is up and serving.
+func Healthz(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("OK"))
+}
+
+// ReadyzHandler returns the readiness handler for the given checks.
+// It responds 200 if all checks pass, 503 otherwise, with the status
+// of each check in the JSON body.
+func ReadyzHandler(checks map[string]HealthCheck) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status, ok := RunChecks(r.Context(), checks)
+		w.Header().Set("Content-Type", "application/json")
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(status)
+	}
+}
+
+// RunChecks runs the given checks concurrently and returns the status
+// of each of them ("ok" or "fail"). The errors are only logged, as they
+// may reveal internal hosts.
+func RunChecks(ctx context.Context, checks map[string]HealthCheck) (map[string]string, bool) {
+	type result struct {
+		name string
+		err  error
+	}
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	results := make(chan result, len(checks))
+	for name, check := range checks {
+		go func(name string, check HealthCheck) {
+			results <- result{name, check(ctx)}
+		}(name, check)
+	}
+
+	ok := true
+	status := make(map[string]string, len(checks))
+	for range checks {
+		res := <-results
+		if res.err != nil {
+			ok = false
+			status[res.name] = "fail"
+			log.Printf("readiness check %s failed: %v", res.name, res.err)
+		} else {
+			status[res.name] = "ok"
+		}
+	}
+	return status, ok
+}
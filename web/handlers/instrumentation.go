@@ -26,6 +26,7 @@ import (
 	"net/http"
 
 	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/web/metrics"
 )
 
 var (
@@ -70,7 +71,10 @@ var (
 	})
 )
 
-func InstruHandler() http.Handler {
+// InstruHandler returns the Prometheus handler that exposes the business gauges
+// and the runtime service metrics (HTTP, GraphQL, Dgraph and notifier).
+// If runtime is true, the Go and process collectors are exposed too.
+func InstruHandler(runtime bool) http.Handler {
 	// Create Handler from scratch
 	r := prometheus.NewRegistry()
 
@@ -84,11 +88,8 @@ func InstruHandler() http.Handler {
 	r.MustRegister(archiveCount)
 	r.MustRegister(labelCount)
 
-	// More metrics
-	//MustRegister(
-	//    promcollectors.NewProcessCollector(promcollectors.ProcessCollectorOpts{}),
-	//    promcollectors.NewGoCollector(),
-	//)
+	// Service metrics
+	metrics.Register(r, runtime)
 
 	return promhttp.HandlerFor(r, promhttp.HandlerOpts{})
 }
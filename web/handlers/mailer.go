@@ -21,10 +21,13 @@
 package handlers
 
 import (
-	//"fmt"
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"github.com/spf13/viper"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/mail"
 	"strings"
@@ -34,6 +37,7 @@ import (
 	"fractale/fractal6.go/graph/codec"
 	"fractale/fractal6.go/graph/model"
 	"fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
 )
 
 /*
@@ -58,12 +62,55 @@ type EmailForm struct {
 	Title      string `json:"subject"`
 	Msg        string `json:"plain_body"`
 	References string `json:"references"`
-	//AttachmentQuantity int  `json:"attachment_quantity"`
-	//Attachments []string    `json:"attachments"`
+	// Requires the "include attachments" option of the Postal route.
+	AttachmentQuantity int               `json:"attachment_quantity"`
+	Attachments        []EmailAttachment `json:"attachments"`
+}
+
+type EmailAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Size        int    `json:"size"`
+	Data        string `json:"data"` // base64 encoded
+}
+
+// storeAttachments stores the email attachments in the given organisation.
+// The files rejected (size, type...) are skipped.
+func storeAttachments(uctx *model.UserCtx, receiverid string, attachments []EmailAttachment) []*model.AttachmentRef {
+	var refs []*model.AttachmentRef
+	rootnameid, err := codec.Nid2rootid(receiverid)
+	if err != nil {
+		return refs
+	}
+	for _, a := range attachments {
+		data, err := base64.StdEncoding.DecodeString(a.Data)
+		if err != nil {
+			log.Printf("Email attachment %s: %v", a.Filename, err)
+			continue
+		}
+		ref, err := graph.StoreAttachment(uctx, rootnameid, a.Filename, bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			log.Printf("Email attachment %s: %v", a.Filename, err)
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	return refs
 }
 
 // Handle user email responses. Receiving email response from email notifications.
 func Notifications(w http.ResponseWriter, r *http.Request) {
+	handlePostalEmail(w, r, ReceiveReply)
+}
+
+// Handle email sent to orga. Convert email to tension.
+func Mailing(w http.ResponseWriter, r *http.Request) {
+	handlePostalEmail(w, r, ReceiveMailing)
+}
+
+// handlePostalEmail validates and decodes an inbound email sent by the Postal
+// webhook and passes it to the given receiver.
+func handlePostalEmail(w http.ResponseWriter, r *http.Request, receive func(EmailForm) (int, error)) {
 	// Validate WebHook identity
 	if err := tools.ValidatePostalSignature(r, postalWebhookPK); err != nil {
 		http.Error(w, err.Error(), 400)
@@ -78,27 +125,54 @@ func Notifications(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Determine where from and to where it goes
+	if code, err := receive(form); err != nil {
+		http.Error(w, err.Error(), code)
+		return
+	}
+}
+
+// ReceiveEmail routes an inbound email according to its recipient: replies
+// to the signed reply addresses go to ReceiveReply, other emails to ReceiveMailing.
+// It returns the HTTP status code of the error, if any.
+func ReceiveEmail(form EmailForm) (int, error) {
+	if toEmail, err := mail.ParseAddress(form.To); err == nil && auth.IsReplyAddress(toEmail.Address) {
+		return ReceiveReply(form)
+	}
+	return ReceiveMailing(form)
+}
+
+// ReceiveReply adds the email reply of an user as a comment of the tension
+// or the contract it replies to.
+func ReceiveReply(form EmailForm) (int, error) {
+	// Determine where from and to where it goes, from the signed reply
+	// address of the recipient (see auth.NewReplyAddress).
 	var isTid string
 	var isCid string
-	for _, r := range strings.Split(form.References, " ") {
-		l := strings.TrimPrefix(r, "<")
-		if strings.HasPrefix(l, "tension/") {
-			isTid = l[8:strings.Index(l, "@")]
-			break
-		}
-		if strings.HasPrefix(l, "contract/") {
-			isCid = l[9:strings.Index(l, "@")]
-			break
-		}
-
+	toEmail, err := mail.ParseAddress(form.To)
+	if err != nil {
+		return 400, fmt.Errorf("RECIPIENT EMAIL NOT FOUND")
+	}
+	username, kind, id, err := auth.ParseReplyAddress(toEmail.Address)
+	if err != nil {
+		return 400, err
+	}
+	switch kind {
+	case "tension":
+		isTid = id
+	case "contract":
+		isCid = id
 	}
 
 	// Get author
-	uctx, err := db.GetDB().GetUctx("email", form.From)
+	uctx, err := db.GetDB().GetUctx("username", username)
 	if err != nil {
-		http.Error(w, err.Error(), 400)
-		return
+		return 400, err
+	}
+
+	// Remove the quoted message and the signature
+	msg := tools.StripEmailReply(form.Msg)
+	if msg == "" && len(form.Attachments) == 0 {
+		return 400, fmt.Errorf("empty reply")
 	}
 	createdAt := tools.Now()
 	createdBy := model.UserRef{Username: &uctx.Username}
@@ -114,24 +188,34 @@ func Notifications(w http.ResponseWriter, r *http.Request) {
 		// Check event
 		ok, _, err := graph.TensionEventHook(uctx, isTid, history, nil)
 		if err != nil {
-			http.Error(w, err.Error(), 500)
-			return
+			return 500, err
 		}
 		if !ok {
-			http.Error(w, "access denied", 400)
-			return
+			return 400, fmt.Errorf("access denied")
+		}
+		// Store the attachments
+		var attachments []*model.AttachmentRef
+		if receiverid, err := db.GetDB().GetFieldById(isTid, "Tension.receiverid"); err == nil && receiverid != nil {
+			attachments = storeAttachments(uctx, receiverid.(string), form.Attachments)
+			for _, a := range attachments {
+				a.Tension = &model.TensionRef{ID: &isTid}
+			}
 		}
 		// Publish  event
-		db.GetDB().Update(db.DB.GetRootUctx(), "tension", model.UpdateTensionInput{
+		err = db.GetDB().Update(db.DB.GetRootUctx(), "tension", model.UpdateTensionInput{
 			Filter: &model.TensionFilter{ID: []string{isTid}},
 			Set: &model.TensionPatch{
 				Comments: []*model.CommentRef{&model.CommentRef{
-					CreatedAt: &createdAt,
-					CreatedBy: &createdBy,
-					Message:   &form.Msg,
+					CreatedAt:   &createdAt,
+					CreatedBy:   &createdBy,
+					Message:     &msg,
+					Attachments: attachments,
 				}},
 			},
 		})
+		if err != nil {
+			return 500, err
+		}
 
 		// Publish Notification
 		// --
@@ -142,21 +226,18 @@ func Notifications(w http.ResponseWriter, r *http.Request) {
 		}
 		// Push notification
 		if err := graph.PushEventNotifications(notif); err != nil {
-			http.Error(w, "PushEventNotifications error: "+err.Error(), 500)
-			return
+			return 500, fmt.Errorf("PushEventNotifications error: %v", err)
 		}
 	} else if isCid != "" { // If contract reply/comment
 		// Build Event
 		contract, err := db.GetDB().GetContractHook(isCid)
 		if err != nil {
-			http.Error(w, err.Error(), 500)
-			return
+			return 500, err
 		}
 		// Check  event
 		ok, err := graph.HasContractRight(uctx, contract)
 		if err != nil {
-			http.Error(w, err.Error(), 400)
-			return
+			return 400, err
 		}
 		if !ok {
 			// Check if user is candidate
@@ -167,21 +248,44 @@ func Notifications(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 			if !ok {
-				http.Error(w, "access denied", 400)
-				return
+				return 400, fmt.Errorf("access denied")
+			}
+		}
+		// Vote if the reply contains an explicit command (+1, -1, accept, reject)
+		if vote, ok, rest := tools.ParseVoteCommand(msg); ok {
+			if _, err := graph.AddVote(uctx, contract, vote); err != nil {
+				// Only a refused vote is the sender's fault; let the
+				// mail server retry on internal failures.
+				if strings.HasPrefix(err.Error(), "Access denied") {
+					return 400, err
+				}
+				return 500, err
+			}
+			msg = rest
+			if msg == "" && len(form.Attachments) == 0 {
+				return 200, nil
 			}
 		}
+		// Store the attachments, on the contract tension
+		attachments := storeAttachments(uctx, contract.Tension.Receiverid, form.Attachments)
+		for _, a := range attachments {
+			a.Tension = &model.TensionRef{ID: &contract.Tension.ID}
+		}
 		// Publish  event
-		db.GetDB().Update(db.DB.GetRootUctx(), "contract", model.UpdateContractInput{
+		err = db.GetDB().Update(db.DB.GetRootUctx(), "contract", model.UpdateContractInput{
 			Filter: &model.ContractFilter{ID: []string{isCid}},
 			Set: &model.ContractPatch{
 				Comments: []*model.CommentRef{&model.CommentRef{
-					CreatedAt: &createdAt,
-					CreatedBy: &createdBy,
-					Message:   &form.Msg,
+					CreatedAt:   &createdAt,
+					CreatedBy:   &createdBy,
+					Message:     &msg,
+					Attachments: attachments,
 				}},
 			},
 		})
+		if err != nil {
+			return 500, err
+		}
 
 		// Publish Notification
 		// --
@@ -193,118 +297,90 @@ func Notifications(w http.ResponseWriter, r *http.Request) {
 		}
 		// Push notification
 		if err := graph.PushContractNotifications(notif); err != nil {
-			http.Error(w, "PushContractNotification error: "+err.Error(), 500)
-			return
+			return 500, fmt.Errorf("PushContractNotification error: %v", err)
 		}
 	} else {
 		// In every other case, it returns an error.
-		http.Error(w, "Unknown references", 400)
-		return
+		return 400, fmt.Errorf("Unknown references")
 	}
 
+	return 200, nil
 }
 
-// Handle email sent to orga. Convert email to tension.
-func Mailing(w http.ResponseWriter, r *http.Request) {
-	// Validate WebHook identity
-	if err := tools.ValidatePostalSignature(r, postalWebhookPK); err != nil {
-		http.Error(w, err.Error(), 400)
-		return
-	}
-
-	// Get request form
-	var form EmailForm
-	err := json.NewDecoder(r.Body).Decode(&form)
+// ReceiveMailing converts an email sent to an organisation (<nameid>@domain)
+// into a new tension.
+func ReceiveMailing(form EmailForm) (int, error) {
+	// Get the targeted circle
+	toEmail, err := mail.ParseAddress(form.To)
 	if err != nil {
-		http.Error(w, err.Error(), 500)
-		return
+		return 400, fmt.Errorf("RECIPIENT EMAIL NOT FOUND")
 	}
-
-	// Get author
-	uctx, err := db.GetDB().GetUctx("email", form.From)
+	receiverid, setting, err := graph.GetMailingTarget(strings.Split(toEmail.Address, "@")[0])
 	if err != nil {
-		http.Error(w, "You need an account on Fractale to send email to organisation, please visit https://fractale.co \n\n"+err.Error(), 400)
-		return
+		return 400, err
 	}
-	createdAt := tools.Now()
-	createdBy := model.User{Username: uctx.Username}
 
-	// Get the nameid of the targeted circle
-	toEmail, err := mail.ParseAddress(form.To)
+	// Get author (senders without account may be allowed by the mailing setting)
+	uctx, err := db.GetDB().GetUctx("email", form.From)
 	if err != nil {
-		http.Error(w, "RECIPIENT EMAIL NOT FOUND", 400)
-		return
-	}
-	receiverid := strings.Replace(strings.Split(toEmail.Address, "@")[0], "/", "#", -1)
-	filter := `eq(Node.isArchived, false)`
-	if ex, _ := db.GetDB().Exists("Node.nameid", receiverid, &filter); !ex {
-		http.Error(w, "NAMEID NOT FOUND", 400)
-		return
+		uctx = nil
 	}
 
-	// Build the tension
-	e := model.TensionEventCreated
-	rootnameid, _ := codec.Nid2rootid(receiverid)
-	emitterid := codec.MemberIdCodec(rootnameid, uctx.Username)
-	event := model.Event{
-		CreatedAt: createdAt,
-		CreatedBy: &createdBy,
-		EventType: e,
-	}
-	tension := model.Tension{
-		CreatedAt:  createdAt,
-		CreatedBy:  &model.User{Username: uctx.Username},
-		Emitterid:  emitterid,
-		Emitter:    &model.Node{Nameid: emitterid},
-		Receiverid: receiverid,
-		Receiver:   &model.Node{Nameid: receiverid},
-		Type:       model.TensionTypeOperational,
-		Status:     model.TensionStatusOpen,
-		Title:      form.Title,
-		Comments: []*model.Comment{
-			&model.Comment{
-				CreatedAt: createdAt,
-				CreatedBy: &createdBy,
-				Message:   form.Msg,
-			},
-		},
-		Subscribers: []*model.User{&model.User{Username: uctx.Username}},
+	// Create tension
+	tid, author, err := graph.CreateMailingTension(uctx, form.From, receiverid, setting, form.Title, form.Msg)
+	if err != nil && tid == "" {
+		if uctx == nil {
+			return 400, fmt.Errorf("You need an account on Fractale to send email to organisation, please visit https://fractale.co \n\n%v", err)
+		}
+		return 400, err
 	}
 
-	// Verify author can create tension
-	var eventRef model.EventRef
-	tools.StructMap(event, &eventRef)
-	ok, _, err := graph.ProcessEvent(uctx, &tension, &eventRef, nil, nil, true, false)
-	if !ok || err != nil {
-		http.Error(w, "NOT AUTHORIZED TO CREATE TENSION HERE", 400)
-		return
+	// Store the attachments
+	if attachments := storeAttachments(author, receiverid, form.Attachments); len(attachments) > 0 {
+		db.GetDB().Update(db.DB.GetRootUctx(), "tension", model.UpdateTensionInput{
+			Filter: &model.TensionFilter{ID: []string{tid}},
+			Set:    &model.TensionPatch{Attachments: attachments},
+		})
 	}
 
-	// Create tension
-	var tensionInput model.AddTensionInput
-	tools.StructMap(tension, &tensionInput)
-	tid, err := db.GetDB().Add(*uctx, "tension", tensionInput)
 	if err != nil {
-		http.Error(w, err.Error(), 400)
-		return
+		return 500, err
 	}
+	return 200, nil
+}
 
-	// Publish Notification
-	// --
-	notif := model.EventNotif{
-		Uctx:    uctx,
-		Tid:     tid,
-		History: []*model.EventRef{&eventRef},
-	}
-	// Push notification
-	if err := graph.PushEventNotifications(notif); err != nil {
-		http.Error(w, "PushEventNotifications error: "+err.Error(), 500)
-		return
-	}
+type PostalWebhookForm struct {
+	Event   string `json:"event"`
+	Payload struct {
+		Status  string `json:"status"`
+		Details string `json:"details"`
+		// MessageSent, MessageDelayed, MessageDeliveryFailed and MessageHeld events
+		Message *PostalMessage `json:"message"`
+		// MessageBounced event
+		OriginalMessage *PostalMessage `json:"original_message"`
+	} `json:"payload"`
+}
 
+type PostalMessage struct {
+	To        string `json:"to"`
+	Direction string `json:"direction"`
 }
 
-// Handle Postal WebHook - redirect it to a matrix channel
+// Delivery status of the Postal webhook events.
+// Postal doesn't report the spam complaints itself, the feedback loop
+// reports can be relayed with the MessageComplained event and the
+// MessageBounced payload.
+var postalEvents = map[string]model.EmailStatus{
+	"MessageSent":           model.EmailStatusDelivered,
+	"MessageDelayed":        model.EmailStatusSoftBounced,
+	"MessageDeliveryFailed": model.EmailStatusHardBounced,
+	"MessageHeld":           model.EmailStatusHeld,
+	"MessageBounced":        model.EmailStatusHardBounced,
+	"MessageComplained":     model.EmailStatusComplained,
+}
+
+// Handle Postal WebHook - record the delivery status of the sent emails,
+// and optionally redirect it to a matrix channel.
 func PostalWebhook(w http.ResponseWriter, r *http.Request) {
 	// Validate WebHook identity
 	if err := tools.ValidatePostalSignature(r, postalWebhookPK); err != nil {
@@ -319,9 +395,30 @@ func PostalWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = tools.MatrixJsonSend(string(body), matrixPostalRoom, matrixToken)
-	if err != nil {
+	var form PostalWebhookForm
+	if err := json.Unmarshal(body, &form); err != nil {
 		http.Error(w, err.Error(), 400)
 		return
 	}
+
+	// Record the delivery event
+	if status, ok := postalEvents[form.Event]; ok {
+		m := form.Payload.Message
+		if m == nil {
+			m = form.Payload.OriginalMessage
+		}
+		if m != nil && m.To != "" && m.Direction != "incoming" {
+			if err := graph.ProcessDeliveryEvent(m.To, status); err != nil {
+				http.Error(w, err.Error(), 500)
+				return
+			}
+		}
+	}
+
+	// Matrix sink
+	if matrixPostalRoom != "" && matrixToken != "" {
+		if err := tools.MatrixJsonSend(string(body), matrixPostalRoom, matrixToken); err != nil {
+			log.Printf("PostalWebhook: matrix forwarding failed: %v", err)
+		}
+	}
 }
@@ -26,6 +26,7 @@ import (
 	"net/http"
 
 	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/model"
 	"fractale/fractal6.go/web/auth"
 )
 
@@ -115,6 +116,64 @@ func TopLabels(w http.ResponseWriter, r *http.Request) {
 	w.Write(jsonData)
 }
 
+func TopTemplates(w http.ResponseWriter, r *http.Request) {
+	form := struct {
+		Nameid      string
+		IncludeSelf bool
+	}{}
+
+	// Get the JSON body and decode it
+	err := json.NewDecoder(r.Body).Decode(&form)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	// Get top tension templates
+	templates, err := db.GetDB().GetTopTemplates("nameid", form.Nameid, form.IncludeSelf)
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+
+	// Only keep the templates of the circles visible by the user
+	uctx := auth.GetUserContextOrEmpty(r.Context())
+	var nameids []string
+	for _, t := range templates {
+		for _, n := range t.Nodes {
+			nameids = append(nameids, n.Nameid)
+		}
+	}
+	visible := make(map[string]bool)
+	if len(nameids) > 0 {
+		nids, _, err := auth.VisibleNameids(uctx, nameids)
+		if err != nil {
+			http.Error(w, err.Error(), 500)
+			return
+		}
+		for _, nid := range nids {
+			visible[nid] = true
+		}
+	}
+	data := []model.TensionTemplate{}
+	for _, t := range templates {
+		for _, n := range t.Nodes {
+			if visible[n.Nameid] {
+				data = append(data, t)
+				break
+			}
+		}
+	}
+
+	// Return the user context
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	w.Write(jsonData)
+}
+
 func SubLabels(w http.ResponseWriter, r *http.Request) {
 	var q string
 
@@ -210,8 +269,13 @@ func TensionsLight(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Filter the nameids according to the @auth directives
+	// Apply the saved view, if any, and filter the nameids according to the @auth directives
 	uctx := auth.GetUserContextOrEmpty(r.Context())
+	err = auth.ApplyTensionView(uctx, &q)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
 	err = auth.QueryAuthFilter(uctx, &q)
 	if err != nil {
 		http.Error(w, err.Error(), 500)
@@ -219,11 +283,15 @@ func TensionsLight(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get Int Tensions
-	data, err := db.GetDB().GetTensions(q, "light")
+	data, next, err := db.GetDB().GetTensionsPage(q, "light")
 	if err != nil {
 		http.Error(w, err.Error(), 500)
 		return
 	}
+	if next != nil {
+		// Cursor of the next page
+		w.Header().Set("X-Next-Cursor", *next)
+	}
 
 	// Filter authorized tension
 	//final := []model.Tension{}
@@ -260,8 +328,13 @@ func TensionsInt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Filter the nameids according to the @auth directives
+	// Apply the saved view, if any, and filter the nameids according to the @auth directives
 	uctx := auth.GetUserContextOrEmpty(r.Context())
+	err = auth.ApplyTensionView(uctx, &q)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
 	err = auth.QueryAuthFilter(uctx, &q)
 	if err != nil {
 		http.Error(w, err.Error(), 500)
@@ -269,11 +342,15 @@ func TensionsInt(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get Int Tensions
-	data, err := db.GetDB().GetTensions(q, "int")
+	data, next, err := db.GetDB().GetTensionsPage(q, "int")
 	if err != nil {
 		http.Error(w, err.Error(), 500)
 		return
 	}
+	if next != nil {
+		// Cursor of the next page
+		w.Header().Set("X-Next-Cursor", *next)
+	}
 
 	// Filter authorized tension
 	//final := []model.Tension{}
@@ -310,8 +387,13 @@ func TensionsExt(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Filter the nameids according to the @auth directives
+	// Apply the saved view, if any, and filter the nameids according to the @auth directives
 	uctx := auth.GetUserContextOrEmpty(r.Context())
+	err = auth.ApplyTensionView(uctx, &q)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
 	err = auth.QueryAuthFilter(uctx, &q)
 	if err != nil {
 		http.Error(w, err.Error(), 500)
@@ -319,11 +401,15 @@ func TensionsExt(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get Ext Tensions
-	data, err := db.GetDB().GetTensions(q, "ext")
+	data, next, err := db.GetDB().GetTensionsPage(q, "ext")
 	if err != nil {
 		http.Error(w, err.Error(), 500)
 		return
 	}
+	if next != nil {
+		// Cursor of the next page
+		w.Header().Set("X-Next-Cursor", *next)
+	}
 
 	// Return the user context
 	jsonData, err := json.Marshal(data)
@@ -344,8 +430,13 @@ func TensionsAll(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Filter the nameids according to the @auth directives
+	// Apply the saved view, if any, and filter the nameids according to the @auth directives
 	uctx := auth.GetUserContextOrEmpty(r.Context())
+	err = auth.ApplyTensionView(uctx, &q)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
 	err = auth.QueryAuthFilter(uctx, &q)
 	if err != nil {
 		http.Error(w, err.Error(), 500)
@@ -353,11 +444,15 @@ func TensionsAll(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get all tensions
-	data, err := db.GetDB().GetTensions(q, "all")
+	data, next, err := db.GetDB().GetTensionsPage(q, "all")
 	if err != nil {
 		http.Error(w, err.Error(), 500)
 		return
 	}
+	if next != nil {
+		// Cursor of the next page
+		w.Header().Set("X-Next-Cursor", *next)
+	}
 
 	// Return the user context
 	jsonData, err := json.Marshal(data)
@@ -378,8 +473,13 @@ func TensionsCount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Filter the nameids according to the @auth directives
+	// Apply the saved view, if any, and filter the nameids according to the @auth directives
 	uctx := auth.GetUserContextOrEmpty(r.Context())
+	err = auth.ApplyTensionView(uctx, &q)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
 	err = auth.QueryAuthFilter(uctx, &q)
 	if err != nil {
 		http.Error(w, err.Error(), 500)
@@ -0,0 +1,63 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"fractale/fractal6.go/graph"
+	"fractale/fractal6.go/graph/model"
+	"fractale/fractal6.go/web/auth"
+)
+
+// BulkTensions applies one change to a set of tensions, and returns
+// the result for each tension.
+func BulkTensions(w http.ResponseWriter, r *http.Request) {
+	// Get user context
+	_, uctx, err := auth.GetUserContext(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+
+	// Get request form
+	var form model.BulkTensionForm
+	err = json.NewDecoder(r.Body).Decode(&form)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	results, err := graph.BulkTensionOp(uctx, form)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	// Return the per-tension results
+	jsonData, err := json.Marshal(results)
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	w.Write(jsonData)
+}
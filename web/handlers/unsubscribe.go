@@ -0,0 +1,87 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+
+	"fractale/fractal6.go/db"
+	"fractale/fractal6.go/graph/model"
+	"fractale/fractal6.go/tools"
+	"fractale/fractal6.go/web/auth"
+)
+
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<html>
+<head><meta charset="utf-8"><title>Fractale</title></head>
+<body>
+{{if .Done}}<p>You have been unsubscribed.</p>
+{{else}}<form method="post">
+<input type="hidden" name="List-Unsubscribe" value="One-Click">
+<p>Stop receiving these email notifications?</p>
+<button type="submit">Unsubscribe</button>
+</form>
+{{end}}</body>
+</html>`))
+
+// Unsubscribe stops the notifications targeted by the signed token given in
+// the `token` query parameter (see auth.UnsubscribeToken).
+// GET requests ask for a confirmation, as links may be followed by mail scanners.
+// POST requests unsubscribe, including the RFC 8058 one-click requests.
+func Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	t, err := auth.ParseUnsubscribeToken(r.URL.Query().Get("token"))
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	if r.Method != "POST" {
+		unsubscribePage.Execute(w, map[string]bool{"Done": false})
+		return
+	}
+
+	switch t.Kind {
+	case auth.UnsubscribeTension:
+		if !tools.IsUid(t.Target) {
+			http.Error(w, "invalid tension id", 400)
+			return
+		}
+		err = db.GetDB().RemoveTensionSubscriber(t.Target, t.Username)
+	case auth.UnsubscribeNode:
+		err = db.GetDB().RemoveNodeWatcher(t.Target, t.Username)
+	case auth.UnsubscribeReason:
+		reason := model.NotifPreferenceReason(t.Target)
+		if !reason.IsValid() {
+			http.Error(w, "invalid notification reason", 400)
+			return
+		}
+		// Keep the in-app notifications
+		err = db.GetDB().SetNotifPreference(t.Username, reason, model.NotifChannelApp)
+	case auth.UnsubscribeEmail:
+		err = db.GetDB().SetFieldByEq("User.username", t.Username, "User.notifyByEmail", "false")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+
+	unsubscribePage.Execute(w, map[string]bool{"Done": true})
+}
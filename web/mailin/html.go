@@ -0,0 +1,216 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mailin
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// HtmlToText converts an HTML email body to plain text. Paragraphs and line
+// breaks are kept, links are followed by their target and blockquotes are
+// quoted with "> " as in a plain text reply.
+func HtmlToText(s string) string {
+	doc, err := html.Parse(strings.NewReader(s))
+	if err != nil {
+		return s
+	}
+	var w textWriter
+	w.walk(doc)
+	w.breakLine()
+
+	// Remove the trailing spaces and the successive blank lines
+	var out []string
+	for _, l := range w.lines {
+		l = strings.TrimRight(l, " ")
+		if l == "" && (len(out) == 0 || out[len(out)-1] == "") {
+			continue
+		}
+		out = append(out, l)
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+type textWriter struct {
+	lines []string
+	line  strings.Builder
+	quote int  // blockquote depth
+	pre   int  // preformatted depth
+	blank bool // a blank line is due before the next text
+	space bool // a space is due before the next text
+}
+
+// text writes a text node, collapsing its white spaces.
+func (w *textWriter) text(s string) {
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		w.space = w.space || s != ""
+		return
+	}
+	w.space = w.space || strings.TrimLeft(s, " \t\r\n") != s
+	w.write(strings.Join(words, " "))
+	w.space = strings.TrimRight(s, " \t\r\n") != s
+}
+
+func (w *textWriter) write(s string) {
+	if w.blank {
+		w.lines = append(w.lines, "")
+		w.blank = false
+	}
+	if w.line.Len() == 0 {
+		w.line.WriteString(strings.Repeat("> ", w.quote))
+	} else if w.space {
+		w.line.WriteString(" ")
+	}
+	w.line.WriteString(s)
+	w.space = false
+}
+
+// newLine ends the current line, even if empty.
+func (w *textWriter) newLine() {
+	w.lines = append(w.lines, w.line.String())
+	w.line.Reset()
+	w.space = false
+}
+
+// breakLine ends the current line if it is not empty.
+func (w *textWriter) breakLine() {
+	if w.line.Len() > 0 {
+		w.newLine()
+	}
+}
+
+// breakBlock ends the current line and separates the next text with a blank line.
+func (w *textWriter) breakBlock() {
+	w.breakLine()
+	w.blank = len(w.lines) > 0
+}
+
+func (w *textWriter) walk(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		if w.pre > 0 {
+			for i, l := range strings.Split(n.Data, "\n") {
+				if i > 0 {
+					w.newLine()
+				}
+				if l != "" {
+					w.write(l)
+				}
+			}
+		} else {
+			w.text(n.Data)
+		}
+		return
+	case html.ElementNode:
+	case html.DocumentNode:
+		w.walkChildren(n)
+		return
+	default:
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Head, atom.Script, atom.Style, atom.Title:
+		return
+	case atom.Br:
+		w.newLine()
+	case atom.Img:
+		if alt := attr(n, "alt"); alt != "" {
+			w.text(alt)
+		}
+	case atom.Hr:
+		w.breakBlock()
+		w.write("---")
+		w.breakBlock()
+	case atom.A:
+		w.walkChildren(n)
+		href := attr(n, "href")
+		if strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://") {
+			if text := strings.TrimSpace(textContent(n)); text != href {
+				w.space = true
+				w.write("(" + href + ")")
+			}
+		}
+	case atom.Li:
+		w.breakLine()
+		w.write("-")
+		w.space = true
+		w.walkChildren(n)
+		w.breakLine()
+	case atom.Td, atom.Th:
+		w.space = true
+		w.walkChildren(n)
+		w.space = true
+	case atom.Div, atom.Tr, atom.Dt, atom.Dd:
+		w.breakLine()
+		w.walkChildren(n)
+		w.breakLine()
+	case atom.Blockquote:
+		w.breakBlock()
+		w.quote++
+		w.walkChildren(n)
+		w.breakLine()
+		w.quote--
+		w.breakBlock()
+	case atom.Pre:
+		w.breakBlock()
+		w.pre++
+		w.walkChildren(n)
+		w.pre--
+		w.breakBlock()
+	case atom.P, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6,
+		atom.Ul, atom.Ol, atom.Dl, atom.Table:
+		w.breakBlock()
+		w.walkChildren(n)
+		w.breakBlock()
+	default:
+		w.walkChildren(n)
+	}
+}
+
+func (w *textWriter) walkChildren(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		w.walk(c)
+	}
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(textContent(c))
+	}
+	return b.String()
+}
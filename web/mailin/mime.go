@@ -0,0 +1,197 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mailin
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+)
+
+// Message is an inbound email, decoded from its raw MIME form.
+type Message struct {
+	From        string // Address of the From header
+	Subject     string
+	Text        string // Plain text body, converted from HTML if needed
+	Attachments []Attachment
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Maximum depth of nested multipart entities.
+const maxPartDepth = 10
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: charset.NewReaderLabel}
+
+// ParseMessage parses a raw email (RFC 5322) and its MIME parts. The body is
+// taken from the first text/plain part, or from the first text/html part if
+// there is none. The other parts, and the parts marked as attachment, are
+// returned as attachments.
+func ParseMessage(r io.Reader) (*Message, error) {
+	m, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := Message{}
+	if subject, err := wordDecoder.DecodeHeader(m.Header.Get("Subject")); err == nil {
+		msg.Subject = subject
+	} else {
+		msg.Subject = m.Header.Get("Subject")
+	}
+	parser := mail.AddressParser{WordDecoder: wordDecoder}
+	if from, err := parser.Parse(m.Header.Get("From")); err == nil {
+		msg.From = from.Address
+	}
+
+	p := parts{msg: &msg}
+	err = p.walk(textproto.MIMEHeader(m.Header), m.Body, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.plain != "" {
+		msg.Text = strings.TrimSpace(p.plain)
+	} else {
+		msg.Text = HtmlToText(p.html)
+	}
+	return &msg, nil
+}
+
+// parts collects the content of the MIME parts of a message.
+type parts struct {
+	msg   *Message
+	plain string
+	html  string
+}
+
+func (p *parts) walk(header textproto.MIMEHeader, body io.Reader, depth int) error {
+	if depth > maxPartDepth {
+		return fmt.Errorf("too many nested MIME parts")
+	}
+
+	mediatype, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		// RFC 2045: default to plain text
+		mediatype, params = "text/plain", map[string]string{}
+	}
+	body = decodeTransfer(header.Get("Content-Transfer-Encoding"), body)
+
+	// Multipart entities
+	if strings.HasPrefix(mediatype, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextRawPart()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if err := p.walk(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	// Inline text
+	disposition, dparams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := dparams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+	if disposition != "attachment" && filename == "" {
+		switch mediatype {
+		case "text/plain":
+			if p.plain == "" {
+				p.plain = decodeCharset(params["charset"], data)
+			}
+			return nil
+		case "text/html":
+			if p.html == "" {
+				p.html = decodeCharset(params["charset"], data)
+			}
+			return nil
+		}
+	}
+
+	// Attachments
+	if f, err := wordDecoder.DecodeHeader(filename); err == nil {
+		filename = f
+	}
+	if filename == "" {
+		filename = "attachment"
+		if exts, _ := mime.ExtensionsByType(mediatype); len(exts) > 0 {
+			filename += exts[0]
+		}
+	}
+	p.msg.Attachments = append(p.msg.Attachments, Attachment{
+		Filename:    filename,
+		ContentType: mediatype,
+		Data:        data,
+	})
+	return nil
+}
+
+// decodeTransfer decodes the body of a part according to its Content-Transfer-Encoding.
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+// decodeCharset converts the text to UTF-8.
+func decodeCharset(label string, data []byte) string {
+	if label == "" {
+		return string(data)
+	}
+	r, err := charset.NewReaderLabel(label, bytes.NewReader(data))
+	if err != nil {
+		return string(data)
+	}
+	text, err := io.ReadAll(r)
+	if err != nil {
+		return string(data)
+	}
+	return string(text)
+}
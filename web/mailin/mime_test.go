@@ -0,0 +1,127 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mailin
+
+import (
+	"strings"
+	"testing"
+)
+
+func crlf(s string) string {
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
+
+func TestParseMessagePlain(t *testing.T) {
+	raw := crlf(`From: =?UTF-8?Q?Ren=C3=A9e?= <renee@example.com>
+To: reply+t0x1-renee-abc@fractale.co
+Subject: =?ISO-8859-1?Q?R=E9union?=
+Content-Type: text/plain; charset=iso-8859-1
+Content-Transfer-Encoding: quoted-printable
+
+J'arrive =E0 10h.=
+ Merci.
+`)
+	msg, err := ParseMessage(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.From != "renee@example.com" {
+		t.Errorf("From = %q", msg.From)
+	}
+	if msg.Subject != "Réunion" {
+		t.Errorf("Subject = %q", msg.Subject)
+	}
+	if msg.Text != "J'arrive à 10h. Merci." {
+		t.Errorf("Text = %q", msg.Text)
+	}
+}
+
+func TestParseMessageMultipart(t *testing.T) {
+	raw := crlf(`From: alice@example.com
+To: circle@fractale.co
+Subject: Report
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: multipart/alternative; boundary="inner"
+
+--inner
+Content-Type: text/html; charset=utf-8
+
+<p>Hello <b>world</b></p>
+--inner--
+--outer
+Content-Type: application/pdf; name="report.pdf"
+Content-Disposition: attachment; filename="report.pdf"
+Content-Transfer-Encoding: base64
+
+JVBERi0x
+LjQK
+--outer
+Content-Type: image/png
+Content-Transfer-Encoding: base64
+
+iVBORw==
+--outer--
+`)
+	msg, err := ParseMessage(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Text != "Hello world" {
+		t.Errorf("Text = %q", msg.Text)
+	}
+	if len(msg.Attachments) != 2 {
+		t.Fatalf("got %d attachments, want 2", len(msg.Attachments))
+	}
+	a := msg.Attachments[0]
+	if a.Filename != "report.pdf" || a.ContentType != "application/pdf" || string(a.Data) != "%PDF-1.4\n" {
+		t.Errorf("attachment = %q %q %q", a.Filename, a.ContentType, a.Data)
+	}
+	if a := msg.Attachments[1]; a.Filename != "attachment.png" || len(a.Data) != 4 {
+		t.Errorf("attachment = %q %d bytes", a.Filename, len(a.Data))
+	}
+}
+
+func TestHtmlToText(t *testing.T) {
+	testcases := []struct {
+		input string
+		want  string
+	}{
+		{"Hello <b>world</b>!", "Hello world!"},
+		{"<p>One</p><p>Two</p>", "One\n\nTwo"},
+		{"<div>One</div><div>Two</div><div><br></div><div>Three</div>", "One\nTwo\n\nThree"},
+		{"line<br>break", "line\nbreak"},
+		{"<ul><li>a</li><li>b</li></ul>", "- a\n- b"},
+		{`<a href="https://fractale.co">Fractale</a>`, "Fractale (https://fractale.co)"},
+		{`<a href="https://fractale.co">https://fractale.co</a>`, "https://fractale.co"},
+		{"<style>p {}</style><p>Yes</p>", "Yes"},
+		{"Yes<blockquote>previous<br>message</blockquote>", "Yes\n\n> previous\n> message"},
+		{"<pre>a\n  b</pre>", "a\n  b"},
+	}
+
+	for _, test := range testcases {
+		if got := HtmlToText(test.input); got != test.want {
+			t.Errorf("HtmlToText(%q) = %q, want %q", test.input, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mailin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Handler processes the message received for one recipient.
+// The error is reported to the MTA, which bounces the message.
+type Handler func(from, to string, data []byte) error
+
+// Server receives the emails delivered by a MTA (Postfix, Exim...) with
+// LMTP (RFC 2033) or SMTP (RFC 5321). It only implements what is needed to
+// receive mails for local delivery: no relay, no authentication and no TLS,
+// so it should listen on a local address, behind the MTA.
+type Server struct {
+	Addr     string // TCP address to listen on
+	Hostname string // Hostname announced in the greeting
+	Domain   string // Domain of the accepted recipients
+	LMTP     bool
+	MaxSize  int64 // Maximum size of the messages, in bytes
+	MaxRcpts int   // Maximum number of recipients per message
+	Timeout  time.Duration
+	Handler  Handler
+
+	mu       sync.Mutex
+	listener net.Listener
+}
+
+var errTooBig = errors.New("message too big")
+
+// ListenAndServe listens on the TCP address s.Addr and serves the incoming connections.
+func (s *Server) ListenAndServe() error {
+	l, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts the connections on the listener until it is closed.
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	s.listener = l
+	s.mu.Unlock()
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go s.serveConn(c)
+	}
+}
+
+// Close stops listening. The sessions in progress are not interrupted.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Close()
+}
+
+// session is the state of a client connection.
+type session struct {
+	s     *Server
+	conn  net.Conn
+	tp    *textproto.Conn
+	helo  bool
+	from  *string
+	rcpts []string
+}
+
+func (s *Server) serveConn(c net.Conn) {
+	defer c.Close()
+	ss := session{s: s, conn: c, tp: textproto.NewConn(c)}
+	ss.reply(220, "%s %s Fractale ready", s.Hostname, ss.protocol())
+	for {
+		if s.Timeout > 0 {
+			c.SetDeadline(time.Now().Add(s.Timeout))
+		}
+		line, err := ss.tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		if !ss.handle(strings.ToUpper(verb), strings.TrimSpace(arg)) {
+			return
+		}
+	}
+}
+
+func (ss *session) protocol() string {
+	if ss.s.LMTP {
+		return "LMTP"
+	}
+	return "ESMTP"
+}
+
+func (ss *session) reply(code int, format string, args ...interface{}) {
+	ss.tp.PrintfLine("%d %s", code, fmt.Sprintf(format, args...))
+}
+
+func (ss *session) reset() {
+	ss.from = nil
+	ss.rcpts = nil
+}
+
+// handle processes a command and returns false if the connection must be closed.
+func (ss *session) handle(verb, arg string) bool {
+	switch verb {
+	case "LHLO", "EHLO", "HELO":
+		if (verb == "LHLO") != ss.s.LMTP {
+			ss.reply(500, "5.5.1 Unknown command for %s", ss.protocol())
+			return true
+		}
+		ss.reset()
+		ss.helo = true
+		if verb == "HELO" {
+			ss.reply(250, "%s", ss.s.Hostname)
+			return true
+		}
+		ss.tp.PrintfLine("250-%s", ss.s.Hostname)
+		ss.tp.PrintfLine("250-PIPELINING")
+		ss.tp.PrintfLine("250-8BITMIME")
+		ss.tp.PrintfLine("250-ENHANCEDSTATUSCODES")
+		ss.reply(250, "SIZE %d", ss.s.MaxSize)
+	case "MAIL":
+		if !ss.helo {
+			ss.reply(503, "5.5.1 Say hello first")
+			return true
+		}
+		if ss.from != nil {
+			ss.reply(503, "5.5.1 Nested MAIL command")
+			return true
+		}
+		from, params, err := parsePath(arg, "FROM:")
+		if err != nil {
+			ss.reply(501, "5.5.4 %s", err)
+			return true
+		}
+		if size, err := strconv.ParseInt(params["SIZE"], 10, 64); err == nil && ss.s.MaxSize > 0 && size > ss.s.MaxSize {
+			ss.reply(552, "5.3.4 Message too big")
+			return true
+		}
+		ss.from = &from
+		ss.reply(250, "2.1.0 OK")
+	case "RCPT":
+		if ss.from == nil {
+			ss.reply(503, "5.5.1 Need MAIL command")
+			return true
+		}
+		to, _, err := parsePath(arg, "TO:")
+		if err != nil {
+			ss.reply(501, "5.5.4 %s", err)
+			return true
+		}
+		if !ss.s.accept(to) {
+			ss.reply(550, "5.1.1 Unknown recipient")
+			return true
+		}
+		if ss.s.MaxRcpts > 0 && len(ss.rcpts) >= ss.s.MaxRcpts {
+			ss.reply(452, "4.5.3 Too many recipients")
+			return true
+		}
+		ss.rcpts = append(ss.rcpts, to)
+		ss.reply(250, "2.1.5 OK")
+	case "DATA":
+		if len(ss.rcpts) == 0 {
+			ss.reply(503, "5.5.1 Need RCPT command")
+			return true
+		}
+		ss.reply(354, "End data with <CR><LF>.<CR><LF>")
+		data, err := ss.readData()
+		if err == errTooBig {
+			ss.replyAll(552, "5.3.4 Message too big")
+		} else if err != nil {
+			return false
+		} else {
+			ss.deliver(data)
+		}
+		ss.reset()
+	case "RSET":
+		ss.reset()
+		ss.reply(250, "2.0.0 OK")
+	case "NOOP":
+		ss.reply(250, "2.0.0 OK")
+	case "VRFY":
+		ss.reply(252, "2.5.0 Cannot verify user")
+	case "QUIT":
+		ss.reply(221, "2.0.0 Bye")
+		return false
+	default:
+		ss.reply(500, "5.5.2 Unknown command")
+	}
+	return true
+}
+
+// readData reads the message until the terminating dot.
+func (ss *session) readData() ([]byte, error) {
+	r := ss.tp.DotReader()
+	var lr io.Reader = r
+	if ss.s.MaxSize > 0 {
+		lr = io.LimitReader(r, ss.s.MaxSize+1)
+	}
+	data, err := io.ReadAll(lr)
+	if err != nil {
+		return nil, err
+	}
+	if ss.s.MaxSize > 0 && int64(len(data)) > ss.s.MaxSize {
+		// Consume the rest of the message
+		if _, err := io.Copy(io.Discard, r); err != nil {
+			return nil, err
+		}
+		return nil, errTooBig
+	}
+	return data, nil
+}
+
+// replyAll replies once per recipient with LMTP, once for all with SMTP.
+func (ss *session) replyAll(code int, format string, args ...interface{}) {
+	n := 1
+	if ss.s.LMTP {
+		n = len(ss.rcpts)
+	}
+	for i := 0; i < n; i++ {
+		ss.reply(code, format, args...)
+	}
+}
+
+// deliver passes the message to the handler for each recipient. With SMTP,
+// the message is rejected if it fails for one of the recipients.
+func (ss *session) deliver(data []byte) {
+	var failed error
+	for _, to := range ss.rcpts {
+		err := ss.s.Handler(*ss.from, to, data)
+		if err != nil {
+			log.Printf("mailin: message from %s to %s rejected: %v", *ss.from, to, err)
+			failed = err
+		}
+		if !ss.s.LMTP {
+			continue
+		}
+		if err != nil {
+			ss.reply(550, "5.7.1 %s", oneLine(err))
+		} else {
+			ss.reply(250, "2.0.0 OK")
+		}
+	}
+	if ss.s.LMTP {
+		return
+	}
+	if failed != nil {
+		ss.reply(550, "5.7.1 %s", oneLine(failed))
+	} else {
+		ss.reply(250, "2.0.0 OK")
+	}
+}
+
+// accept returns true if the recipient belongs to the server domain.
+func (s *Server) accept(address string) bool {
+	if s.Domain == "" {
+		return true
+	}
+	i := strings.LastIndex(address, "@")
+	return i > 0 && strings.EqualFold(address[i+1:], s.Domain)
+}
+
+// parsePath parses the argument of the MAIL and RCPT commands,
+// e.g. "FROM:<alice@example.com> SIZE=1024".
+func parsePath(arg, prefix string) (string, map[string]string, error) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", nil, fmt.Errorf("Syntax error")
+	}
+	arg = strings.TrimSpace(arg[len(prefix):])
+	if !strings.HasPrefix(arg, "<") {
+		return "", nil, fmt.Errorf("Syntax error")
+	}
+	end := strings.Index(arg, ">")
+	if end < 0 {
+		return "", nil, fmt.Errorf("Syntax error")
+	}
+	path := arg[1:end]
+	params := make(map[string]string)
+	for _, p := range strings.Fields(arg[end+1:]) {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = v
+	}
+	// The null reverse-path is allowed for the bounces.
+	if path == "" {
+		if prefix == "FROM:" {
+			return "", params, nil
+		}
+		return "", nil, fmt.Errorf("Empty recipient")
+	}
+	// Strip the source route, if any (RFC 5321, Appendix C).
+	if i := strings.Index(path, ":"); i >= 0 && strings.HasPrefix(path, "@") {
+		path = path[i+1:]
+	}
+	if _, err := mail.ParseAddress(path); err != nil {
+		return "", nil, fmt.Errorf("Bad address")
+	}
+	return path, params, nil
+}
+
+// oneLine makes an error fit in a reply line.
+func oneLine(err error) string {
+	return strings.Join(strings.Fields(err.Error()), " ")
+}
@@ -0,0 +1,130 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mailin
+
+import (
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+func startServer(t *testing.T, lmtp bool, handler Handler) *textproto.Conn {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{Hostname: "mx.test", Domain: "fractale.co", LMTP: lmtp, MaxSize: 1024, Handler: handler}
+	go s.Serve(l)
+	t.Cleanup(func() { s.Close() })
+
+	c, err := textproto.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	expect(t, c, 220)
+	return c
+}
+
+// cmd sends a command and checks the code of the reply.
+func cmd(t *testing.T, c *textproto.Conn, code int, format string, args ...interface{}) {
+	t.Helper()
+	if err := c.PrintfLine(format, args...); err != nil {
+		t.Fatal(err)
+	}
+	expect(t, c, code)
+}
+
+func expect(t *testing.T, c *textproto.Conn, code int) {
+	t.Helper()
+	if _, msg, err := c.ReadResponse(code); err != nil {
+		t.Fatalf("expected %d, got %v %s", code, err, msg)
+	}
+}
+
+func TestServerLMTP(t *testing.T) {
+	received := map[string]string{}
+	c := startServer(t, true, func(from, to string, data []byte) error {
+		if strings.HasPrefix(to, "bad") {
+			return fmt.Errorf("access denied")
+		}
+		received[to] = from + "|" + string(data)
+		return nil
+	})
+
+	cmd(t, c, 500, "EHLO client.test")
+	cmd(t, c, 503, "MAIL FROM:<alice@example.com>")
+	cmd(t, c, 250, "LHLO client.test")
+	cmd(t, c, 250, "MAIL FROM:<alice@example.com> SIZE=100")
+	cmd(t, c, 250, "RCPT TO:<circle@fractale.co>")
+	cmd(t, c, 550, "RCPT TO:<circle@example.com>")
+	cmd(t, c, 250, "RCPT TO:<bad@FRACTALE.co>")
+	cmd(t, c, 354, "DATA")
+	c.PrintfLine("Subject: test")
+	c.PrintfLine("")
+	c.PrintfLine("..dot")
+	c.PrintfLine(".")
+	// One reply per recipient
+	expect(t, c, 250)
+	expect(t, c, 550)
+
+	if got := received["circle@fractale.co"]; got != "alice@example.com|Subject: test\n\n.dot\n" {
+		t.Errorf("received %q", got)
+	}
+	if _, ok := received["bad@FRACTALE.co"]; ok {
+		t.Errorf("message delivered to a failing recipient")
+	}
+
+	// Too big
+	cmd(t, c, 552, "MAIL FROM:<alice@example.com> SIZE=2048")
+	cmd(t, c, 250, "MAIL FROM:<>")
+	cmd(t, c, 250, "RCPT TO:<circle@fractale.co>")
+	cmd(t, c, 354, "DATA")
+	c.PrintfLine(strings.Repeat("x", 2048))
+	c.PrintfLine(".")
+	expect(t, c, 552)
+
+	cmd(t, c, 221, "QUIT")
+}
+
+func TestServerSMTP(t *testing.T) {
+	c := startServer(t, false, func(from, to string, data []byte) error {
+		if strings.HasPrefix(to, "bad") {
+			return fmt.Errorf("access denied")
+		}
+		return nil
+	})
+
+	cmd(t, c, 500, "LHLO client.test")
+	cmd(t, c, 250, "EHLO client.test")
+	cmd(t, c, 250, "MAIL FROM:<alice@example.com>")
+	cmd(t, c, 250, "RCPT TO:<circle@fractale.co>")
+	cmd(t, c, 250, "RCPT TO:<bad@fractale.co>")
+	cmd(t, c, 354, "DATA")
+	c.PrintfLine("Hello")
+	c.PrintfLine(".")
+	// One reply for all the recipients
+	expect(t, c, 550)
+	cmd(t, c, 250, "NOOP")
+	cmd(t, c, 221, "QUIT")
+}
@@ -0,0 +1,174 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package metrics holds the runtime service metrics (HTTP, GraphQL and
+// notifier) exposed to Prometheus, along with the Dgraph ones of the db package.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"fractale/fractal6.go/db"
+)
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by route, method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	gqlOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "graphql_operation_duration_seconds",
+		Help:    "GraphQL operation latency by root field.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	gqlOperationErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "graphql_operation_errors_total",
+		Help: "Number of GraphQL operations that returned errors, by root field.",
+	}, []string{"operation"})
+
+	notifierMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "notifier_messages_total",
+		Help: "Number of notifier messages processed by channel and status (ok/error).",
+	}, []string{"channel", "status"})
+
+	notifierDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "notifier_processing_duration_seconds",
+		Help:    "Notifier message processing latency by channel.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"channel"})
+)
+
+// Register adds the runtime metrics to the given registry.
+// If runtime is true, the Go and process collectors are registered as well.
+func Register(r *prometheus.Registry, runtime bool) {
+	r.MustRegister(
+		httpRequestDuration,
+		gqlOperationDuration,
+		gqlOperationErrors,
+		notifierMessages,
+		notifierDuration,
+	)
+	r.MustRegister(db.MetricsCollectors()...)
+
+	if runtime {
+		r.MustRegister(
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+			collectors.NewGoCollector(),
+		)
+	}
+}
+
+//
+// HTTP
+//
+
+// HttpMiddleware records the request latency by route pattern (not the raw
+// path, to keep the label cardinality bounded).
+func HttpMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		route := "unknown"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		httpRequestDuration.
+			WithLabelValues(route, r.Method, strconv.Itoa(status)).
+			Observe(time.Since(start).Seconds())
+	})
+}
+
+//
+// GraphQL
+//
+
+// GqlTracer is a gqlgen extension that records the latency and the
+// errors of each GraphQL operation.
+type GqlTracer struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = GqlTracer{}
+
+func (GqlTracer) ExtensionName() string {
+	return "Metrics"
+}
+
+func (GqlTracer) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (GqlTracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	start := time.Now()
+	resp := next(ctx)
+
+	// The operation name is chosen by the client, label by the root field of
+	// the operation instead, to keep the label cardinality bounded.
+	operation := "unknown"
+	if graphql.HasOperationContext(ctx) {
+		if oc := graphql.GetOperationContext(ctx); oc.Operation != nil && len(oc.Operation.SelectionSet) > 0 {
+			if f, ok := oc.Operation.SelectionSet[0].(*ast.Field); ok {
+				operation = f.Name
+			} else {
+				operation = "other"
+			}
+		}
+	}
+
+	gqlOperationDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if resp == nil || len(resp.Errors) > 0 {
+		gqlOperationErrors.WithLabelValues(operation).Inc()
+	}
+	return resp
+}
+
+//
+// Notifier
+//
+
+// ObserveNotifier records the processing of a notifier message.
+func ObserveNotifier(channel string, start time.Time, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	notifierMessages.WithLabelValues(channel, status).Inc()
+	notifierDuration.WithLabelValues(channel).Observe(time.Since(start).Seconds())
+}
@@ -31,9 +31,13 @@ import (
 	"runtime/debug"
 )
 
-// Notifier recoverer
-func NotifRecover(info string) {
+// Notifier recoverer.
+// If err is not nil, it is set to the panic error (e.g. for the metrics).
+func NotifRecover(info string, err *error) {
 	if r := recover(); r != nil {
+		if err != nil {
+			*err = fmt.Errorf("panic: %v", r)
+		}
 		// Email notification
 		fmt.Println("------------------notif recov ------------")
 		e := email.SendMaintainerEmail(
@@ -42,6 +42,16 @@ func GetCache() *Session {
 	return cache
 }
 
+// Ping checks that the session cache is reachable.
+func Ping(ctx context.Context) error {
+	return cache.Ping(ctx).Err()
+}
+
+// Close closes the session cache client.
+func Close() error {
+	return cache.Close()
+}
+
 func GenerateToken() string {
 	token, _ := uuid.NewV4()
 	return token.String()
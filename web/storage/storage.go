@@ -0,0 +1,195 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+/*
+ *
+ * Storage of the uploaded files (attachments)
+ *
+ */
+
+// Storage is a backend where the uploaded files are stored.
+type Storage interface {
+	Put(key string, r io.Reader, size int64, contentType string) error
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
+
+// Scanner checks an uploaded file before it is stored (anti-virus...).
+// It returns an error if the file must be rejected.
+type Scanner interface {
+	Scan(filename string, r io.Reader) error
+}
+
+var store Storage
+var scanners []Scanner
+var maxSize int64
+var allowedTypes []string
+
+func init() {
+	maxSize = 10 << 20 // 10MB
+	if viper.IsSet("storage.max_size") {
+		maxSize = viper.GetInt64("storage.max_size") << 20
+	}
+	allowedTypes = viper.GetStringSlice("storage.allowed_types")
+
+	var err error
+	switch backend := viper.GetString("storage.backend"); backend {
+	case "", "local":
+		root := viper.GetString("storage.local_path")
+		if root == "" {
+			root = "data/attachments"
+		}
+		store = NewLocalStorage(root)
+	case "s3":
+		store, err = NewS3Storage(S3Config{
+			Endpoint:  viper.GetString("storage.s3_endpoint"),
+			Region:    viper.GetString("storage.s3_region"),
+			Bucket:    viper.GetString("storage.s3_bucket"),
+			AccessKey: viper.GetString("storage.s3_access_key"),
+			SecretKey: viper.GetString("storage.s3_secret_key"),
+		})
+	default:
+		err = fmt.Errorf("unknown storage backend: %s", backend)
+	}
+	if err != nil {
+		fmt.Println("Storage error:", err.Error(), "- attachments disabled.")
+		store = nil
+	}
+
+	if cmd := viper.GetStringSlice("storage.scan_cmd"); len(cmd) > 0 {
+		RegisterScanner(CmdScanner{Cmd: cmd})
+	}
+}
+
+// GetStorage returns the configured storage backend, or nil if the
+// attachments are disabled.
+func GetStorage() Storage {
+	return store
+}
+
+// SetStorage overrides the configured storage backend.
+func SetStorage(s Storage) {
+	store = s
+}
+
+// RegisterScanner adds a scanner run on every uploaded file.
+func RegisterScanner(s Scanner) {
+	scanners = append(scanners, s)
+}
+
+// MaxSize returns the maximum size of an uploaded file, in bytes.
+func MaxSize() int64 {
+	return maxSize
+}
+
+// Save checks the file against the size and type limits, runs the scanners,
+// and stores it at the given key. It returns the detected content type.
+func Save(key, filename string, r io.ReadSeeker, size int64) (string, error) {
+	if store == nil {
+		return "", fmt.Errorf("attachments are disabled")
+	}
+	if size > maxSize {
+		return "", fmt.Errorf("file too large (max %d MB)", maxSize>>20)
+	}
+
+	// Detect the content type from the data, the client one can't be trusted.
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	contentType := DetectContentType(filename, head[:n])
+	if !IsAllowedType(contentType) {
+		return "", fmt.Errorf("file type not allowed: %s", contentType)
+	}
+
+	for _, s := range scanners {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+		if err := s.Scan(filename, r); err != nil {
+			return "", fmt.Errorf("file rejected: %s", err.Error())
+		}
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return contentType, store.Put(key, r, size, contentType)
+}
+
+// DetectContentType returns the media type of the file, without parameters.
+// Plain text files are refined with their extension (csv, markdown...).
+func DetectContentType(filename string, head []byte) string {
+	contentType := strings.Split(http.DetectContentType(head), ";")[0]
+	if contentType == "text/plain" {
+		switch strings.ToLower(path.Ext(filename)) {
+		case ".csv":
+			contentType = "text/csv"
+		case ".md":
+			contentType = "text/markdown"
+		}
+	}
+	return contentType
+}
+
+// IsAllowedType returns true if the content type matches the `storage.allowed_types`
+// patterns (e.g. "image/*", "application/pdf"). All types are allowed if none are given.
+func IsAllowedType(contentType string) bool {
+	if len(allowedTypes) == 0 {
+		return true
+	}
+	for _, t := range allowedTypes {
+		if t == contentType || strings.HasSuffix(t, "/*") && strings.HasPrefix(contentType, t[:len(t)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// CmdScanner pipes the file to a command (e.g. `clamdscan -`), and rejects it
+// if the command fails.
+type CmdScanner struct {
+	Cmd []string
+}
+
+func (s CmdScanner) Scan(filename string, r io.Reader) error {
+	cmd := exec.Command(s.Cmd[0], s.Cmd[1:]...)
+	cmd.Stdin = r
+	cmd.Stderr = os.Stderr
+	if out, err := cmd.Output(); err != nil {
+		return fmt.Errorf("%s: %s", filename, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
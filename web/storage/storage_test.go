@@ -0,0 +1,123 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func testStorage(t *testing.T, s Storage) {
+	data := []byte("hello attachment")
+	if err := s.Put("org/abc/file.txt", bytes.NewReader(data), int64(len(data)), "text/plain"); err != nil {
+		t.Fatalf("Put error: %v", err)
+	}
+
+	r, err := s.Get("org/abc/file.txt")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	r.Close()
+	if !bytes.Equal(got, data) {
+		t.Errorf("Get error, want: %s, got: %s", data, got)
+	}
+
+	if err := s.Delete("org/abc/file.txt"); err != nil {
+		t.Fatalf("Delete error: %v", err)
+	}
+	if _, err := s.Get("org/abc/file.txt"); err == nil {
+		t.Errorf("Get should fail after Delete")
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	s := NewLocalStorage(t.TempDir())
+	testStorage(t, s)
+
+	if err := s.Put("../escape", strings.NewReader("x"), 1, "text/plain"); err == nil {
+		t.Errorf("Put should reject keys outside of the root directory")
+	}
+}
+
+// fakeS3 is a local stand-in of an S3 bucket.
+func fakeS3(t *testing.T) *httptest.Server {
+	var mu sync.Mutex
+	objects := make(map[string][]byte)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=key/") || r.Header.Get("X-Amz-Date") == "" {
+			http.Error(w, "AccessDenied", 403)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case "PUT":
+			objects[r.URL.Path], _ = io.ReadAll(r.Body)
+		case "GET":
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				http.Error(w, "NoSuchKey", 404)
+				return
+			}
+			w.Write(data)
+		case "DELETE":
+			delete(objects, r.URL.Path)
+			w.WriteHeader(204)
+		}
+	}))
+}
+
+func TestS3Storage(t *testing.T) {
+	ts := fakeS3(t)
+	defer ts.Close()
+
+	s, err := NewS3Storage(S3Config{Endpoint: ts.URL, Bucket: "bucket", AccessKey: "key", SecretKey: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStorage(t, s)
+}
+
+func TestIsAllowedType(t *testing.T) {
+	defer func(types []string) { allowedTypes = types }(allowedTypes)
+	allowedTypes = []string{"image/*", "application/pdf"}
+
+	for _, c := range []struct {
+		in   string
+		want bool
+	}{
+		{"image/png", true},
+		{"application/pdf", true},
+		{"application/zip", false},
+		{"imagex/png", false},
+	} {
+		if got := IsAllowedType(c.in); got != c.want {
+			t.Errorf("IsAllowedType(%s) error, want: %v, got: %v", c.in, c.want, got)
+		}
+	}
+}
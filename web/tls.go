@@ -0,0 +1,127 @@
+/*
+ * Fractale - Self-organisation for humans.
+ * Copyright (C) 2024 Fractale Co
+ *
+ * This file is part of Fractale.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as
+ * published by the Free Software Foundation, either version 3 of the
+ * License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Fractale.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package web
+
+import (
+	"crypto/tls"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// CertReloader serves a TLS certificate and reloads it from disk when
+// the certificate or key file changes (e.g. after a renewal).
+type CertReloader struct {
+	certFile string
+	keyFile  string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+// Minimum delay between two checks of the files modification time.
+var certCheckInterval = time.Minute
+
+// NewCertReloader loads the certificate pair, and returns an error
+// if it is not valid.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	cr := &CertReloader{certFile: certFile, keyFile: keyFile}
+	if err := cr.reload(); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+// TLSConfig returns a TLS configuration that uses the reloader.
+func (cr *CertReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: cr.GetCertificate,
+	}
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (cr *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if !cr.shouldCheck() {
+		cr.mu.RLock()
+		defer cr.mu.RUnlock()
+		return cr.cert, nil
+	}
+
+	if modTime := cr.lastModTime(); !modTime.IsZero() {
+		cr.mu.RLock()
+		changed := modTime.After(cr.modTime)
+		cr.mu.RUnlock()
+		if changed {
+			if err := cr.reload(); err != nil {
+				// Keep serving the previous certificate until the next change.
+				log.Printf("TLS certificate reload error: %v", err)
+				cr.mu.Lock()
+				cr.modTime = modTime
+				cr.mu.Unlock()
+			}
+		}
+	}
+
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+	return cr.cert, nil
+}
+
+// shouldCheck returns true at most once every certCheckInterval, so that the
+// files are not checked on every handshake.
+func (cr *CertReloader) shouldCheck() bool {
+	now := time.Now()
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	if now.Sub(cr.lastCheck) < certCheckInterval {
+		return false
+	}
+	cr.lastCheck = now
+	return true
+}
+
+func (cr *CertReloader) reload() error {
+	modTime := cr.lastModTime()
+	cert, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		return err
+	}
+	cr.mu.Lock()
+	cr.cert = &cert
+	cr.modTime = modTime
+	cr.mu.Unlock()
+	return nil
+}
+
+// lastModTime returns the latest modification time of the cert and key files.
+func (cr *CertReloader) lastModTime() time.Time {
+	var t time.Time
+	for _, fn := range []string{cr.certFile, cr.keyFile} {
+		if fi, err := os.Stat(fn); err == nil && fi.ModTime().After(t) {
+			t = fi.ModTime()
+		}
+	}
+	return t
+}